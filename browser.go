@@ -0,0 +1,145 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+// Reading the cookie stores of Chrome and Firefox.
+//
+// This package does not depend on a SQLite driver: A program using
+// ImportChromeCookies or ImportFirefoxCookies has to import one and pass
+// the name it is registered under with database/sql, e.g. "sqlite3" for
+// github.com/mattn/go-sqlite3.  The values of the cookies read are plain;
+// they have to be encoded before they are imported into a jar which uses
+// a ValueCodec.
+
+import (
+	"database/sql"
+	"time"
+)
+
+// chromeEpoch is the offset in seconds between the Chrome epoch
+// 1601-01-01 and the Unix epoch 1970-01-01.
+const chromeEpoch = 11644473600
+
+// chromeTime converts a Chrome timestamp (microseconds since 1601-01-01
+// UTC) to a time.  0 yields the zero time.
+func chromeTime(us int64) time.Time {
+	if us == 0 {
+		return time.Time{}
+	}
+	return time.Unix(us/1e6-chromeEpoch, us%1e6*1e3)
+}
+
+// firefoxTime converts a Firefox timestamp (microseconds since the Unix
+// epoch) to a time.  0 yields the zero time.
+func firefoxTime(us int64) time.Time {
+	if us == 0 {
+		return time.Time{}
+	}
+	return time.Unix(us/1e6, us%1e6*1e3)
+}
+
+// browserCookie builds a cookie from a row of a browser cookie store.
+// A host with a leading dot yields a domain cookie.
+func browserCookie(host, name, value, path string, secure, httpOnly bool) *Cookie {
+	domain, dotted := canonicalDomain(host)
+	if path == "" {
+		path = "/"
+	}
+	return &Cookie{
+		Name:     name,
+		Value:    value,
+		Domain:   domain,
+		Path:     path,
+		Secure:   secure,
+		HostOnly: !dotted,
+		HttpOnly: httpOnly,
+	}
+}
+
+// ImportChromeCookies reads all cookies from the Chrome cookie database
+// dbPath (the file "Cookies" in the profile directory) using the SQLite
+// driver registered as driverName.  The result may be added to a jar with
+// Import.  Encrypted values cannot be decrypted: Cookies which have an
+// encrypted value only are skipped and their number is returned as
+// encrypted.  Current versions of Chrome encrypt nearly all values.
+func ImportChromeCookies(driverName, dbPath string) (cookies []*Cookie, encrypted int, err error) {
+	db, err := sql.Open(driverName, dbPath)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT host_key, name, value, encrypted_value, path, " +
+		"expires_utc, is_secure, is_httponly, creation_utc, last_access_utc " +
+		"FROM cookies")
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var host, name, value, path string
+		var encryptedValue []byte
+		var expires, created, lastAccess int64
+		var secure, httpOnly bool
+		err := rows.Scan(&host, &name, &value, &encryptedValue, &path,
+			&expires, &secure, &httpOnly, &created, &lastAccess)
+		if err != nil {
+			return nil, 0, err
+		}
+		if value == "" && len(encryptedValue) > 0 {
+			encrypted++
+			continue
+		}
+		c := browserCookie(host, name, value, path, secure, httpOnly)
+		c.Expires = chromeTime(expires)
+		c.Created = chromeTime(created)
+		c.LastAccess = chromeTime(lastAccess)
+		cookies = append(cookies, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return cookies, encrypted, nil
+}
+
+// ImportFirefoxCookies reads all cookies from the Firefox cookie database
+// dbPath (the file "cookies.sqlite" in the profile directory) using the
+// SQLite driver registered as driverName.  The result may be added to a
+// jar with Import.
+func ImportFirefoxCookies(driverName, dbPath string) ([]*Cookie, error) {
+	db, err := sql.Open(driverName, dbPath)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT host, name, value, path, expiry, " +
+		"isSecure, isHttpOnly, creationTime, lastAccessed FROM moz_cookies")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cookies []*Cookie
+	for rows.Next() {
+		var host, name, value, path string
+		var expiry, created, lastAccess int64
+		var secure, httpOnly bool
+		err := rows.Scan(&host, &name, &value, &path, &expiry,
+			&secure, &httpOnly, &created, &lastAccess)
+		if err != nil {
+			return nil, err
+		}
+		c := browserCookie(host, name, value, path, secure, httpOnly)
+		if expiry != 0 {
+			c.Expires = time.Unix(expiry, 0)
+		}
+		c.Created = firefoxTime(created)
+		c.LastAccess = firefoxTime(lastAccess)
+		cookies = append(cookies, c)
+	}
+	return cookies, rows.Err()
+}
@@ -0,0 +1,85 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+import (
+	"sort"
+	"time"
+)
+
+// -------------------------------------------------------------------------
+// Retrieval cache
+
+// cacheKey identifies a call to Cookies.
+type cacheKey struct {
+	https      bool
+	host, path string
+}
+
+// cacheEntry is the sorted list of cookies to send for a cacheKey.
+type cacheEntry struct {
+	cookies []*Cookie
+	expires time.Time // earliest expiration of cookies; zero if all are session cookies
+}
+
+// maxCacheEntries bounds the number of cached retrievals.  Each distinct
+// host and path gets an entry, so a client reading many URLs without
+// storing cookies (e.g. a crawler) would otherwise grow the cache forever.
+const maxCacheEntries = 1024
+
+// retrievalCache memoizes the result of retrieve and sort for the
+// current generation of the jar content.
+type retrievalCache struct {
+	generation uint64 // generation of the jar content the entries belong to
+	entries    map[cacheKey]cacheEntry
+}
+
+// invalidate marks all cached entries as stale.  It must be called after
+// any modification of the jar content.
+func (jar *Jar) invalidate() {
+	jar.generation++
+}
+
+// retrieveSorted fetches the sorted list of cookies to be sent, either
+// from the cache (if CacheRetrieval is set) or from storage.  A non-zero
+// now retrieves the cookies valid at that time and bypasses the cache.
+func (jar *Jar) retrieveSorted(https bool, host, path string, now time.Time) []*Cookie {
+	if !jar.CacheRetrieval || !now.IsZero() {
+		if now.IsZero() {
+			now = time.Now()
+		}
+		cookies := jar.content.retrieve(https, host, path, now)
+		sort.Sort(sendList(cookies))
+		return cookies
+	}
+
+	if jar.cache.entries == nil || jar.cache.generation != jar.generation {
+		jar.cache.entries = make(map[cacheKey]cacheEntry)
+		jar.cache.generation = jar.generation
+	}
+
+	key := cacheKey{https, host, path}
+	entry, ok := jar.cache.entries[key]
+	if ok && (entry.expires.IsZero() || entry.expires.After(time.Now())) {
+		return entry.cookies
+	}
+
+	if !ok && len(jar.cache.entries) >= maxCacheEntries {
+		jar.cache.entries = make(map[cacheKey]cacheEntry)
+	}
+	entry.cookies = jar.content.retrieve(https, host, path, time.Now())
+	sort.Sort(sendList(entry.cookies))
+	entry.expires = time.Time{}
+	for _, cookie := range entry.cookies {
+		if cookie.Session() {
+			continue
+		}
+		if entry.expires.IsZero() || cookie.Expires.Before(entry.expires) {
+			entry.expires = cookie.Expires
+		}
+	}
+	jar.cache.entries[key] = entry
+	return entry.cookies
+}
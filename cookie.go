@@ -5,22 +5,30 @@
 package cookiejar
 
 import (
+	"net/http"
+	"path"
 	"strings"
 	"time"
 )
 
 // Cookie is the representation of a cookie in the cookie jar.
 type Cookie struct {
-	Name       string    // the name of the cookie
-	Value      string    // the value of cookie
-	Domain     string    // the domain (no leading dot)
-	Path       string    // the path
-	Expires    time.Time // zero value indicates Session cookie
-	Secure     bool      // send to https only
-	HostOnly   bool      // a Host cookie if true, else a Domain cookie
-	HttpOnly   bool      // corresponding field in http.Cookie
-	Created    time.Time // time of creation
-	LastAccess time.Time // last update or send action
+	Name       string        // the name of the cookie
+	Value      string        // the value of cookie
+	Domain     string        // the domain (no leading dot)
+	Path       string        // the path
+	Expires    time.Time     // zero value indicates Session cookie
+	Secure     bool          // send to https only
+	HostOnly   bool          // a Host cookie if true, else a Domain cookie
+	HttpOnly   bool          // corresponding field in http.Cookie
+	SameSite   http.SameSite // corresponding field in http.Cookie
+	Created    time.Time     // time of creation
+	LastAccess time.Time     // last update or send action
+	Source     string        `json:",omitempty"` // host and path of the last setter if Jar.TrackSource
+	Scheme     string        `json:",omitempty"` // "http" or "https" if Jar.IsolateByScheme, else ""
+	Seq        uint64        `json:",omitempty"` // order of creation if Jar.PreserveSetOrder
+
+	skew time.Duration // tolerated clock skew, see Jar.ClockSkew
 }
 
 // shouldSend determines whether the cookie c qualifies to be included in a
@@ -29,7 +37,32 @@ type Cookie struct {
 func (c *Cookie) shouldSend(https bool, host, path string) bool {
 	return c.domainMatch(host) &&
 		c.pathMatch(path) &&
-		secureEnough(c.Secure, https)
+		secureEnough(c.Secure, https) &&
+		c.schemeMatch(https)
+}
+
+// schemeMatch reports whether a cookie isolated by scheme may be sent
+// to a https (or http) request.  Shared cookies match both schemes.
+func (c *Cookie) schemeMatch(https bool) bool {
+	return c.Scheme == "" || (c.Scheme == "https") == https
+}
+
+// sameSiteMatch applies the SameSite attribute of c to a request which is
+// cross-site or not (see draft-ietf-httpbis-rfc6265bis section 5.6.7.1):
+// Strict cookies are sent on same-site requests only, Lax cookies also on
+// cross-site top-level navigations with a safe method.  Cookies without
+// a SameSite attribute are treated like SameSite=None.
+func (c *Cookie) sameSiteMatch(crossSite, topLevel bool, method string) bool {
+	if !crossSite {
+		return true
+	}
+	switch c.SameSite {
+	case http.SameSiteStrictMode:
+		return false
+	case http.SameSiteLaxMode:
+		return topLevel && (method == "" || method == "GET" || method == "HEAD")
+	}
+	return true
 }
 
 // Every cookie is sent via https.  If the protocol is just http, then the
@@ -39,41 +72,107 @@ func secureEnough(cookieIsSecure, requestIsSecure bool) bool {
 }
 
 // domainMatch implements "domain-match" of RFC 6265 section 5.1.3:
-//   A string domain-matches a given domain string if at least one of the
-//   following conditions hold:
-//     o  The domain string and the string are identical.  (Note that both
-//        the domain string and the string will have been canonicalized to
-//        lower case at this point.)
-//     o  All of the following conditions hold:
-//        *  The domain string is a suffix of the string.
-//        *  The last character of the string that is not included in the
-//           domain string is a %x2E (".") character.
-//        *  The string is a host name (i.e., not an IP address).
+//
+//	A string domain-matches a given domain string if at least one of the
+//	following conditions hold:
+//	  o  The domain string and the string are identical.  (Note that both
+//	     the domain string and the string will have been canonicalized to
+//	     lower case at this point.)
+//	  o  All of the following conditions hold:
+//	     *  The domain string is a suffix of the string.
+//	     *  The last character of the string that is not included in the
+//	        domain string is a %x2E (".") character.
+//	     *  The string is a host name (i.e., not an IP address).
 func (c *Cookie) domainMatch(host string) bool {
 	if c.Domain == host {
 		return true
 	}
-	return !c.HostOnly && strings.HasSuffix(host, "."+c.Domain)
+	return !c.HostOnly && isSubdomain(host, c.Domain)
+}
+
+// prefixOK checks the constraints cookie name prefixes put on a cookie
+// (see draft-ietf-httpbis-rfc6265bis section 4.1.3):  A cookie named
+// "__Secure-..." must be Secure, a cookie named "__Host-..." must be a
+// Secure host cookie with Path "/".  The prefixes are matched case
+// insensitive.
+func (c *Cookie) prefixOK() bool {
+	switch {
+	case hasPrefixFold(c.Name, "__Secure-"):
+		return c.Secure
+	case hasPrefixFold(c.Name, "__Host-"):
+		return c.Secure && c.HostOnly && c.Path == "/"
+	}
+	return true
+}
+
+// prefixed reports whether the name of c has a "__Secure-" or "__Host-"
+// prefix.
+func (c *Cookie) prefixed() bool {
+	return hasPrefixFold(c.Name, "__Secure-") || hasPrefixFold(c.Name, "__Host-")
+}
+
+// hasPrefixFold is a case insensitive strings.HasPrefix.
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+// canonicalDomain returns domain in the form stored in Cookie.Domain:
+// lower case and without a leading or trailing dot.  dotted reports
+// whether domain had a leading dot which marks a domain cookie in
+// exported formats (see displayDomain).
+func canonicalDomain(domain string) (canonical string, dotted bool) {
+	canonical = strings.ToLower(domain)
+	if strings.HasSuffix(canonical, ".") {
+		canonical = canonical[:len(canonical)-1]
+	}
+	if strings.HasPrefix(canonical, ".") {
+		canonical, dotted = canonical[1:], true
+	}
+	return canonical, dotted
+}
+
+// displayDomain returns the domain of c as used by exported formats like
+// HAR: With a leading dot for domain cookies and without for host cookies.
+func (c *Cookie) displayDomain() string {
+	if c.HostOnly {
+		return c.Domain
+	}
+	return "." + c.Domain
+}
+
+// isSubdomain reports whether host ends in "."+domain.  It is like
+// strings.HasSuffix(host, "."+domain) but does not allocate.
+func isSubdomain(host, domain string) bool {
+	n := len(host) - len(domain)
+	return n > 0 && host[n-1] == '.' && host[n:] == domain
 }
 
 // pathMatch implements "path-match" according to RFC 6265 section 5.1.4:
-//   A request-path path-matches a given cookie-path if at least one of
-//   the following conditions holds:
-//     o  The cookie-path and the request-path are identical.
-//     o  The cookie-path is a prefix of the request-path, and the last
-//        character of the cookie-path is %x2F ("/").
-//     o  The cookie-path is a prefix of the request-path, and the first
-//        character of the request-path that is not included in the cookie-
-//        path is a %x2F ("/") character.
+//
+//	A request-path path-matches a given cookie-path if at least one of
+//	the following conditions holds:
+//	  o  The cookie-path and the request-path are identical.
+//	  o  The cookie-path is a prefix of the request-path, and the last
+//	     character of the cookie-path is %x2F ("/").
+//	  o  The cookie-path is a prefix of the request-path, and the first
+//	     character of the request-path that is not included in the cookie-
+//	     path is a %x2F ("/") character.
 func (c *Cookie) pathMatch(requestPath string) bool {
-	if requestPath == c.Path { // the simple case
+	return underPath(requestPath, c.Path)
+}
+
+// underPath reports whether the path p lies in the subtree rooted at dir
+// with the path segment rules of pathMatch: "/foo" and "/foo/bar" lie
+// under "/foo" but "/foobar" does not.
+func underPath(p, dir string) bool {
+	if p == dir { // the simple case
 		return true
 	}
 
-	if strings.HasPrefix(requestPath, c.Path) {
-		if c.Path[len(c.Path)-1] == '/' {
+	if strings.HasPrefix(p, dir) {
+		if dir[len(dir)-1] == '/' {
 			return true // "/any/path" matches "/" and "/any/"
-		} else if requestPath[len(c.Path)] == '/' {
+		} else if p[len(dir)] == '/' {
 			return true // "/any" matches "/any/some"
 		}
 	}
@@ -81,9 +180,25 @@ func (c *Cookie) pathMatch(requestPath string) bool {
 	return false
 }
 
+// normalizePath collapses duplicate slashes and resolves "." and ".."
+// segments in the absolute path p.  A trailing slash is kept.
+func normalizePath(p string) string {
+	clean := path.Clean(p)
+	if strings.HasSuffix(p, "/") && clean != "/" {
+		clean += "/"
+	}
+	return clean
+}
+
 // Expired checks if the cookie c is expired.
 func (c *Cookie) Expired() bool {
-	return !c.Session() && c.Expires.Before(time.Now())
+	return c.expiredAt(time.Now())
+}
+
+// expiredAt reports whether c is expired at time now.  The clock skew
+// tolerated by the jar c is stored in is taken into account.
+func (c *Cookie) expiredAt(now time.Time) bool {
+	return !c.Session() && c.Expires.Before(now.Add(-c.skew))
 }
 
 // Session checks if a cookie c is a session cookie (i.e. has a
@@ -106,11 +221,91 @@ func (l sendList) Less(i, j int) bool {
 	// like:
 	//   o  longer paths go firts
 	//   o  for same length paths: earlier creation time goes first
+	// Cookies with same path length and same creation time (e.g. after
+	// an Add of cookies with a zero Created) are sorted by name to keep
+	// the Cookie header deterministic.
 	in, jn := len(l[i].Path), len(l[j].Path)
 	if in == jn {
+		if l[i].Created.Equal(l[j].Created) {
+			return l[i].Name < l[j].Name
+		}
 		return l[i].Created.Before(l[j].Created)
 	}
 	return in > jn
 }
 
 func (l sendList) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+
+// seqList is a list of cookies sortable like sendList but by the order
+// of creation instead of the creation time.
+type seqList []*Cookie
+
+func (l seqList) Len() int { return len(l) }
+
+func (l seqList) Less(i, j int) bool {
+	in, jn := len(l[i].Path), len(l[j].Path)
+	if in != jn {
+		return in > jn
+	}
+	if l[i].Seq != l[j].Seq {
+		return l[i].Seq < l[j].Seq
+	}
+	return sendList(l).Less(i, j)
+}
+
+func (l seqList) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+
+// keyList is a list of cookies sortable by domain, path, name and scheme.
+type keyList []*Cookie
+
+func (l keyList) Len() int { return len(l) }
+func (l keyList) Less(i, j int) bool {
+	a, b := l[i], l[j]
+	switch {
+	case a.Domain != b.Domain:
+		return a.Domain < b.Domain
+	case a.Path != b.Path:
+		return a.Path < b.Path
+	case a.Name != b.Name:
+		return a.Name < b.Name
+	}
+	return a.Scheme < b.Scheme
+}
+func (l keyList) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+
+// precedes reports whether c takes precedence over b if only one cookie
+// of a name can be used: Host cookies precede domain cookies, more
+// specific domains less specific ones, longer paths shorter ones and
+// newer cookies older ones.
+func precedes(c, b *Cookie) bool {
+	switch {
+	case c.HostOnly != b.HostOnly:
+		return c.HostOnly
+	case len(c.Domain) != len(b.Domain):
+		return len(c.Domain) > len(b.Domain)
+	case len(c.Path) != len(b.Path):
+		return len(c.Path) > len(b.Path)
+	}
+	return c.Created.After(b.Created)
+}
+
+// precedenceList is a list of cookies sortable by precedes.
+type precedenceList []*Cookie
+
+func (l precedenceList) Len() int           { return len(l) }
+func (l precedenceList) Less(i, j int) bool { return precedes(l[i], l[j]) }
+func (l precedenceList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+// nameList is a list of cookies sortable by name.
+type nameList []*Cookie
+
+func (l nameList) Len() int           { return len(l) }
+func (l nameList) Less(i, j int) bool { return l[i].Name < l[j].Name }
+func (l nameList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+// createdList is a list of cookies sortable by creation time.
+type createdList []*Cookie
+
+func (l createdList) Len() int           { return len(l) }
+func (l createdList) Less(i, j int) bool { return l[i].Created.Before(l[j].Created) }
+func (l createdList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
@@ -0,0 +1,111 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+import (
+	"net/url"
+	"sync"
+	"time"
+)
+
+// -------------------------------------------------------------------------
+// Cookie events
+
+// EventAction is the kind of modification reported in a CookieEvent.
+type EventAction int
+
+const (
+	EventCreate EventAction = iota // a new cookie was stored
+	EventUpdate                    // an existing cookie was overwritten
+	EventDelete                    // a cookie was deleted by the server or a method like Remove
+	EventEvict                     // a cookie was removed to enforce a limit
+	EventExpire                    // an expired cookie was removed by Cleanup
+)
+
+// CookieEvent describes a single modification of the jar content.
+type CookieEvent struct {
+	Action EventAction
+	Cookie Cookie   // for EventDelete by the server or Remove only Domain, Path, Name and Scheme are set
+	URL    *url.URL // the URL passed to SetCookies, nil for other methods
+	Time   time.Time
+}
+
+// subscriberBuffer is the number of events buffered for each subscriber.
+const subscriberBuffer = 64
+
+// subscriber is a single receiver of cookie events.
+type subscriber struct {
+	events chan CookieEvent
+	once   sync.Once
+}
+
+// Subscribe returns a channel on which all modifications of jar done by
+// SetCookies, all evictions and all removals by Remove, DeleteMatching,
+// ClearSession, ConsolidateName and Cleanup are reported.  Replacing the
+// whole content (e.g. LoadReplace) and the reuse of the storage of expired
+// cookies not yet removed by Cleanup are not reported.  The channel is
+// buffered; if a subscriber does not keep up, the oldest buffered events
+// are dropped.  Calling the returned function unsubscribes and closes the
+// channel.
+func (jar *Jar) Subscribe() (<-chan CookieEvent, func()) {
+	s := &subscriber{events: make(chan CookieEvent, subscriberBuffer)}
+
+	jar.Lock()
+	jar.subscribers = append(jar.subscribers, s)
+	jar.Unlock()
+
+	unsubscribe := func() {
+		jar.Lock()
+		defer jar.Unlock()
+		for i, t := range jar.subscribers {
+			if t == s {
+				n := len(jar.subscribers) - 1
+				jar.subscribers[i] = jar.subscribers[n]
+				jar.subscribers[n] = nil
+				jar.subscribers = jar.subscribers[:n]
+				break
+			}
+		}
+		s.once.Do(func() { close(s.events) })
+	}
+	return s.events, unsubscribe
+}
+
+// remove deletes all cookies for which match returns true, publishes an
+// event action for each and returns their number.  The caller must hold
+// the lock on jar.
+func (jar *Jar) remove(match func(*Cookie) bool, action EventAction) int {
+	return jar.content.deleteFunc(func(c *Cookie) bool {
+		if !match(c) {
+			return false
+		}
+		jar.publish(action, *c, nil)
+		return true
+	})
+}
+
+// publish sends an event to all subscribers without blocking.
+// The caller must hold the lock on jar.
+func (jar *Jar) publish(action EventAction, cookie Cookie, u *url.URL) {
+	if len(jar.subscribers) == 0 {
+		return
+	}
+	event := CookieEvent{Action: action, Cookie: cookie, URL: u, Time: time.Now()}
+	for _, s := range jar.subscribers {
+		for {
+			select {
+			case s.events <- event:
+			default:
+				// buffer full: drop oldest event and retry
+				select {
+				case <-s.events:
+				default:
+				}
+				continue
+			}
+			break
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"time"
+)
+
+// -------------------------------------------------------------------------
+// Gob encoding
+
+// gobVersion is the version of the gobJar envelope.
+const gobVersion = 1
+
+var errGobVersion = errors.New("Unknown version of gob encoded jar")
+
+// gobConfig are the settings of a Jar which can be gob encoded.
+// ValueCodec and EvictionVeto are functions and cannot be encoded.
+type gobConfig struct {
+	BoxedStorage                  bool
+	Shards                        int // number of shards of sharded storage
+	MaxBytesPerCookie             int
+	MaxPathBytes                  int
+	RequirePathScope              bool
+	NormalizePaths                bool
+	MaxCookiesPerHost             int
+	MaxCookiesTotal               int
+	MaxDomains                    int
+	DeferCleanup                  bool
+	MaxFutureExpiry               time.Duration
+	ClockSkew                     time.Duration
+	SessionTTL                    time.Duration
+	LastAccessGranularity         time.Duration
+	CacheRetrieval                bool
+	HostCookieOnIP                bool
+	DomainCookiesOnPublicSuffixes bool
+	ExactDomainIsHostOnly         bool
+	StripPortFromDomain           bool
+	UnderscoreInHost              bool
+	ImportHttpOnly                HttpOnlyPolicy
+	ImportResetTimes              bool
+	BlockThirdParty               bool
+	TrackSource                   bool
+	EnforceSameSiteNoneSecure     bool
+	ProtectSecureCookies          bool
+	ImplicitSecureOnHTTPS         bool
+	IsolateByScheme               bool
+	LenientParsing                bool
+	PreserveSetOrder              bool
+	MaxLoadBytes                  int64
+	MaxLoadCookies                int
+}
+
+// gobJar is the envelope written by GobEncode.
+type gobJar struct {
+	Version int
+	Config  gobConfig
+	Cookies []Cookie
+}
+
+// config returns the encodable settings of jar.
+func (jar *Jar) config() gobConfig {
+	_, boxedStorage := jar.content.(*boxed)
+	shards := 0
+	if s, ok := jar.content.(*sharded); ok {
+		boxedStorage, shards = true, len(*s)
+	}
+	return gobConfig{
+		BoxedStorage:                  boxedStorage,
+		Shards:                        shards,
+		MaxBytesPerCookie:             jar.MaxBytesPerCookie,
+		MaxPathBytes:                  jar.MaxPathBytes,
+		RequirePathScope:              jar.RequirePathScope,
+		NormalizePaths:                jar.NormalizePaths,
+		MaxCookiesPerHost:             jar.MaxCookiesPerHost,
+		MaxCookiesTotal:               jar.MaxCookiesTotal,
+		MaxDomains:                    jar.MaxDomains,
+		DeferCleanup:                  jar.DeferCleanup,
+		MaxFutureExpiry:               jar.MaxFutureExpiry,
+		ClockSkew:                     jar.ClockSkew,
+		SessionTTL:                    jar.SessionTTL,
+		LastAccessGranularity:         jar.LastAccessGranularity,
+		CacheRetrieval:                jar.CacheRetrieval,
+		HostCookieOnIP:                jar.HostCookieOnIP,
+		DomainCookiesOnPublicSuffixes: jar.DomainCookiesOnPublicSuffixes,
+		ExactDomainIsHostOnly:         jar.ExactDomainIsHostOnly,
+		StripPortFromDomain:           jar.StripPortFromDomain,
+		UnderscoreInHost:              jar.UnderscoreInHost,
+		ImportHttpOnly:                jar.ImportHttpOnly,
+		ImportResetTimes:              jar.ImportResetTimes,
+		BlockThirdParty:               jar.BlockThirdParty,
+		TrackSource:                   jar.TrackSource,
+		EnforceSameSiteNoneSecure:     jar.EnforceSameSiteNoneSecure,
+		ProtectSecureCookies:          jar.ProtectSecureCookies,
+		ImplicitSecureOnHTTPS:         jar.ImplicitSecureOnHTTPS,
+		IsolateByScheme:               jar.IsolateByScheme,
+		LenientParsing:                jar.LenientParsing,
+		PreserveSetOrder:              jar.PreserveSetOrder,
+		MaxLoadBytes:                  jar.MaxLoadBytes,
+		MaxLoadCookies:                jar.MaxLoadCookies,
+	}
+}
+
+// setConfig applies the settings c to jar.  The storage is not touched.
+func (jar *Jar) setConfig(c gobConfig) {
+	jar.MaxBytesPerCookie = c.MaxBytesPerCookie
+	jar.MaxPathBytes = c.MaxPathBytes
+	jar.RequirePathScope = c.RequirePathScope
+	jar.NormalizePaths = c.NormalizePaths
+	jar.MaxCookiesPerHost = c.MaxCookiesPerHost
+	jar.MaxCookiesTotal = c.MaxCookiesTotal
+	jar.MaxDomains = c.MaxDomains
+	jar.DeferCleanup = c.DeferCleanup
+	jar.MaxFutureExpiry = c.MaxFutureExpiry
+	jar.ClockSkew = c.ClockSkew
+	jar.SessionTTL = c.SessionTTL
+	jar.LastAccessGranularity = c.LastAccessGranularity
+	jar.CacheRetrieval = c.CacheRetrieval
+	jar.HostCookieOnIP = c.HostCookieOnIP
+	jar.DomainCookiesOnPublicSuffixes = c.DomainCookiesOnPublicSuffixes
+	jar.ExactDomainIsHostOnly = c.ExactDomainIsHostOnly
+	jar.StripPortFromDomain = c.StripPortFromDomain
+	jar.UnderscoreInHost = c.UnderscoreInHost
+	jar.ImportHttpOnly = c.ImportHttpOnly
+	jar.ImportResetTimes = c.ImportResetTimes
+	jar.BlockThirdParty = c.BlockThirdParty
+	jar.TrackSource = c.TrackSource
+	jar.EnforceSameSiteNoneSecure = c.EnforceSameSiteNoneSecure
+	jar.ProtectSecureCookies = c.ProtectSecureCookies
+	jar.ImplicitSecureOnHTTPS = c.ImplicitSecureOnHTTPS
+	jar.IsolateByScheme = c.IsolateByScheme
+	jar.LenientParsing = c.LenientParsing
+	jar.PreserveSetOrder = c.PreserveSetOrder
+	jar.MaxLoadBytes = c.MaxLoadBytes
+	jar.MaxLoadCookies = c.MaxLoadCookies
+}
+
+// GobEncode encodes the settings, the kind of storage and all non-expired
+// cookies of jar.  ValueCodec and EvictionVeto are not encoded.
+func (jar *Jar) GobEncode() ([]byte, error) {
+	jar.Lock()
+	g := gobJar{Version: gobVersion, Config: jar.config(), Cookies: jar.All()}
+	jar.Unlock()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(g); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode replaces the content of jar with the cookies encoded in data
+// by GobEncode.  If jar is the zero Jar, the encoded settings and kind of
+// storage are restored as well; a jar set up by NewJar keeps its own.
+// MaxLoadBytes and MaxLoadCookies of jar are honored.  Like LoadReplace
+// it enforces the limits of jar and leaves jar untouched if data is
+// malformed or contains an invalid cookie.
+func (jar *Jar) GobDecode(data []byte) error {
+	if jar.MaxLoadBytes > 0 && int64(len(data)) > jar.MaxLoadBytes {
+		return errLoadTooLarge
+	}
+	var g gobJar
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&g); err != nil {
+		return err
+	}
+	if g.Version != gobVersion {
+		return errGobVersion
+	}
+	if jar.MaxLoadCookies > 0 && len(g.Cookies) > jar.MaxLoadCookies {
+		return errLoadTooMany
+	}
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	check := jar
+	var content storage
+	switch {
+	case jar.content != nil:
+		content = jar.content.empty()
+	case g.Config.Shards > 0:
+		content = newSharded(g.Config.Shards)
+	default:
+		content = newStorage(g.Config.BoxedStorage)
+	}
+	if jar.content == nil {
+		check = &Jar{}
+		check.setConfig(g.Config)
+	}
+	for i := range g.Cookies {
+		cookie := &g.Cookies[i]
+		if check.expired(cookie) {
+			continue
+		}
+		if !check.valid(cookie) {
+			return errInvalidCookie
+		}
+		c := content.find(cookie.Domain, cookie.Path, cookie.Name, cookie.Scheme)
+		*c = *cookie
+	}
+
+	if jar.content == nil {
+		jar.setConfig(g.Config)
+	}
+	jar.replace(content)
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// -------------------------------------------------------------------------
+// Parsing of Set-Cookie headers
+
+// attributeNames maps the lower case names of the cookie attributes of
+// RFC 6265 section 4.1.1 to their canonical spelling.
+var attributeNames = map[string]string{
+	"expires":  "Expires",
+	"max-age":  "Max-Age",
+	"domain":   "Domain",
+	"path":     "Path",
+	"secure":   "Secure",
+	"httponly": "HttpOnly",
+	"samesite": "SameSite",
+}
+
+// SetCookieHeader parses the given Set-Cookie header lines and handles
+// the resulting cookies like SetCookies.  Unless LenientParsing is set,
+// lines which do not follow the syntax of RFC 6265 section 4.1.1 are
+// rejected.  The number of rejected lines is returned.
+func (jar *Jar) SetCookieHeader(u *url.URL, lines ...string) (rejected int) {
+	cookies := make([]*http.Cookie, 0, len(lines))
+	for _, line := range lines {
+		if cookie := jar.parseSetCookie(line); cookie != nil {
+			cookies = append(cookies, cookie)
+		} else {
+			rejected++
+		}
+	}
+	jar.SetCookies(u, cookies)
+	return rejected
+}
+
+// parseSetCookie parses a single Set-Cookie header line.  If LenientParsing
+// is not set, a missing "=" in the name-value pair, attribute names not in
+// their canonical spelling and values for Secure or HttpOnly yield nil.
+// Otherwise a missing "=" is treated as a cookie with an empty value and
+// the rest is parsed as leniently as net/http does.  In both modes an
+// Expires attribute net/http cannot parse yields nil unless Max-Age is
+// given: The cookie must not silently become a session cookie.
+func (jar *Jar) parseSetCookie(line string) *http.Cookie {
+	parts := strings.Split(line, ";")
+	if strings.Index(parts[0], "=") == -1 {
+		if !jar.LenientParsing {
+			return nil
+		}
+		parts[0] = strings.TrimSpace(parts[0]) + "="
+	}
+	if !jar.LenientParsing {
+		for _, attr := range parts[1:] {
+			attr = strings.TrimSpace(attr)
+			name, hasValue := attr, false
+			if i := strings.Index(attr, "="); i != -1 {
+				name, hasValue = strings.TrimSpace(attr[:i]), true
+			}
+			canonical, known := attributeNames[strings.ToLower(name)]
+			if !known {
+				continue // extension-av
+			}
+			if name != canonical {
+				return nil
+			}
+			if hasValue && (name == "Secure" || name == "HttpOnly") {
+				return nil
+			}
+		}
+	}
+
+	header := http.Header{"Set-Cookie": {strings.Join(parts, ";")}}
+	cookies := (&http.Response{Header: header}).Cookies()
+	if len(cookies) != 1 {
+		return nil
+	}
+	cookie := cookies[0]
+	if cookie.MaxAge == 0 && cookie.Expires.IsZero() && cookie.RawExpires != "" {
+		return nil
+	}
+	return cookie
+}
+
+// -------------------------------------------------------------------------
+// Cookie headers of requests
+
+// ParseCookieHeader parses the value of a Cookie request header like
+// "a=1; b=2" into cookies with Name and Value set.  Malformed pairs are
+// dropped the same way net/http drops them on the server side.
+func ParseCookieHeader(header string) []*http.Cookie {
+	r := &http.Request{Header: http.Header{"Cookie": {header}}}
+	return r.Cookies()
+}
+
+// FormatCookies produces the value of a Cookie request header for the
+// given cookies.  Only Name and Value are used.  The result is the same
+// as the header AttachCookies adds for the cookies.
+func FormatCookies(cookies []*http.Cookie) string {
+	r := &http.Request{Header: http.Header{}}
+	for _, cookie := range cookies {
+		r.AddCookie(cookie)
+	}
+	return r.Header.Get("Cookie")
+}
@@ -0,0 +1,257 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+// Mapping and validation of internationalized domain names.  Domain names
+// are mapped like UTS #46 does for lookup (case folding, compatibility
+// mapping and Normalization Form C) and the resulting labels are checked
+// against IDNA2008: RFC 5891 section 4.2, the derived properties of
+// RFC 5892 and the Bidi Rule of RFC 5893.  The tables are generated by
+// makeidna.go into idnatable.go.
+
+import (
+	"errors"
+	"sort"
+	"unicode"
+)
+
+var (
+	errDisallowedRune = errors.New("Domain name contains a disallowed code point")
+	errBadHyphen      = errors.New("Domain name label has a misplaced hyphen")
+	errLeadingMark    = errors.New("Domain name label starts with a combining mark")
+	errBidiRule       = errors.New("Domain name label violates the Bidi Rule")
+)
+
+// idnaMap maps the domain name s for lookup: The full stops of UTS #46
+// become ".", each code point is replaced by its idnaMapping or else
+// lower cased and the result is put into Normalization Form C.
+func idnaMap(s string) string {
+	mapped := make([]rune, 0, len(s))
+	for _, r := range s {
+		switch r {
+		case '。', '．', '｡':
+			mapped = append(mapped, '.')
+			continue
+		}
+		if m, ok := idnaMapping[r]; ok {
+			mapped = append(mapped, []rune(m)...)
+			continue
+		}
+		mapped = append(mapped, unicode.ToLower(r))
+	}
+	return string(nfc(mapped))
+}
+
+// idnaValidate checks that the mapped, non-ASCII label is a valid U-label.
+func idnaValidate(label string) error {
+	if label == "" {
+		return nil
+	}
+	runes := []rune(label)
+	if runes[0] == '-' || runes[len(runes)-1] == '-' ||
+		len(runes) >= 4 && runes[2] == '-' && runes[3] == '-' {
+		return errBadHyphen // RFC 5891 section 4.2.3.1
+	}
+	for i, r := range runes {
+		if i == 0 && unicode.Is(unicode.M, r) {
+			return errLeadingMark // RFC 5891 section 4.2.3.2
+		}
+		if !idnaValid(r) {
+			return errDisallowedRune
+		}
+	}
+	if !bidiRuleOK(label) {
+		return errBidiRule
+	}
+	return nil
+}
+
+// idnaValid approximates the derived property PVALID of RFC 5892: Lower
+// case letters, other letters, marks and decimal digits which are stable
+// under the mapping, and the hyphen.  Code points which are only valid in
+// some context (CONTEXTJ and CONTEXTO) are rejected.
+func idnaValid(r rune) bool {
+	switch r {
+	case 'ß', 'ς', '۽', '۾', '་', '〇':
+		return true // PVALID exceptions of RFC 5892 section 2.6
+	case 'ـ', 'ߺ', '〮', '〯', '〱', '〲',
+		'〳', '〴', '〵', '〻':
+		return false // DISALLOWED exceptions
+	case '-':
+		return true
+	}
+	if _, ok := idnaMapping[r]; ok || unicode.ToLower(r) != r {
+		return false // unstable
+	}
+	return unicode.In(r, unicode.Ll, unicode.Lo, unicode.Lm,
+		unicode.Mn, unicode.Mc, unicode.Nd)
+}
+
+// The bidi classes distinguished by the Bidi Rule.
+const (
+	bidiL = iota
+	bidiR
+	bidiAL
+	bidiAN
+	bidiEN
+	bidiES
+	bidiNSM
+)
+
+// A bidiRange assigns the bidi class to the code points Lo to Hi.
+type bidiRange struct {
+	Lo, Hi rune
+	Class  int
+}
+
+// bidiClass returns the bidi class of r as far as the Bidi Rule needs it.
+// idnaValidate admits only the hyphen of the classes not in bidiClasses.
+func bidiClass(r rune) int {
+	if r == '-' {
+		return bidiES
+	}
+	i := sort.Search(len(bidiClasses), func(i int) bool {
+		return bidiClasses[i].Hi >= r
+	})
+	if i < len(bidiClasses) && bidiClasses[i].Lo <= r {
+		return bidiClasses[i].Class
+	}
+	return bidiL
+}
+
+// bidiRuleOK checks label against the Bidi Rule of RFC 5893 section 2.
+// A label containing right-to-left characters or Arabic digits must be a
+// right-to-left label; other labels pass.
+func bidiRuleOK(label string) bool {
+	var classes []int
+	rtl := false
+	for _, r := range label {
+		c := bidiClass(r)
+		rtl = rtl || c == bidiR || c == bidiAL || c == bidiAN
+		classes = append(classes, c)
+	}
+	if !rtl {
+		return true
+	}
+
+	// rule 1: a right-to-left label starts with R or AL
+	if classes[0] != bidiR && classes[0] != bidiAL {
+		return false
+	}
+	// rule 3: it ends in R, AL, EN or AN followed by marks only
+	last := len(classes) - 1
+	for last > 0 && classes[last] == bidiNSM {
+		last--
+	}
+	switch classes[last] {
+	case bidiR, bidiAL, bidiEN, bidiAN:
+	default:
+		return false
+	}
+	// rules 2 and 4: no left-to-right characters and not both kinds of
+	// digits
+	var en, an bool
+	for _, c := range classes {
+		switch c {
+		case bidiL:
+			return false
+		case bidiEN:
+			en = true
+		case bidiAN:
+			an = true
+		}
+	}
+	return !(en && an)
+}
+
+// The constants of the algorithmic Hangul (de)composition of the Unicode
+// Standard section 3.12.
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// nfc puts runes into Normalization Form C: They are decomposed, the
+// combining marks are put into canonical order and are then composed
+// again.  The runes are modified in place.
+func nfc(runes []rune) []rune {
+	// canonical decomposition
+	var decomposed []rune
+	for i, r := range runes {
+		d, ok := decomposition[r]
+		if s := r - hangulSBase; 0 <= s && s < hangulSCount {
+			if decomposed == nil {
+				decomposed = append([]rune{}, runes[:i]...)
+			}
+			decomposed = append(decomposed,
+				hangulLBase+s/hangulNCount, hangulVBase+s%hangulNCount/hangulTCount)
+			if t := s % hangulTCount; t != 0 {
+				decomposed = append(decomposed, hangulTBase+t)
+			}
+		} else if ok {
+			if decomposed == nil {
+				decomposed = append([]rune{}, runes[:i]...)
+			}
+			decomposed = append(decomposed, []rune(d)...)
+		} else if decomposed != nil {
+			decomposed = append(decomposed, r)
+		}
+	}
+	if decomposed != nil {
+		runes = decomposed
+	}
+
+	// canonical ordering: a stable sort of each run of combining marks
+	for i := 1; i < len(runes); i++ {
+		c := combiningClass[runes[i]]
+		for j := i; j > 0 && c != 0 && combiningClass[runes[j-1]] > c; j-- {
+			runes[j], runes[j-1] = runes[j-1], runes[j]
+		}
+	}
+
+	// canonical composition
+	composed := runes[:0]
+	starter := -1
+	for _, r := range runes {
+		c := combiningClass[r]
+		if starter >= 0 {
+			last := len(composed) - 1
+			blocked := last > starter &&
+				(combiningClass[composed[last]] == 0 || combiningClass[composed[last]] >= c)
+			if !blocked {
+				if p, ok := compose(composed[starter], r); ok {
+					composed[starter] = p
+					continue
+				}
+			}
+		}
+		if c == 0 {
+			starter = len(composed)
+		}
+		composed = append(composed, r)
+	}
+	return composed
+}
+
+// compose returns the primary composite of a and b if there is one.
+func compose(a, b rune) (rune, bool) {
+	if l, v := a-hangulLBase, b-hangulVBase; 0 <= l && l < hangulLCount &&
+		0 <= v && v < hangulVCount {
+		return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+	}
+	if s, t := a-hangulSBase, b-hangulTBase; 0 <= s && s < hangulSCount &&
+		s%hangulTCount == 0 && 0 < t && t < hangulTCount {
+		return a + t, true
+	}
+	p, ok := composition[[2]rune{a, b}]
+	return p, ok
+}
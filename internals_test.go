@@ -8,6 +8,7 @@ package cookiejar
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"testing"
@@ -62,6 +63,94 @@ func TestPathMatch(t *testing.T) {
 	}
 }
 
+var domainMatchTests = []struct {
+	domain   string
+	hostOnly bool
+	host     string
+	match    bool
+}{
+	{"host.test", false, "host.test", true},
+	{"host.test", true, "host.test", true},
+	{"host.test", false, "www.host.test", true},
+	{"host.test", true, "www.host.test", false},
+	{"host.test", false, "a.b.host.test", true},
+	{"host.test", false, "wwwhost.test", false},
+	{"host.test", false, ".host.test", true},
+	{"host.test", false, "ost.test", false},
+	{"host.test", false, "host.test.org", false},
+	{"www.host.test", false, "host.test", false},
+	{"test", false, "host.test", true},
+	{"", false, "host.test", false},
+}
+
+func TestDomainMatch(t *testing.T) {
+	for i, tt := range domainMatchTests {
+		c := &Cookie{Domain: tt.domain, HostOnly: tt.hostOnly}
+		if c.domainMatch(tt.host) != tt.match {
+			t.Errorf("#%d want %t for %q (host-only %t) ~ %q",
+				i, tt.match, tt.domain, tt.hostOnly, tt.host)
+		}
+	}
+
+	c := &Cookie{Domain: "host.test"}
+	allocs := testing.AllocsPerRun(100, func() { c.domainMatch("www.host.test") })
+	if allocs != 0 {
+		t.Errorf("domainMatch allocates %.1f times", allocs)
+	}
+}
+
+func BenchmarkDomainMatch(b *testing.B) {
+	c := &Cookie{Domain: "host.test"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.domainMatch("www.sub.host.test")
+	}
+}
+
+var canonicalDomainTests = []struct {
+	in        string
+	canonical string
+	dotted    bool
+}{
+	{"www.example.com", "www.example.com", false},
+	{"WWW.Example.COM", "www.example.com", false},
+	{".example.com", "example.com", true},
+	{"www.example.com.", "www.example.com", false},
+	{".Example.com.", "example.com", true},
+	{"", "", false},
+	{".", "", false},
+}
+
+func TestCanonicalDomain(t *testing.T) {
+	for i, tt := range canonicalDomainTests {
+		canonical, dotted := canonicalDomain(tt.in)
+		if canonical != tt.canonical || dotted != tt.dotted {
+			t.Errorf("#%d %q: want %q/%t, got %q/%t",
+				i, tt.in, tt.canonical, tt.dotted, canonical, dotted)
+		}
+	}
+}
+
+func TestDisplayDomain(t *testing.T) {
+	for i, tt := range []struct {
+		cookie  Cookie
+		display string
+	}{
+		{Cookie{Domain: "www.example.com", HostOnly: true}, "www.example.com"},
+		{Cookie{Domain: "example.com", HostOnly: false}, ".example.com"},
+	} {
+		display := tt.cookie.displayDomain()
+		if display != tt.display {
+			t.Errorf("#%d: want %q, got %q", i, tt.display, display)
+		}
+		// canonical and display form round trip
+		canonical, dotted := canonicalDomain(display)
+		if canonical != tt.cookie.Domain || dotted == tt.cookie.HostOnly {
+			t.Errorf("#%d: %q yields %q/%t", i, display, canonical, dotted)
+		}
+	}
+}
+
 var hostTests = []struct {
 	in, expected string
 }{
@@ -70,7 +159,18 @@ var hostTests = []struct {
 	{"wWw.eXAmple.CoM", "www.example.com"},
 	{"www.example.com:80", "www.example.com"},
 	{"12.34.56.78:8080", "12.34.56.78"},
-	// TODO: add IDN testcase
+	{"www.bücher.test", "www.xn--bcher-kva.test"},
+	{"www.BÜCHER.test:8080", "www.xn--bcher-kva.test"},
+	{"www.example.com.:8080", "www.example.com"},
+	{"12.34.56.78", "12.34.56.78"},
+	{"[2001:DB8::1]", "2001:db8::1"},
+	{"[2001:db8:0::1]:8080", "2001:db8::1"},
+	{"[::ffff:12.34.56.78]:80", "12.34.56.78"},
+	{strings.Repeat("a.", 126) + "com", ""}, // longer than 253 bytes
+	{"my_host.example.com", "my_host.example.com"},
+	{"www.exa mple.com", ""},
+	{"www.example.com\x00", ""},
+	{"www.exa%mple.com", ""},
 }
 
 func TestHost(t *testing.T) {
@@ -91,7 +191,9 @@ var isIPTests = []struct {
 	{"1.1.1.300", false},
 	{"www.foo.bar.net", false},
 	{"123.foo.bar.net", false},
-	// TODO: IPv6 test
+	{"2001:db8::1", true},
+	{"::1", true},
+	{"2001:db8:0::1", false}, // not canonical
 }
 
 func TestIsIP(t *testing.T) {
@@ -107,23 +209,84 @@ var domainAndTypeTests = []struct {
 	inCookieDomain string
 	outDomain      string
 	outHostOnly    bool
+	outErr         error
 }{
-	{"www.example.com", "", "www.example.com", true},
-	{"127.www.0.0.1", "127.0.0.1", "", false},
-	{"www.example.com", ".", "", false},
-	{"www.example.com", "..", "", false},
-	{"www.example.com", "com", "", false},
-	{"www.example.com", ".com", "", false},
-	{"www.example.com", "example.com", "example.com", false},
-	{"www.example.com", ".example.com", "example.com", false},
-	{"www.example.com", "www.example.com", "www.example.com", false},  // Unsure about this and
-	{"www.example.com", ".www.example.com", "www.example.com", false}, // this one.
-	{"foo.sso.example.com", "sso.example.com", "sso.example.com", false},
+	{"www.example.com", "", "www.example.com", true, nil},
+	{"127.www.0.0.1", "127.0.0.1", "", false, errBadDomain},
+	{"www.example.com", ".", "", false, errMalformedDomain},
+	{"www.example.com", "..", "", false, errMalformedDomain},
+	{"www.example.com", "com", "", false, errTLDDomainCookie},
+	{"www.example.com", ".com", "", false, errTLDDomainCookie},
+	{"www.example.com", "example.com", "example.com", false, nil},
+	{"www.example.com", ".example.com", "example.com", false, nil},
+	{"www.example.com", "www.example.com", "www.example.com", false, nil},  // a domain cookie
+	{"www.example.com", ".www.example.com", "www.example.com", false, nil}, // unless ExactDomainIsHostOnly
+	{"foo.sso.example.com", "sso.example.com", "sso.example.com", false, nil},
+	{"www.example.com", "ww.example.com", "", false, errBadDomain},
+	{"www.example.com", "other.com", "", false, errBadDomain},
+	{"example.com", "www.example.com", "", false, errBadDomain},
 }
 
 func TestDomainAndType(t *testing.T) {
 	jar := Jar{}
 	for i, tt := range domainAndTypeTests {
+		d, h, err := jar.domainAndType(tt.inHost, tt.inCookieDomain)
+		if d != tt.outDomain || h != tt.outHostOnly || err != tt.outErr {
+			t.Errorf("#%d %q/%q: want %q/%t/%v got %q/%t/%v",
+				i, tt.inHost, tt.inCookieDomain,
+				tt.outDomain, tt.outHostOnly, tt.outErr, d, h, err)
+		}
+	}
+}
+
+func BenchmarkDomainAndType(b *testing.B) {
+	jar := Jar{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		jar.domainAndType("www.sub.example.com", "example.com")
+	}
+}
+
+var ipDomainTests = []struct {
+	host, domainAttr string
+	err              error
+}{
+	{"example.com", "1.2.3.4", errBadDomain},
+	{"example.com", ".1.2.3.4", errBadDomain},
+	{"4.example.com", "1.2.3.4", errBadDomain},
+	{"1.2.3.4", "example.com", errNoHostname},
+	{"1.2.3.4", "1.2.3.4", errNoHostname},
+	{"1.2.3.4", "", nil},
+}
+
+func TestDomainAndTypeIP(t *testing.T) {
+	jar := Jar{}
+	for i, tt := range ipDomainTests {
+		if _, _, err := jar.domainAndType(tt.host, tt.domainAttr); err != tt.err {
+			t.Errorf("#%d %q/%q: got %v, want %v",
+				i, tt.host, tt.domainAttr, err, tt.err)
+		}
+	}
+}
+
+var exactDomainTests = []struct {
+	inHost         string
+	inCookieDomain string
+	outDomain      string
+	outHostOnly    bool
+}{
+	{"www.example.com", "", "www.example.com", true},
+	{"www.example.com", "www.example.com", "www.example.com", true},
+	{"www.example.com", ".www.example.com", "www.example.com", true},
+	{"www.example.com", "WWW.Example.COM", "www.example.com", true},
+	{"www.example.com", "example.com", "example.com", false},
+	{"localhost", "localhost", "localhost", true},
+	{"co.uk", "co.uk", "co.uk", true},
+}
+
+func TestDomainAndTypeExactDomain(t *testing.T) {
+	jar := Jar{ExactDomainIsHostOnly: true}
+	for i, tt := range exactDomainTests {
 		d, h, _ := jar.domainAndType(tt.inHost, tt.inCookieDomain)
 		if d != tt.outDomain || h != tt.outHostOnly {
 			t.Errorf("#%d %q/%q: want %q/%t got %q/%t",
@@ -133,6 +296,41 @@ func TestDomainAndType(t *testing.T) {
 	}
 }
 
+var publicSuffixDomainTests = []struct {
+	inHost         string
+	inCookieDomain string
+	outDomain      string
+	outHostOnly    bool
+}{
+	{"com", "", "com", true},
+	{"com", "com", "", false},
+	{"com", ".com", "", false},
+	{"google.com", "com", "", false},
+	{"google.com", ".com", "", false},
+	{"google.co.uk", "co.uk", "", false},
+	{"google.co.uk", ".co.uk", "", false},
+	{"google.co.uk", "uk", "", false},
+	{"google.co.uk", ".uk", "", false},
+	{"co.uk", "", "co.uk", true},
+	{"co.uk", "co.uk", "co.uk", true},
+	{"co.uk", ".co.uk", "co.uk", true},
+	{"co.uk", "CO.UK", "co.uk", true},
+}
+
+func TestDomainAndTypePublicSuffixes(t *testing.T) {
+	for _, allowPS := range []bool{false, true} {
+		jar := Jar{DomainCookiesOnPublicSuffixes: allowPS}
+		for i, tt := range publicSuffixDomainTests {
+			d, h, _ := jar.domainAndType(tt.inHost, tt.inCookieDomain)
+			if d != tt.outDomain || h != tt.outHostOnly {
+				t.Errorf("%t #%d %q/%q: want %q/%t got %q/%t",
+					allowPS, i, tt.inHost, tt.inCookieDomain,
+					tt.outDomain, tt.outHostOnly, d, h)
+			}
+		}
+	}
+}
+
 var flatCleanupTests = []struct {
 	spec string // E: expired cookie at this position in flat slice
 	exp  string // expected order of cookies after cleanup
@@ -184,3 +382,195 @@ func TestFlatCleanup(t *testing.T) {
 	}
 
 }
+
+var punycodeTests = []struct {
+	in, out string
+}{
+	{"", ""},
+	{"example.com", "example.com"},
+	{"bücher", "xn--bcher-kva"},
+	{"www.bücher.de", "www.xn--bcher-kva.de"},
+	{"münchen.de", "xn--mnchen-3ya.de"},
+	{"ยจฆฟคฏข", "xn--22cdfh1b8fsa"},
+	{"他们为什么不说中文", "xn--ihqwcrb4cv8a8dqg056pqjye"},
+	{"3年b組金八先生", "xn--3b-ww4c5e180e575a65lsy2b"},
+
+	// mapping and normalization
+	{"BÜCHER.de", "xn--bcher-kva.de"},
+	{"bu\u0308cher.de", "xn--bcher-kva.de"},
+	{"ｂüｃｈｅｒ．ｄｅ", "xn--bcher-kva.de"},
+	{"\u1112\u1161\u11ab\u1100\u116e\u11a8", "xn--3e0b707e"},
+	{"한국", "xn--3e0b707e"},
+	{"a\u0302\u0323.test", "xn--zkg.test"},
+	{"a\u0323\u0302.test", "xn--zkg.test"},
+	{"\ufb01.com", "fi.com"},
+	{"ΣΑΣ.gr", "xn--mxa9ab.gr"},
+	{"straße.de", "xn--strae-oqa.de"},
+	{"\u05d01.com", "xn--1-zhc.com"},
+	{"العربية.test", "xn--mgbcd4a2b0d2b.test"},
+
+	// confusables stay distinct
+	{"b\u00fc\u0441her.test", "xn--bher-0ra631c.test"},
+}
+
+var punycodeErrorTests = []struct {
+	in  string
+	err error
+}{
+	{"exa\u00a0mple.com", errDisallowedRune},
+	{"a\u200db.com", errDisallowedRune},
+	{"ü_a.test", errDisallowedRune},
+	{"ü\u2603.com", errDisallowedRune},
+	{"\u0301abc.com", errLeadingMark},
+	{"-ü.de", errBadHyphen},
+	{"ü-.de", errBadHyphen},
+	{"üb--a.de", errBadHyphen},
+	{"\u05d0a.com", errBidiRule},
+	{"1\u05d0.com", errBidiRule},
+	{"\u0627\u0661\u06f1.com", errBidiRule},
+	{"\xff.com", errInvalidUTF8},
+}
+
+func TestPunycodeToASCII(t *testing.T) {
+	for i, tt := range punycodeTests {
+		got, err := punycodeToASCII(tt.in)
+		if err != nil || got != tt.out {
+			t.Errorf("#%d %q: got %q/%v, want %q", i, tt.in, got, err, tt.out)
+		}
+	}
+}
+
+func TestPunycodeToASCIIErrors(t *testing.T) {
+	for i, tt := range punycodeErrorTests {
+		got, err := punycodeToASCII(tt.in)
+		if err != tt.err {
+			t.Errorf("#%d %q: got %q/%v, want error %v", i, tt.in, got, err, tt.err)
+		}
+	}
+}
+
+var updateActionTests = []struct {
+	setCookie string
+	action    updateAction
+}{
+	{"a=1", createCookie},
+	{"a=2", updateCookie},
+	{"b=1; domain=example.org", invalidCookie},
+	{"a=1; max-age=-1", deleteCookie},
+	{"a=1; max-age=-1", noSuchCookie},
+	{"a=1; path=/x", createCookie},
+}
+
+func TestUpdateAction(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		u, _ := url.Parse("http://www.example.com/")
+		for i, tt := range updateActionTests {
+			header := http.Header{"Set-Cookie": {tt.setCookie}}
+			cookie := (&http.Response{Header: header}).Cookies()[0]
+			action := jar.update(u, "www.example.com", "/", cookie)
+			if action != tt.action {
+				t.Errorf("Boxed=%t #%d %q: want %d, got %d",
+					b, i, tt.setCookie, tt.action, action)
+			}
+		}
+	}
+}
+
+func TestPeek(t *testing.T) {
+	now := time.Now()
+	for _, b := range []bool{true, false} {
+		content := newStorage(b)
+		for _, c := range []Cookie{
+			{Name: "a", Domain: "www.example.com", Path: "/"},
+			{Name: "b", Domain: "www.example.com", Path: "/", Expires: now.Add(time.Hour)},
+			{Name: "c", Domain: "www.example.com", Path: "/", Scheme: "https"},
+		} {
+			*content.find(c.Domain, c.Path, c.Name, c.Scheme) = c
+		}
+		var before StorageStats
+		content.stats(&before)
+
+		for i, tt := range []struct {
+			domain, name, scheme string
+			at                   time.Time
+			found                bool
+		}{
+			{"www.example.com", "a", "", now, true},
+			{"www.example.com", "b", "", now, true},
+			{"www.example.com", "b", "", now.Add(2 * time.Hour), false},
+			{"www.example.com", "c", "", now, false},
+			{"www.example.com", "c", "https", now, true},
+			{"www.example.com", "x", "", now, false},
+			{"other.example.com", "a", "", now, false},
+			{"www.other.org", "a", "", now, false},
+		} {
+			c, ok := content.peek(tt.domain, "/", tt.name, tt.scheme, tt.at)
+			if ok != tt.found || ok && c.Name != tt.name || !ok && c != nil {
+				t.Errorf("Boxed=%t #%d: got %v, %t", b, i, c, ok)
+			}
+		}
+
+		var after StorageStats
+		content.stats(&after)
+		if after.Capacity != before.Capacity || after.Cookies != before.Cookies ||
+			len(after.Boxes) != len(before.Boxes) {
+			t.Errorf("Boxed=%t: peek modified storage: %+v -> %+v", b, before, after)
+		}
+	}
+}
+
+var boxKeyTests = []struct{ host, key string }{
+	{"www.bbc.co.uk", "bbc.co.uk"},
+	{"bbc.co.uk", "bbc.co.uk"},
+	{"co.uk", "co.uk"},
+	{"foo.bar.kawasaki.jp", "foo.bar.kawasaki.jp"},
+	{"a.city.kawasaki.jp", "city.kawasaki.jp"},
+	{"com", "com"},
+	{"localhost", "localhost"},
+	{"10.0.0.1", "10.0.0.1"},
+	{"::1", "::1"},
+}
+
+func TestBoxKey(t *testing.T) {
+	for _, tt := range boxKeyTests {
+		if got := boxKey(tt.host); got != tt.key {
+			t.Errorf("boxKey(%q)=%q, want %q", tt.host, got, tt.key)
+		}
+		if etldp1 := EffectiveTLDPlusOne(tt.host); etldp1 != "" && !isIP(tt.host) && etldp1 != tt.key {
+			t.Errorf("boxKey(%q) differs from EffectiveTLDPlusOne %q", tt.host, etldp1)
+		}
+	}
+}
+
+var stripPortTests = []struct{ in, out string }{
+	{"example.com", "example.com"},
+	{"example.com:80", "example.com"},
+	{".example.com:", ".example.com"},
+	{"example.com:8o", "example.com:8o"},
+	{":80", ""},
+}
+
+func TestStripPort(t *testing.T) {
+	for _, tt := range stripPortTests {
+		if got := stripPort(tt.in); got != tt.out {
+			t.Errorf("stripPort(%q)=%q, want %q", tt.in, got, tt.out)
+		}
+	}
+}
+
+var normalizePathTests = []struct{ in, out string }{
+	{"/", "/"},
+	{"/a//b", "/a/b"},
+	{"//a///b//", "/a/b/"},
+	{"/a/./b/../c", "/a/c"},
+	{"/../a", "/a"},
+}
+
+func TestNormalizePath(t *testing.T) {
+	for _, tt := range normalizePathTests {
+		if got := normalizePath(tt.in); got != tt.out {
+			t.Errorf("normalizePath(%q)=%q, want %q", tt.in, got, tt.out)
+		}
+	}
+}
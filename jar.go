@@ -5,16 +5,12 @@
 // Package cookiejar provides a in-memory storage for http cookies.
 //
 // Jar implements the http.CookieJar interface and conforms
-// to RFC 6265 with the one exception: Cookies from internationalized
-// domain names are not handled properly.
+// to RFC 6265.  Internationalized domain names are handled by converting
+// the host of the URL and the domain attribute of a cookie to their
+// ASCII (Punycode) form.
 //
 package cookiejar
 
-// BUG
-// Jar does not handle internationalized domain names (IDN).
-// The Jar should (but does not) transform the domain name of the URL
-// to punycode before matching the domain attribute of a recieved cookie.
-
 import (
 	"errors"
 	"net"
@@ -32,7 +28,7 @@ import (
 // A Jar implements the http.CookieJar interface.
 //
 // Jar keeps all cookies in memory and does not limit the amount of stored
-// cookies.
+// cookies unless MaxCookiesPerHost or MaxCookiesTotal is set.
 // Jar will neither store cookies in a call to SetCookies nor return cookies
 // from a call to Cookies if the URL is a non-HTTP URL.
 // As HTTP would require full qualified domain names in the URL anyway, this
@@ -45,44 +41,280 @@ type Jar struct {
 	// A value <= 0 indicates unlimited storage capacity.
 	MaxBytesPerCookie int
 
+	// MaxPathBytes is the maximum length of the path of a cookie.  Cookies
+	// with a longer path are not stored.
+	// A value <= 0 indicates unlimited path length.
+	MaxPathBytes int
+
+	// RequirePathScope may be set to true to reject cookies whose explicit
+	// Path does not path-match the default path of the request, e.g. a
+	// page at /a/b.html may set cookies for /a and / but not for /c.
+	// RFC 6265 allows any path.
+	RequirePathScope bool
+
+	// NormalizePaths may be set to true to collapse duplicate slashes and
+	// resolve "." and ".." segments in the paths of cookies and requests
+	// before matching, e.g. "/a//b" and "/a/./b" are treated as "/a/b".
+	// By default paths are matched byte by byte as RFC 6265 requires.
+	NormalizePaths bool
+
+	// MaxCookiesPerHost is the maximum number of cookies stored for one
+	// exact domain (e.g. "a.example.com" but not "b.example.com").  If a
+	// new cookie exceeds this limit the least recently used cookie of
+	// this domain is removed.
+	// A value <= 0 indicates no limit.
+	MaxCookiesPerHost int
+
+	// MaxCookiesTotal is the maximum number of cookies stored in the jar.
+	// If a new cookie exceeds this limit the least recently used cookie
+	// is removed.
+	// A value <= 0 indicates no limit.
+	MaxCookiesTotal int
+
+	// MaxDomains is the maximum number of registrable domains (e.g.
+	// "example.com", the boxes of the boxed storage) for which cookies
+	// are stored.  If it is exceeded all cookies of the domain whose
+	// most recently used cookie is least recent are removed.
+	// A value <= 0 indicates no limit.
+	MaxDomains int
+
+	// DeferCleanup may be set to true to skip enforcing MaxCookiesPerHost,
+	// MaxCookiesTotal and MaxDomains when storing new cookies, which speeds
+	// up the ingestion of lots of cookies.  This applies to SetCookies and
+	// to the bulk loaders Import, ImportHAR, LoadReplace, UnmarshalText
+	// and GobDecode.  The jar may then exceed its limits and keep expired
+	// cookies until Cleanup is called.
+	DeferCleanup bool
+
+	// EvictionVeto may be set to protect cookies from being evicted to
+	// enforce MaxCookiesPerHost or MaxCookiesTotal: If it returns true
+	// for a cookie the next least recently used cookie is evicted
+	// instead.  If all candidates are protected the limit is exceeded.
+	// A domain evicted to enforce MaxDomains keeps its protected cookies.
+	EvictionVeto func(c *Cookie) bool
+
+	// MaxFutureExpiry is the maximum lifetime of a persistent cookie.
+	// Cookies expiring later (e.g. in year 9999) are stored with an
+	// expiration time MaxFutureExpiry from now.  This applies to loaded
+	// and imported cookies as well.  NewJar sets it to 10 years.
+	// A value <= 0 indicates unlimited lifetime.
+	MaxFutureExpiry time.Duration
+
+	// ClockSkew is the tolerated difference between the clocks of servers
+	// and the local clock: A cookie is considered expired only ClockSkew
+	// after its expiration time.  A received cookie whose Expires lies at
+	// most ClockSkew in the past is stored instead of being treated as
+	// deletion.  The expiration time itself is stored, saved and exported
+	// as received.  The tolerance applies to the cookies stored or loaded
+	// while ClockSkew is set.  A value <= 0 disables the tolerance.
+	ClockSkew time.Duration
+
+	// SessionTTL turns session cookies into persistent cookies: A cookie
+	// received without Max-Age and Expires is stored with an expiration
+	// time SessionTTL from now and thus survives e.g. MarshalText.
+	// A value <= 0 keeps session cookies as they are.
+	SessionTTL time.Duration
+
+	// LastAccessGranularity reduces the writes to cookies when they are
+	// retrieved: The LastAccess time of a returned cookie is updated only
+	// if it is more than LastAccessGranularity in the past.  Eviction of
+	// the least recently used cookies then becomes inexact by up to this
+	// duration.  A value <= 0 updates LastAccess on every retrieval.
+	LastAccessGranularity time.Duration
+
+	// CacheRetrieval may be set to true to cache the cookies returned
+	// from Cookies until the next modification of the jar.  This speeds
+	// up repeated calls to Cookies for the same URL.
+	CacheRetrieval bool
+
 	// HostCookiesOnIP may be set to true to allow a host cookie
 	// on an IP address.  Host cookies on an IP address are forbidden
 	// by RCF 6265 but most browsers do allow them.
 	HostCookieOnIP bool
 
 	// DomainCookiesOnPublicSuffixes may be set to true to allow domain cookies
-	// on all domains, especially on top level domains and domains
-	// browsers normaly deny domain cookies like co.uk.
+	// on public suffixes browsers normaly deny domain cookies for.
+	// Domain cookies on top level domains and on public suffixes directly
+	// below a top level domain like co.uk are never allowed.
 	// See http://publicsuffix.org/ for detailed information.
 	DomainCookiesOnPublicSuffixes bool
 
-	content storage // our cookies
+	// ExactDomainIsHostOnly may be set to true to treat a cookie whose
+	// domain attribute equals the host it was recieved from (e.g.
+	// "Domain=www.example.com" from www.example.com) as a host cookie.
+	// By default (and like browsers do) such a cookie is a domain cookie
+	// which is sent to subdomains like sub.www.example.com as well.
+	ExactDomainIsHostOnly bool
+
+	// StripPortFromDomain may be set to true to accept a domain attribute
+	// with a port like "Domain=example.com:80" as "example.com".  By
+	// default such cookies are rejected.
+	StripPortFromDomain bool
+
+	// UnderscoreInHost may be set to true to accept hosts containing an
+	// underscore like "my_host.example.com".  Hosts with other characters
+	// than letters, digits, hyphens and dots are always rejected: No
+	// cookies are stored for or returned to them.
+	UnderscoreInHost bool
+
+	// ImportHttpOnly determines the HttpOnly flag of a cookie in Import
+	// which overwrites an already stored cookie.
+	ImportHttpOnly HttpOnlyPolicy
+
+	// ImportResetTimes may be set to true to set Created and LastAccess
+	// of the cookies added by Import to the time of the import instead of
+	// keeping the imported values.
+	ImportResetTimes bool
+
+	// BlockThirdParty may be set to true to block third-party cookies
+	// in SetCookiesForSite and CookiesForSite: Cookies are neither stored
+	// nor sent for hosts whose registrable domain differs from the one of
+	// the top-level site.  SetCookies and Cookies are not affected.
+	BlockThirdParty bool
+
+	// TrackSource may be set to true to record host and path of the
+	// request URL which created or last updated a cookie in the cookie's
+	// Source field.
+	TrackSource bool
+
+	// EnforceSameSiteNoneSecure may be set to true to reject cookies
+	// with SameSite=None which are not marked Secure, like modern
+	// browsers do.
+	EnforceSameSiteNoneSecure bool
+
+	// ProtectSecureCookies may be set to true to keep insecure origins
+	// from interfering with secure cookies like modern browsers do: Over
+	// plain HTTP cookies with the Secure flag are rejected and so are
+	// cookies which would overwrite or shadow a stored Secure cookie of
+	// the same name, e.g. after a redirect from https to http.
+	ProtectSecureCookies bool
+
+	// ImplicitSecureOnHTTPS may be set to true to store all cookies
+	// received over https with the Secure flag, even if the server did not
+	// set it.  By default the Secure attribute of the cookie is kept.
+	ImplicitSecureOnHTTPS bool
+
+	// IsolateByScheme may be set to true to keep cookies recieved over
+	// http and over https apart: A cookie is only sent to requests with
+	// the scheme it was recieved from and the same cookie may be stored
+	// once per scheme.  By default (and as required by RFC 6265) cookies
+	// are shared between http and https, only limited by their Secure
+	// flag.  Cookies stored before IsolateByScheme was set stay shared.
+	IsolateByScheme bool
+
+	// LenientParsing may be set to true to accept Set-Cookie lines in
+	// SetCookieHeader which violate RFC 6265 in common ways: A missing
+	// value, lower case attribute names or a value for Secure.
+	LenientParsing bool
+
+	// PreserveSetOrder may be set to true to return cookies with paths
+	// of equal length from Cookies in the order they were recieved
+	// instead of by creation time.  This keeps the order in which a
+	// server sent several cookies in one response even if they got the
+	// same creation time.
+	PreserveSetOrder bool
+
+	// MaxLoadBytes and MaxLoadCookies limit the amount of data read
+	// by LoadReplace and ImportHAR so that loading an untrusted file
+	// cannot exhaust memory: Reading more than MaxLoadBytes bytes or
+	// more than MaxLoadCookies cookies fails with an error.
+	// A value <= 0 indicates no limit.
+	MaxLoadBytes   int64
+	MaxLoadCookies int
+
+	// ValueCodec may be set to transparently transform cookie values:
+	// Values recieved in SetCookies are encoded before storage and
+	// decoded again before beeing returned from Cookies.
+	// A nil ValueCodec stores values unaltered.
+	ValueCodec ValueCodec
+
+	content    storage // our cookies
+	generation uint64  // incremented on each modification of content
+	cache      retrievalCache
+
+	subscribers     []*subscriber
+	vetoedEvictions int       // see StorageStats.VetoedEvictions
+	seq             uint64    // last Seq assigned to a cookie
+	stamp           time.Time // last time returned by now
+	batching        bool      // limits are enforced after SetCookiesBatch
 
 	sync.Mutex
 }
 
+// HttpOnlyPolicy determines which HttpOnly flag is kept if Import
+// overwrites a stored cookie.
+type HttpOnlyPolicy int
+
+const (
+	HttpOnlyFromSource HttpOnlyPolicy = iota // use the flag of the imported cookie
+	HttpOnlyFromTarget                       // keep the flag of the stored cookie
+)
+
+// A ValueCodec encodes and decodes the value of a cookie.
+// Decode(Encode(v)) must yield v.
+type ValueCodec interface {
+	Encode(value string) string
+	Decode(value string) string
+}
+
+// defaultMaxFutureExpiry is the MaxFutureExpiry of jars set up by NewJar.
+const defaultMaxFutureExpiry = 10 * 365 * 24 * time.Hour
+
 // NewJar sets up an empty cookie jar.
 // A Jar with boxedStorage can handle cookies from lots of different
 // domains more efficient than a Jar with flat storage.
 //
-// The created Jar will allow 4096 bytes for Name plus Value, won't accpet
-// host cookies for IP-addresses and won't accept a domain cookie for a
-// known public suffix domain.
+// The created Jar will allow 4096 bytes for Name plus Value and 1024 bytes
+// for Path, won't accpet host cookies for IP-addresses and won't accept a
+// domain cookie for a known public suffix domain.  Cookies expire at most
+// 10 years in the future.
 func NewJar(boxedStorage bool) *Jar {
 	jar := Jar{
 		MaxBytesPerCookie:             4096,
+		MaxPathBytes:                  1024,
+		MaxFutureExpiry:               defaultMaxFutureExpiry,
 		HostCookieOnIP:                false,
 		DomainCookiesOnPublicSuffixes: false,
 	}
+	jar.content = newStorage(boxedStorage)
+
+	return &jar
+}
+
+// NewDefaultJar sets up an empty cookie jar with boxed storage and the
+// limits recommended by RFC 6265 section 6.1: 4096 bytes per cookie,
+// 50 cookies per host and 3000 cookies in total.  Like browsers it
+// rejects cookies with SameSite=None which are not Secure.  It is the
+// recommended starting point for a browser-like cookie jar.
+func NewDefaultJar() *Jar {
+	jar := NewJar(true)
+	jar.MaxCookiesPerHost = 50
+	jar.MaxCookiesTotal = 3000
+	jar.EnforceSameSiteNoneSecure = true
+	return jar
+}
+
+// NewShardedJar is like NewJar(true) but spreads the boxes of the storage
+// over the given number of maps.  This keeps each map small for jars
+// holding cookies of a very large number of domains; the cookies are
+// handled exactly as with boxed storage.
+func NewShardedJar(shards int) *Jar {
+	if shards < 1 {
+		shards = 1
+	}
+	jar := NewJar(true)
+	jar.content = newSharded(shards)
+	return jar
+}
+
+// newStorage sets up an empty boxed or flat storage.
+func newStorage(boxedStorage bool) storage {
 	if boxedStorage {
 		tmp := make(boxed)
-		jar.content = &tmp
-	} else {
-		tmp := make(flat, 0, 16)
-		jar.content = &tmp
+		return &tmp
 	}
-
-	return &jar
+	tmp := make(flat, 0, 16)
+	return &tmp
 }
 
 // -------------------------------------------------------------------------
@@ -92,158 +324,1128 @@ func NewJar(boxedStorage bool) *Jar {
 // from a request to u.
 //
 // Cookies with len(Name) + len(Value) > MaxBytesPerCookie will be ignored
-// silently as well as any cookie with a malformed domain field.
+// silently as well as any cookie with a malformed domain field or
+// violating the constraints of the "__Secure-" and "__Host-" name
+// prefixes (see draft-ietf-httpbis-rfc6265bis section 4.1.3).  If cookies
+// contains the same cookie several times only the last one is processed.
 func (jar *Jar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	jar.Lock()
+	defer jar.Unlock()
+
+	jar.setCookies(u, cookies)
+}
+
+// CookieBatch are the cookies recieved in a reply for URL.
+type CookieBatch struct {
+	URL     *url.URL
+	Cookies []*http.Cookie
+}
+
+// SetCookiesBatch handles the cookies of several replies like calling
+// SetCookies for each batch in turn but acquires the lock on jar only once.
+// MaxCookiesPerHost, MaxCookiesTotal and MaxDomains are enforced once on
+// the final state after all batches (unless DeferCleanup is set), so a
+// cookie deleted by a later batch does not cause an eviction.
+func (jar *Jar) SetCookiesBatch(batches []CookieBatch) {
+	jar.Lock()
+	defer jar.Unlock()
+
+	jar.batching = true
+	for _, batch := range batches {
+		jar.setCookies(batch.URL, batch.Cookies)
+	}
+	jar.batching = false
+	if !jar.DeferCleanup {
+		jar.invalidate()
+		jar.cleanup()
+	}
+}
+
+// setCookies implements SetCookies.  The caller must hold the lock on jar.
+func (jar *Jar) setCookies(u *url.URL, cookies []*http.Cookie) {
 	if u == nil || !isHTTP(u) {
 		return // this is a strict HTTP only jar
 	}
 
-	host, err := host(u)
+	host, err := jar.host(u)
 	if err != nil {
 		return
 	}
 	defaultpath := defaultPath(u)
 
+	jar.invalidate()
+	overwritten := jar.overwritten(u, host, defaultpath, cookies)
+	for i, cookie := range cookies {
+		if overwritten != nil && overwritten[i] {
+			continue // replaced later in the same batch
+		}
+		jar.update(u, host, defaultpath, cookie)
+	}
+}
+
+// sizeOK reports whether cookie does not exceed MaxBytesPerCookie.
+func (jar *Jar) sizeOK(cookie *http.Cookie) bool {
+	return jar.MaxBytesPerCookie <= 0 ||
+		len(cookie.Name)+len(cookie.Value) <= jar.MaxBytesPerCookie
+}
+
+// batchKey identifies cookies in one call to SetCookies which end up as
+// the same stored cookie.
+type batchKey struct {
+	name, domain, path string
+}
+
+// overwritten reports for each of cookies recieved from u whether it is
+// replaced by a later cookie of the same batch and need not be stored.
+// Only cookies which would be stored can replace others: An invalid or
+// oversized duplicate leaves an earlier valid cookie in effect.  The
+// result is nil if no name occurs twice.
+func (jar *Jar) overwritten(u *url.URL, host, defaultpath string, cookies []*http.Cookie) []bool {
+	if len(cookies) < 2 {
+		return nil
+	}
+	names := make(map[string]bool, len(cookies))
+	duplicates := false
+	for _, cookie := range cookies {
+		duplicates = duplicates || names[cookie.Name]
+		names[cookie.Name] = true
+	}
+	if !duplicates {
+		return nil
+	}
+
+	overwritten := make([]bool, len(cookies))
+	last := make(map[batchKey]int, len(cookies))
+	for i, cookie := range cookies {
+		domain, _, path, ok := jar.accept(u, host, defaultpath, cookie)
+		if !ok {
+			continue
+		}
+		key := batchKey{cookie.Name, domain, path}
+		if j, ok := last[key]; ok {
+			overwritten[j] = true
+		}
+		last[key] = i
+	}
+	return overwritten
+}
+
+// SetCookies handles the receipt of the cookies in a reply for the given URL.
+func (jar *Jar) Cookies(u *url.URL) []*http.Cookie {
+	return jar.CookiesSorted(u, SortRFC)
+}
+
+// -------------------------------------------------------------------------
+// Other exported methods
+
+// SortOrder determines the order of the cookies returned by CookiesSorted.
+type SortOrder int
+
+const (
+	SortRFC        SortOrder = iota // longer paths first, then by creation time (RFC 6265)
+	SortName                        // alphabetical by name
+	SortCreated                     // by creation time, oldest first
+	SortPrecedence                  // host cookies, more specific domains, longer paths, newer first
+)
+
+// CookiesSorted is like Cookies but returns the cookies in the given order
+// which might help with servers which cannot handle the order mandated by
+// RFC 6265.
+func (jar *Jar) CookiesSorted(u *url.URL, by SortOrder) []*http.Cookie {
+	return jar.cookies(u, by, nil)
+}
+
+// SessionCookies is like Cookies but returns only the session cookies.
+func (jar *Jar) SessionCookies(u *url.URL) []*http.Cookie {
+	return jar.cookies(u, SortRFC, (*Cookie).Session)
+}
+
+// PersistentCookies is like Cookies but returns only the persistent
+// (non-session) cookies.
+func (jar *Jar) PersistentCookies(u *url.URL) []*http.Cookie {
+	return jar.cookies(u, SortRFC, func(c *Cookie) bool { return !c.Session() })
+}
+
+// FilterOpts selects the cookies returned by CookiesFiltered.  The zero
+// value selects all cookies Cookies would return.
+type FilterOpts struct {
+	Secure            bool // only cookies with the Secure flag
+	HttpOnly          bool // only cookies with the HttpOnly flag
+	ExcludeSession    bool // no session cookies
+	ExcludePersistent bool // no persistent cookies
+}
+
+// keep reports whether cookie is selected by opts.
+func (opts FilterOpts) keep(cookie *Cookie) bool {
+	switch {
+	case opts.Secure && !cookie.Secure,
+		opts.HttpOnly && !cookie.HttpOnly,
+		opts.ExcludeSession && cookie.Session(),
+		opts.ExcludePersistent && !cookie.Session():
+		return false
+	}
+	return true
+}
+
+// CookiesFiltered is like Cookies but returns only the cookies selected
+// by opts.  The order of Cookies is kept.
+func (jar *Jar) CookiesFiltered(u *url.URL, opts FilterOpts) []*http.Cookie {
+	return jar.cookies(u, SortRFC, opts.keep)
+}
+
+// ExpiringSoon is like Cookies but returns only the persistent cookies
+// which expire within the given duration from now.  It may be used to
+// refresh e.g. an authentication cookie before it expires.
+func (jar *Jar) ExpiringSoon(u *url.URL, within time.Duration) []*http.Cookie {
+	deadline := time.Now().Add(within)
+	return jar.cookies(u, SortRFC, func(c *Cookie) bool {
+		return !c.Session() && !c.Expires.After(deadline)
+	})
+}
+
+// CookiesAt is like Cookies but uses now instead of the current time to
+// decide which cookies are expired and as their LastAccess time.  This
+// allows reproducible snapshots of the jar.  The retrieval cache is not
+// used.
+func (jar *Jar) CookiesAt(u *url.URL, now time.Time) []*http.Cookie {
+	return jar.appendCookies(nil, u, SortRFC, nil, now)
+}
+
+// CookiesTopN is like Cookies but returns at most n cookies: Those sent
+// first according to RFC 6265, i.e. the ones with the longest paths.
+// Only the returned cookies count as accessed.
+func (jar *Jar) CookiesTopN(u *url.URL, n int) []*http.Cookie {
+	jar.Lock()
+	defer jar.Unlock()
+
+	cookies, ok := jar.selectCookies(u, SortRFC, nil, time.Time{})
+	if !ok {
+		return nil
+	}
+	if n < 0 {
+		n = 0
+	}
+	if len(cookies) > n {
+		cookies = cookies[:n]
+	}
+	return jar.emit(nil, cookies, time.Time{})
+}
+
+// CookiesDetailed is like Cookies but also fills in the Domain and Path of
+// the stored cookie each returned cookie stems from (with a leading dot
+// for domain cookies).  It is meant for debugging: A request must not be
+// given these cookies as Domain and Path are never sent to a server.
+func (jar *Jar) CookiesDetailed(u *url.URL) []*http.Cookie {
+	jar.Lock()
+	defer jar.Unlock()
+
+	cookies, ok := jar.selectCookies(u, SortRFC, nil, time.Time{})
+	if !ok {
+		return nil
+	}
+	detailed := jar.emit(nil, cookies, time.Time{})
+	for i, cookie := range cookies {
+		detailed[i].Domain = cookie.displayDomain()
+		detailed[i].Path = cookie.Path
+	}
+	return detailed
+}
+
+// CookiesExplain is like Cookies but if no cookies are returned it also
+// reports why, e.g. "no cookies for host" or "all matches are Secure and
+// request is http".  The reason is meant for humans debugging a client
+// and is empty if cookies are returned.
+func (jar *Jar) CookiesExplain(u *url.URL) ([]*http.Cookie, string) {
+	if !isHTTP(u) {
+		return nil, "non-http scheme"
+	}
+	host, err := jar.host(u)
+	if err != nil {
+		return nil, "invalid host: " + err.Error()
+	}
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	// collect the candidates before selectCookies removes expired ones
+	now := time.Now()
+	stored := append(jar.content.all(), jar.content.expired(now)...)
+
+	cookies, _ := jar.selectCookies(u, SortRFC, nil, time.Time{})
+	if len(cookies) > 0 {
+		return jar.emit(nil, cookies, time.Time{}), ""
+	}
+	return nil, explainEmpty(stored, isSecure(u), host, jar.requestPath(u), now)
+}
+
+// explainEmpty determines why none of the stored cookies are sent in a
+// request to host/path at time now.
+func explainEmpty(stored []*Cookie, https bool, host, path string, now time.Time) string {
+	var domainMatches, pathMatches, live, secureOK int
+	for _, cookie := range stored {
+		if !cookie.domainMatch(host) {
+			continue
+		}
+		domainMatches++
+		if !cookie.pathMatch(path) {
+			continue
+		}
+		pathMatches++
+		if cookie.expiredAt(now) {
+			continue
+		}
+		live++
+		if secureEnough(cookie.Secure, https) {
+			secureOK++
+		}
+	}
+	switch {
+	case domainMatches == 0:
+		return "no cookies for host"
+	case pathMatches == 0:
+		return "no cookies for path"
+	case live == 0:
+		return "all matches are expired"
+	case secureOK == 0:
+		return "all matches are Secure and request is http"
+	}
+	return "all matches are bound to the other scheme"
+}
+
+// cookies retrieves the cookies to send to u in order by (see
+// appendCookies).
+func (jar *Jar) cookies(u *url.URL, by SortOrder, keep func(*Cookie) bool) []*http.Cookie {
+	return jar.appendCookies(nil, u, by, keep, time.Time{})
+}
+
+// AppendCookies appends the cookies Cookies(u) would return to dst and
+// returns the extended slice.  Reusing dst across calls avoids allocating
+// a new slice for each request.
+func (jar *Jar) AppendCookies(dst []*http.Cookie, u *url.URL) []*http.Cookie {
+	return jar.appendCookies(dst, u, SortRFC, nil, time.Time{})
+}
+
+// appendCookies is the workhorse of all the retrieval methods.  It appends
+// the cookies to send to u in order by to dst.  If keep is non-nil only the
+// cookies for which keep returns true are appended.  A zero now means the
+// current time.
+func (jar *Jar) appendCookies(dst []*http.Cookie, u *url.URL, by SortOrder, keep func(*Cookie) bool, now time.Time) []*http.Cookie {
+	jar.Lock()
+	defer jar.Unlock()
+
+	cookies, ok := jar.selectCookies(u, by, keep, now)
+	if !ok {
+		return dst
+	}
+	return jar.emit(dst, cookies, now)
+}
+
+// emit appends cookies as http.Cookies to dst and updates their LastAccess
+// time to now.  If now is zero jar.now() is used so that the cookies are
+// newer than all restored ones.  It must be called with jar locked.
+func (jar *Jar) emit(dst []*http.Cookie, cookies []*Cookie, now time.Time) []*http.Cookie {
+	if dst == nil {
+		dst = make([]*http.Cookie, 0, len(cookies))
+	}
+	stamped := now.IsZero()
+	if stamped {
+		now = jar.now()
+	}
+	for _, cookie := range cookies {
+		value := cookie.Value
+		if jar.ValueCodec != nil {
+			value = jar.ValueCodec.Decode(value)
+		}
+		dst = append(dst, &http.Cookie{Name: cookie.Name, Value: value})
+
+		// update last access with a strictly increasing timestamp
+		if jar.LastAccessGranularity <= 0 ||
+			now.Sub(cookie.LastAccess) > jar.LastAccessGranularity {
+			cookie.LastAccess = now
+		}
+		now = now.Add(time.Nanosecond)
+	}
+	if stamped {
+		jar.stamp = now // beyond the last stamp used above
+	}
+
+	return dst
+}
+
+// selectCookies returns the stored cookies to send to u at time now in
+// order by.  It must be called with jar locked and does not modify the
+// cookies.  Arguments are as in appendCookies.  ok is false if u is not
+// a HTTP URL with a valid host.
+func (jar *Jar) selectCookies(u *url.URL, by SortOrder, keep func(*Cookie) bool, now time.Time) (cookies []*Cookie, ok bool) {
+	if !isHTTP(u) {
+		return nil, false // this is a strict HTTP only jar
+	}
+
+	// set up host, path and secure
+	host, err := jar.host(u)
+	if err != nil {
+		return nil, false
+	}
+
+	https := isSecure(u)
+	path := jar.requestPath(u)
+
+	cookies = jar.retrieveSorted(https, host, path, now)
+	if keep != nil {
+		selection := make([]*Cookie, 0, len(cookies))
+		for _, cookie := range cookies {
+			if keep(cookie) {
+				selection = append(selection, cookie)
+			}
+		}
+		cookies = selection
+	}
+	switch by {
+	case SortRFC:
+		if jar.PreserveSetOrder {
+			cookies = append([]*Cookie(nil), cookies...)
+			sort.Stable(seqList(cookies))
+		}
+	case SortName:
+		cookies = append([]*Cookie(nil), cookies...)
+		sort.Stable(nameList(cookies))
+	case SortCreated:
+		cookies = append([]*Cookie(nil), cookies...)
+		sort.Stable(createdList(cookies))
+	case SortPrecedence:
+		cookies = append([]*Cookie(nil), cookies...)
+		sort.Stable(precedenceList(cookies))
+	}
+	return cookies, true
+}
+
+// SetCookiesForSite is like SetCookies for a request to u made while
+// visiting topLevelSite.  If BlockThirdParty is set and u is third-party
+// relative to topLevelSite the cookies are not stored.
+func (jar *Jar) SetCookiesForSite(u *url.URL, topLevelSite string, cookies []*http.Cookie) {
+	if u == nil {
+		return
+	}
+	if jar.BlockThirdParty {
+		host, err := jar.host(u)
+		if err != nil || jar.IsThirdParty(host, topLevelSite) {
+			return
+		}
+	}
+	jar.SetCookies(u, cookies)
+}
+
+// CookiesForSite is like Cookies for a request to u made while visiting
+// topLevelSite.  If BlockThirdParty is set third-party cookies are not
+// returned.
+func (jar *Jar) CookiesForSite(u *url.URL, topLevelSite string) []*http.Cookie {
+	if !jar.BlockThirdParty {
+		return jar.Cookies(u)
+	}
+	return jar.cookies(u, SortRFC, func(c *Cookie) bool {
+		return !jar.IsThirdParty(c.Domain, topLevelSite)
+	})
+}
+
+// RequestContext describes the circumstances of a request which decide
+// about sending cookies with a SameSite attribute.
+type RequestContext struct {
+	// TopLevelSite is the host of the page in the browser's address bar.
+	// An empty TopLevelSite denotes a request not initiated by a page
+	// (e.g. a typed URL) which is same-site.
+	TopLevelSite string
+
+	// IsTopLevelNavigation is true if the request loads a new page
+	// into the top-level browsing context, false for subresources,
+	// frames and scripted requests.
+	IsTopLevelNavigation bool
+
+	// Method is the HTTP method of the request, "" means "GET".
+	Method string
+}
+
+// CookiesForRequestContext is like CookiesForSite for a request to u in
+// context ctx but also honours the SameSite attribute of the cookies: If
+// u is cross-site to ctx.TopLevelSite, SameSite=Strict cookies are never
+// sent and SameSite=Lax cookies only on top-level navigations with method
+// GET or HEAD.
+func (jar *Jar) CookiesForRequestContext(u *url.URL, ctx RequestContext) []*http.Cookie {
+	host, err := jar.host(u)
+	if err != nil {
+		return nil
+	}
+	crossSite := ctx.TopLevelSite != "" && jar.IsThirdParty(host, ctx.TopLevelSite)
+	return jar.cookies(u, SortRFC, func(c *Cookie) bool {
+		if jar.BlockThirdParty && ctx.TopLevelSite != "" &&
+			jar.IsThirdParty(c.Domain, ctx.TopLevelSite) {
+			return false
+		}
+		return c.sameSiteMatch(crossSite, ctx.IsTopLevelNavigation, ctx.Method)
+	})
+}
+
+// ThirdPartyCookies is like Cookies but returns only the cookies which are
+// third-party cookies if u is loaded as part of the site topLevelSite
+// (see IsThirdParty).
+func (jar *Jar) ThirdPartyCookies(u *url.URL, topLevelSite string) []*http.Cookie {
+	return jar.cookies(u, SortRFC, func(c *Cookie) bool {
+		return jar.IsThirdParty(c.Domain, topLevelSite)
+	})
+}
+
+// IsThirdParty reports whether a cookie for cookieHost is a third-party
+// cookie on the site topLevelSite, i.e. whether the registrable domains
+// (effective TLD plus one) of the two hosts differ.
+func (jar *Jar) IsThirdParty(cookieHost, topLevelSite string) bool {
+	return site(cookieHost) != site(topLevelSite)
+}
+
+// site returns the registrable domain of host.  For hosts which are
+// public suffixes or IP addresses the (canonical) host itself is returned.
+func site(host string) string {
+	host, _ = canonicalDomain(host)
+	if ascii, err := punycodeToASCII(host); err == nil {
+		host = ascii
+	}
+	if isIP(host) {
+		return host
+	}
+	if etldp1 := EffectiveTLDPlusOne(host); etldp1 != "" {
+		return etldp1
+	}
+	return host
+}
+
+// CookiesForURLString is like Cookies but takes the URL as a string.
+// An error is returned if raw cannot be parsed or is not a HTTP(S) URL.
+func (jar *Jar) CookiesForURLString(raw string) ([]*http.Cookie, error) {
+	u, err := parseHTTPURL(raw)
+	if err != nil {
+		return nil, err
+	}
+	return jar.Cookies(u), nil
+}
+
+// SetCookiesForURLString is like SetCookies but takes the URL as a string.
+// An error is returned if raw cannot be parsed or is not a HTTP(S) URL.
+func (jar *Jar) SetCookiesForURLString(raw string, cookies []*http.Cookie) error {
+	u, err := parseHTTPURL(raw)
+	if err != nil {
+		return err
+	}
+	jar.SetCookies(u, cookies)
+	return nil
+}
+
+// parseHTTPURL parses raw which must be a HTTP or HTTPS URL.
+func parseHTTPURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !isHTTP(u) {
+		return nil, errNonHTTPURL
+	}
+	if u.Host == "" {
+		return nil, errNoHost
+	}
+	return u, nil
+}
+
+// AttachCookies adds the cookies jar would send in a request to r.URL
+// to the Cookie header of r, just like a http.Client would do.
+func (jar *Jar) AttachCookies(r *http.Request) {
+	for _, cookie := range jar.Cookies(r.URL) {
+		r.AddCookie(cookie)
+	}
+}
+
+// HasCookies reports whether a call to Cookies(u) would return at least
+// one cookie.  Unlike Cookies it does not sort the cookies and does not
+// update their LastAccess time.
+func (jar *Jar) HasCookies(u *url.URL) bool {
+	if !isHTTP(u) {
+		return false
+	}
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	host, err := jar.host(u)
+	if err != nil {
+		return false
+	}
+
+	path := jar.requestPath(u)
+
+	return jar.content.contains(isSecure(u), host, path)
+}
+
+// CookieMap returns the names and values of the cookies Cookies(u) would
+// return.  If several cookies have the same name, only the most specific
+// one (longest path, then newest) is contained.  Unlike Cookies it does
+// not update the LastAccess time of the cookies.
+func (jar *Jar) CookieMap(u *url.URL) map[string]string {
+	return jar.cookieMap(u, func(c, b *Cookie) bool {
+		return len(c.Path) == len(b.Path) && c.Created.After(b.Created)
+	})
+}
+
+// CookieMapPrecedence is like CookieMap but if several cookies have the
+// same name it contains the one which comes first in SortPrecedence:
+// A host cookie is preferred over a domain cookie, a more specific domain
+// over a less specific one, then the longest path and then the newest.
+func (jar *Jar) CookieMapPrecedence(u *url.URL) map[string]string {
+	return jar.cookieMap(u, precedes)
+}
+
+// cookieMap implements CookieMap: If several cookies have the same name
+// the first one in RFC order for which no other cookie is better wins.
+func (jar *Jar) cookieMap(u *url.URL, better func(c, b *Cookie) bool) map[string]string {
+	m := make(map[string]string)
+	if !isHTTP(u) {
+		return m
+	}
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	host, err := jar.host(u)
+	if err != nil {
+		return m
+	}
+
+	path := jar.requestPath(u)
+
+	best := make(map[string]*Cookie)
+	for _, cookie := range jar.retrieveSorted(isSecure(u), host, path, time.Time{}) {
+		b, ok := best[cookie.Name]
+		if !ok || better(cookie, b) {
+			best[cookie.Name] = cookie
+		}
+	}
+	for name, cookie := range best {
+		value := cookie.Value
+		if jar.ValueCodec != nil {
+			value = jar.ValueCodec.Decode(value)
+		}
+		m[name] = value
+	}
+	return m
+}
+
+// AllCookiesForHost returns copies of all non-expired cookies which
+// domain-match host, regardless of their Path and Secure flag, in the
+// order of RFC 6265.  It is intended for exporting or inspecting the
+// jar; use Cookies to determine which cookies to send in a request.
+func (jar *Jar) AllCookiesForHost(hostname string) []*Cookie {
+	hostname, err := jar.host(&url.URL{Host: hostname})
+	if err != nil {
+		return nil
+	}
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	selection := make([]*Cookie, 0)
+	for _, cookie := range jar.content.all() {
+		if cookie.domainMatch(hostname) {
+			c := *cookie
+			selection = append(selection, &c)
+		}
+	}
+	sort.Sort(sendList(selection))
+	return selection
+}
+
+// All returns a copy of all non-expired cookies in the jar.  Values are
+// returned as stored, i.e. encoded by ValueCodec.
+func (jar *Jar) All() []Cookie {
+	all := jar.content.all()
+	cookies := make([]Cookie, len(all))
+	for i, cookie := range all {
+		cookies[i] = *cookie
+	}
+	return cookies
+}
+
+// cookieKey identifies a stored cookie.
+type cookieKey struct {
+	domain, path, name, scheme string
+}
+
+func keyOf(c *Cookie) cookieKey {
+	return cookieKey{c.Domain, c.Path, c.Name, c.Scheme}
+}
+
+// Diff compares the non-expired cookies of jar and other by domain, path
+// and name (and scheme if IsolateByScheme is used).  It returns copies of
+// the cookies only present in jar, of those only present in other and of
+// the cookies of jar whose value or expiration time differs from their
+// counterpart in other.  Each list is sorted by domain, path and name.
+func (jar *Jar) Diff(other *Jar) (onlyHere, onlyThere, changed []*Cookie) {
+	jar.Lock()
+	here := jar.All()
+	jar.Unlock()
+	other.Lock()
+	there := other.All()
+	other.Unlock()
+
+	index := make(map[cookieKey]*Cookie, len(there))
+	for i := range there {
+		index[keyOf(&there[i])] = &there[i]
+	}
+	for i := range here {
+		c := &here[i]
+		o, ok := index[keyOf(c)]
+		if !ok {
+			onlyHere = append(onlyHere, c)
+			continue
+		}
+		delete(index, keyOf(c))
+		if c.Value != o.Value || !c.Expires.Equal(o.Expires) {
+			changed = append(changed, c)
+		}
+	}
+	for _, o := range index {
+		onlyThere = append(onlyThere, o)
+	}
+
+	sort.Sort(keyList(onlyHere))
+	sort.Sort(keyList(onlyThere))
+	sort.Sort(keyList(changed))
+	return onlyHere, onlyThere, changed
+}
+
+// Add adds all non-expired elements of cookies to the jar.  Expired cookies
+// are silently ignored.  If a cookie is already present in the jar it will
+// be overwritten.  The LastAccess field of the given cookies are not modified.
+func (jar *Jar) Add(cookies []Cookie) {
+	jar.Lock()
+	defer jar.Unlock()
+
+	jar.invalidate()
+	for _, cookie := range cookies {
+		if jar.expired(&cookie) {
+			continue
+		}
+		c := jar.content.find(cookie.Domain, cookie.Path, cookie.Name, cookie.Scheme)
+		*c = cookie
+		jar.restore(c)
+	}
+}
+
+// NewCookie returns a session cookie for use with Import.  The domain
+// given as host is canonicalized: A leading dot yields a domain cookie,
+// otherwise a host cookie is created; the domain is converted to lower
+// case and Punycode.  An empty path or a path not starting with "/" is
+// replaced by "/".  The value is encoded by ValueCodec.  NewCookie
+// returns nil for an empty or malformed host.
+func (jar *Jar) NewCookie(host, path, name, value string) *Cookie {
+	domain, dotted := canonicalDomain(host)
+	domain, err := punycodeToASCII(domain)
+	if err != nil || domain == "" {
+		return nil
+	}
+	if path == "" || path[0] != '/' {
+		path = "/"
+	}
+	if jar.ValueCodec != nil {
+		value = jar.ValueCodec.Encode(value)
+	}
+	now := time.Now()
+	return &Cookie{
+		Name:       name,
+		Value:      value,
+		Domain:     domain,
+		Path:       path,
+		HostOnly:   !dotted,
+		Created:    now,
+		LastAccess: now,
+	}
+}
+
+// Import adds cookies to the jar like Add but validates each cookie first:
+// Cookies without name, with an empty or malformed Domain, a Path not
+// starting with "/", expired cookies, cookies exceeding MaxBytesPerCookie
+// and cookies violating the constraints of the "__Secure-" and "__Host-"
+// name prefixes are rejected.  Domain and HostOnly of the cookies are
+// trusted.  If a cookie overwrites a stored one its HttpOnly flag is
+// determined by ImportHttpOnly.  Created and LastAccess are kept unless
+// ImportResetTimes is set.  Values are taken as stored, i.e. already
+// encoded by ValueCodec like the values returned by All; use NewCookie
+// to create cookies from plain values.  Afterwards jar is cleaned up once
+// like by Cleanup unless DeferCleanup is set.  The number of imported and
+// rejected cookies is returned.
+func (jar *Jar) Import(cookies []*Cookie) (imported, rejected int) {
+	jar.Lock()
+	defer jar.Unlock()
+
+	jar.invalidate()
+	now := time.Now()
+	for _, cookie := range cookies {
+		if !jar.valid(cookie) {
+			rejected++
+			continue
+		}
+		c := jar.content.find(cookie.Domain, cookie.Path, cookie.Name, cookie.Scheme)
+		existing := c.Name != "" && !c.Expired()
+		httpOnly := c.HttpOnly
+		*c = *cookie
+		if existing && jar.ImportHttpOnly == HttpOnlyFromTarget {
+			c.HttpOnly = httpOnly
+		}
+		if jar.ImportResetTimes {
+			// strictly increasing to keep the order of cookies
+			c.Created, c.LastAccess = now, now
+			now = now.Add(time.Nanosecond)
+		}
+		jar.restore(c)
+		imported++
+	}
+
+	if !jar.DeferCleanup {
+		jar.tidy()
+	}
+	return imported, rejected
+}
+
+// valid checks whether cookie may be imported into jar.
+func (jar *Jar) valid(cookie *Cookie) bool {
+	switch {
+	case cookie == nil, cookie.Name == "", jar.expired(cookie):
+		return false
+	case cookie.Domain == "", cookie.Domain[0] == '.',
+		cookie.Domain[len(cookie.Domain)-1] == '.',
+		cookie.Domain != strings.ToLower(cookie.Domain):
+		return false
+	case cookie.Path == "", cookie.Path[0] != '/':
+		return false
+	case cookie.Scheme != "" && cookie.Scheme != "http" && cookie.Scheme != "https":
+		return false
+	case !cookie.prefixOK():
+		return false
+	case jar.MaxBytesPerCookie > 0 &&
+		len(cookie.Name)+len(cookie.Value) > jar.MaxBytesPerCookie:
+		return false
+	}
+	return true
+}
+
+// DeleteMatching deletes all cookies whose Domain is domainSuffix or a
+// subdomain of domainSuffix, whose Path is pathPrefix or lies below it and
+// whose Name is name.  Paths are compared by whole segments like in
+// RFC 6265 path matching: A pathPrefix of "/foo" covers "/foo" and
+// "/foo/bar" but not "/foobar".  An empty domainSuffix, pathPrefix or name
+// matches any cookie.  The number of deleted cookies is returned; expired
+// cookies which are still stored do not count and no EventDelete is
+// published for them.
+func (jar *Jar) DeleteMatching(domainSuffix, pathPrefix, name string) int {
+	domainSuffix, _ = canonicalDomain(domainSuffix)
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	jar.invalidate()
+	return jar.remove(func(c *Cookie) bool {
+		return (domainSuffix == "" || c.Domain == domainSuffix ||
+			strings.HasSuffix(c.Domain, "."+domainSuffix)) &&
+			(pathPrefix == "" || underPath(c.Path, pathPrefix)) &&
+			(name == "" || c.Name == name) && !c.Expired()
+	}, EventDelete)
+}
+
+// ConsolidateName removes all but the most specific of the cookies named
+// name which would be sent to u, i.e. it keeps only the one which comes
+// first in SortPrecedence: A host cookie is kept over a domain cookie, a
+// more specific domain over a less specific one, then the longest path
+// and then the newest.  The number of removed cookies is returned.
+//
+// RFC 6265 identifies a cookie by its name, domain, host-only flag and
+// path, so a host cookie and a domain cookie of the same name are two
+// distinct cookies which both are sent, and SetCookies never merges them.
+// ConsolidateName is meant for applications which know that a server
+// uses only one cookie of this name.
+func (jar *Jar) ConsolidateName(u *url.URL, name string) int {
+	if !isHTTP(u) {
+		return 0
+	}
+	host, err := jar.host(u)
+	if err != nil {
+		return 0
+	}
+	path := jar.requestPath(u)
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	var best *Cookie
+	for _, cookie := range jar.content.all() {
+		if cookie.Name == name && cookie.domainMatch(host) && cookie.pathMatch(path) &&
+			(best == nil || precedes(cookie, best)) {
+			best = cookie
+		}
+	}
+	if best == nil {
+		return 0
+	}
+
+	jar.invalidate()
+	return jar.remove(func(c *Cookie) bool {
+		return c != best && c.Name == name && !c.Expired() &&
+			c.domainMatch(host) && c.pathMatch(path)
+	}, EventDelete)
+}
+
+// ExpiredCookies returns copies of the cookies in jar which are expired
+// but still stored because expired cookies are removed lazily.  It may
+// help to debug the memory usage of jar, see also Cleanup.
+func (jar *Jar) ExpiredCookies() []*Cookie {
 	jar.Lock()
 	defer jar.Unlock()
 
-	for _, cookie := range cookies {
-		if jar.MaxBytesPerCookie > 0 && len(cookie.Name)+len(cookie.Value) > jar.MaxBytesPerCookie {
-			continue
-		}
-		jar.update(host, defaultpath, cookie)
+	expired := jar.content.expired(time.Now())
+	cookies := make([]*Cookie, len(expired))
+	for i, cookie := range expired {
+		c := *cookie
+		cookies[i] = &c
 	}
+	sort.Sort(keyList(cookies))
+	return cookies
 }
 
-// SetCookies handles the receipt of the cookies in a reply for the given URL.
-func (jar *Jar) Cookies(u *url.URL) []*http.Cookie {
-	if !isHTTP(u) {
-		return nil // this is a strict HTTP only jar
-	}
-
+// ClearSession removes all session cookies from jar like a browser does
+// when it is closed and returns the number of removed cookies.
+// Persistent cookies are kept.
+func (jar *Jar) ClearSession() int {
 	jar.Lock()
 	defer jar.Unlock()
 
-	// set up host, path and secure
-	host, err := host(u)
+	jar.invalidate()
+	return jar.remove((*Cookie).Session, EventDelete)
+}
+
+// DomainKey returns the key under which boxed storage groups the cookies
+// for hostname: Its effective TLD plus one (e.g. "bbc.co.uk" for
+// "www.bbc.co.uk") or hostname itself if it has none, e.g. for a single
+// label host or an IP address.  All cookies whose Domain yields the same
+// key are stored in the same box.
+func (jar *Jar) DomainKey(hostname string) string {
+	hostname, err := jar.host(&url.URL{Host: hostname})
 	if err != nil {
-		return nil
+		return ""
 	}
+	return boxKey(hostname)
+}
 
-	https := isSecure(u)
-	path := u.Path
-	if path == "" {
-		path = "/"
-	}
+// StorageStats reports how much of the storage of jar is in use.  A big
+// difference between Capacity and Cookies indicates memory which is not
+// returned after deleting lots of cookies.
+func (jar *Jar) StorageStats() StorageStats {
+	jar.Lock()
+	defer jar.Unlock()
 
-	cookies := jar.content.retrieve(https, host, path)
-	sort.Sort(sendList(cookies))
+	var s StorageStats
+	jar.content.stats(&s)
+	s.VetoedEvictions = jar.vetoedEvictions
+	return s
+}
 
-	// fill into slice of http.Cookies and update LastAccess time
-	now := time.Now()
-	httpCookies := make([]*http.Cookie, len(cookies))
-	for i, cookie := range cookies {
-		httpCookies[i] = &http.Cookie{Name: cookie.Name, Value: cookie.Value}
+// CheckInvariants verifies the consistency of the storage of jar: Every
+// cookie is stored once, in the box of its domain, live cookies have a
+// name and the valid entries of the retrieval cache hold as many cookies
+// as the storage would return.  It is meant to be called in tests and
+// fuzzing harnesses after operations on jar; a non-nil result indicates
+// a bug in this package.
+func (jar *Jar) CheckInvariants() error {
+	jar.Lock()
+	defer jar.Unlock()
 
-		// update last access with a strictly increasing timestamp
-		cookie.LastAccess = now
-		now = now.Add(time.Nanosecond)
+	if err := jar.content.check(); err != nil {
+		return err
+	}
+	if jar.cache.generation != jar.generation {
+		return nil // all cached entries are stale
+	}
+	now := time.Now()
+	all := jar.content.all()
+	for key, entry := range jar.cache.entries {
+		if !entry.expires.IsZero() && !entry.expires.After(now) {
+			continue
+		}
+		n := 0
+		for _, cookie := range all {
+			if cookie.shouldSend(key.https, key.host, key.path) {
+				n++
+			}
+		}
+		if n != len(entry.cookies) {
+			return errCountMismatch
+		}
 	}
+	return nil
+}
 
-	return httpCookies
+// ApproxMemoryBytes estimates the heap memory used by the cookies stored
+// in jar, including expired ones not yet removed.  It sums the sizes of
+// the cookies and their strings and the overhead of the storage.  The
+// result is an estimate for capacity planning, not an exact figure.
+func (jar *Jar) ApproxMemoryBytes() int64 {
+	return jar.StorageStats().Bytes
 }
 
-// -------------------------------------------------------------------------
-// Other exported methods
+// Compact releases the memory of deleted and expired cookies by shrinking
+// the storage of jar to its minimal size.  As this copies all cookies it
+// should be called only after lots of cookies have been deleted.
+func (jar *Jar) Compact() {
+	jar.Lock()
+	defer jar.Unlock()
 
-// All returns a copy of all non-expired cookies in the jar.
-func (jar *Jar) All() []Cookie {
-	if b, ok := jar.content.(*boxed); ok {
-		cookies := make([]Cookie, 0, 32)
-		for _, f := range *b {
-			for _, cookie := range *f {
-				if cookie.Expired() {
-					continue
-				}
-				cookies = append(cookies, *cookie)
-			}
-		}
-		return cookies
-	} else {
-		f := jar.content.(*flat)
-		cookies := make([]Cookie, 0, len(*f))
-		for _, cookie := range *f {
-			if cookie.Expired() {
-				continue
-			}
-			cookies = append(cookies, *cookie)
-		}
-		return cookies
+	jar.content.compact()
+	jar.invalidate()
+}
+
+// ReplaceStorage switches jar to boxed or flat storage (see NewJar).
+// All non-expired cookies are kept with all their fields, including
+// Created and LastAccess.
+func (jar *Jar) ReplaceStorage(boxedStorage bool) {
+	jar.Lock()
+	defer jar.Unlock()
+
+	all := jar.All()
+	content := newStorage(boxedStorage)
+	for _, cookie := range all {
+		c := content.find(cookie.Domain, cookie.Path, cookie.Name, cookie.Scheme)
+		*c = cookie
 	}
-	panic("Not reached")
+	jar.content = content
+	jar.invalidate()
 }
 
-// Add adds all non-expired elements of cookies to the jar.  Expired cookies
-// are silently ignored.  If a cookie is already present in the jar it will
-// be overwritten.  The LastAccess field of the given cookies are not modified.
-func (jar *Jar) Add(cookies []Cookie) {
-	for _, cookie := range cookies {
-		if cookie.Expired() {
-			continue
+// Get returns a copy of the stored cookie identified by domain, path and
+// name.  The domain is canonicalized like in Remove.  If there is no such
+// non-expired cookie, Get returns false.  Unlike Cookies it does not
+// update the LastAccess time of the cookie.
+func (jar *Jar) Get(domain, path, name string) (*Cookie, bool) {
+	domain, _ = canonicalDomain(domain)
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	now := time.Now()
+	for _, scheme := range []string{"", "http", "https"} {
+		if cookie, ok := jar.content.peek(domain, path, name, scheme, now); ok {
+			c := *cookie
+			return &c, true
 		}
-		c := jar.content.find(cookie.Domain, cookie.Path, cookie.Name)
-		*c = cookie
 	}
+	return nil, false
 }
 
 // Remove deletes the cookie identified by domain, path and name from jar.
-// The function returns true if the cookie was present in the jar.
+// Cookies isolated by scheme (see IsolateByScheme) are removed for both
+// schemes.  The function returns true if the cookie was present in the jar.
 func (jar *Jar) Remove(domain, path, name string) bool {
-	// sanitize domain
-	domain = strings.Trim(strings.ToLower(domain), ".")
-	existed := jar.content.delete(domain, path, name)
+	domain, _ = canonicalDomain(domain)
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	jar.invalidate()
+	existed := false
+	for _, scheme := range []string{"", "http", "https"} {
+		if jar.content.delete(domain, path, name, scheme) {
+			jar.publish(EventDelete, Cookie{Domain: domain, Path: path,
+				Name: name, Scheme: scheme}, nil)
+			existed = true
+		}
+	}
 	return existed
 }
 
 // -------------------------------------------------------------------------
 // Internals to SetCookies
 
-// the following action codes are for internal bookkeeping
+// the following action codes are for internal bookkeeping: update
+// reports what it did with a recieved cookie.
 type updateAction int
 
 const (
-	invalidCookie updateAction = iota
-	createCookie
-	updateCookie
-	deleteCookie
-	noSuchCookie
+	invalidCookie updateAction = iota // cookie rejected
+	createCookie                      // new cookie stored
+	updateCookie                      // stored cookie overwritten
+	deleteCookie                      // stored cookie deleted
+	noSuchCookie                      // deletion of a cookie not stored
 )
 
+// maxHostLength is the maximal length of a DNS name in its textual form.
+const maxHostLength = 253
+
 // host returns the (canonical) host from an URL u.
 // See RFC 6265 section 5.1.2
-// TODO: idns are not handeled at all.
 func host(u *url.URL) (host string, err error) {
 	host = strings.ToLower(u.Host)
-	if strings.HasSuffix(host, ".") {
-		// treat all domain names the same:
-		// strip trailing dot from fully qualified domain names
-		host = host[:len(host)-1]
-	}
-	if strings.Index(host, ":") != -1 {
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		// an IPv6 address without port
+		host = host[1 : len(host)-1]
+	} else if strings.Index(host, ":") != -1 {
 		host, _, err = net.SplitHostPort(host)
 		if err != nil {
 			return "", err
 		}
 	}
+	if ip := net.ParseIP(host); ip != nil {
+		// the same address is always the same host
+		return ip.String(), nil
+	}
+	if strings.HasSuffix(host, ".") {
+		// treat all domain names the same:
+		// strip trailing dot from fully qualified domain names
+		host = host[:len(host)-1]
+	}
 
 	host, err = punycodeToASCII(host)
 	if err != nil {
 		return "", err
 	}
+	if len(host) > maxHostLength {
+		// no valid DNS name; this also bounds the work done per host
+		return "", errHostTooLong
+	}
+	for i := 0; i < len(host); i++ {
+		// letters, digits, hyphen (LDH), dot and the common underscore
+		c := host[i]
+		if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_') {
+			return "", errHostCharacter
+		}
+	}
 
 	return host, nil
 }
 
+// host is like the function host but rejects underscores in the host
+// unless UnderscoreInHost is set.
+func (jar *Jar) host(u *url.URL) (string, error) {
+	h, err := host(u)
+	if err == nil && !jar.UnderscoreInHost && strings.Index(h, "_") != -1 {
+		return "", errHostCharacter
+	}
+	return h, err
+}
+
+// requestPath returns the path of u to match cookies against: "/" if u
+// has no path and normalized if NormalizePaths is set.
+func (jar *Jar) requestPath(u *url.URL) string {
+	path := u.Path
+	if path == "" {
+		path = "/"
+	}
+	if jar.NormalizePaths {
+		path = normalizePath(path)
+	}
+	return path
+}
+
 // isSecure checks for https scheme in u.
 func isSecure(u *url.URL) bool {
 	return strings.ToLower(u.Scheme) == "https"
@@ -264,11 +1466,6 @@ func isIP(host string) bool {
 	return ip.String() == host
 }
 
-// This is a dummy helper function which once can do the IDN stuff.
-func punycodeToASCII(s string) (string, error) {
-	return s, nil
-}
-
 // defaultPath returns "directory" part of path from u. Empty and
 // malformed paths yield "/".
 // See RFC 6265 section 5.1.4:
@@ -303,22 +1500,16 @@ func defaultPath(u *url.URL) string {
 // update is the workhorse which stores, updates or deletes the recieved cookie
 // in the jar.  host is the (canonical) hostname from which the cookie was
 // recieved and defaultpath the apropriate default path ("directory" of the
-// request path.
-func (jar *Jar) update(host, defaultpath string, recieved *http.Cookie) updateAction {
-
-	// Domain, hostOnly and our storage key
-	domain, hostOnly, err := jar.domainAndType(host, recieved.Domain)
-	if err != nil {
+// request path.  All modifications are published as events for u.
+func (jar *Jar) update(u *url.URL, host, defaultpath string, recieved *http.Cookie) updateAction {
+	domain, hostOnly, path, ok := jar.accept(u, host, defaultpath, recieved)
+	if !ok {
 		return invalidCookie
 	}
 
-	now := time.Now()
-
-	// Path
-	path := recieved.Path
-	if path == "" || path[0] != '/' {
-		path = defaultpath
-	}
+	// expiry is decided by the clock, the creation and access times
+	// only have to be strictly increasing
+	now, stamp := time.Now(), jar.now()
 
 	// Check for deletion of cookie and determine expiration time:
 	// MaxAge takes precedence over Expires.
@@ -329,54 +1520,448 @@ func (jar *Jar) update(host, defaultpath string, recieved *http.Cookie) updateAc
 	} else if recieved.MaxAge > 0 {
 		expires = time.Now().Add(time.Duration(recieved.MaxAge) * time.Second)
 	} else if !recieved.Expires.IsZero() {
-		if recieved.Expires.Before(now) {
-			deleteRequest = true
-		} else {
-			expires = recieved.Expires
+		expires = recieved.Expires
+		if expires.Before(now.Add(-jar.ClockSkew)) {
+			deleteRequest, expires = true, time.Time{}
+		}
+	}
+	if jar.SessionTTL > 0 && expires.IsZero() && !deleteRequest {
+		expires = now.Add(jar.SessionTTL)
+	}
+	expires = jar.limitExpires(expires, now)
+	secure := recieved.Secure || jar.ImplicitSecureOnHTTPS && isSecure(u)
+	scheme := ""
+	if jar.IsolateByScheme {
+		scheme = "http"
+		if isSecure(u) {
+			scheme = "https"
 		}
 	}
 	if deleteRequest {
-		if existed := jar.content.delete(domain, path, recieved.Name); existed {
+		if existed := jar.content.delete(domain, path, recieved.Name, scheme); existed {
+			jar.publish(EventDelete, Cookie{Domain: domain, Path: path,
+				Name: recieved.Name, Scheme: scheme}, u)
 			return deleteCookie
 		} else {
 			return noSuchCookie
 		}
 	}
 
-	cookie := jar.content.find(domain, path, recieved.Name)
+	value := recieved.Value
+	if jar.ValueCodec != nil {
+		value = jar.ValueCodec.Encode(value)
+	}
+
+	cookie := jar.content.find(domain, path, recieved.Name, scheme)
 	if len(cookie.Name) == 0 {
 		// a new cookie
 		cookie.Domain = domain
 		cookie.HostOnly = hostOnly
 		cookie.Path = path
 		cookie.Name = recieved.Name
-		cookie.Value = recieved.Value
+		cookie.Scheme = scheme
+		cookie.Seq = 0
+		if jar.PreserveSetOrder {
+			jar.seq++
+			cookie.Seq = jar.seq
+		}
+		cookie.Value = value
 		cookie.HttpOnly = recieved.HttpOnly
-		cookie.Secure = recieved.Secure
+		cookie.SameSite = recieved.SameSite
+		cookie.Secure = secure
 		cookie.Expires = expires
-		cookie.Created = now
-		cookie.LastAccess = now
+		cookie.skew = jar.ClockSkew
+		cookie.Created = stamp
+		cookie.LastAccess = stamp
+		cookie.Source = ""
+		if jar.TrackSource {
+			cookie.Source = host + u.Path
+		}
+		jar.publish(EventCreate, *cookie, u)
+		if jar.DeferCleanup || jar.batching {
+			return createCookie
+		}
+		if jar.MaxCookiesPerHost > 0 {
+			jar.limitHost(u, domain)
+		}
+		if jar.MaxCookiesTotal > 0 {
+			jar.limitTotal(u)
+		}
+		if jar.MaxDomains > 0 {
+			jar.limitDomains(u)
+		}
 		return createCookie
 	}
 
 	// an update for a cookie
 	cookie.HostOnly = hostOnly
-	cookie.Value = recieved.Value
+	cookie.Value = value
 	cookie.HttpOnly = recieved.HttpOnly
+	cookie.SameSite = recieved.SameSite
 	cookie.Expires = expires
-	cookie.Secure = recieved.Secure
-	cookie.LastAccess = now
+	cookie.skew = jar.ClockSkew
+	cookie.Secure = secure
+	cookie.LastAccess = stamp
+	if jar.TrackSource {
+		cookie.Source = host + u.Path
+	}
+	jar.publish(EventUpdate, *cookie, u)
 	return updateCookie
 }
 
+// accept is the validation portion of update: It checks the size of
+// recieved, validates it and, if ProtectSecureCookies is set, rejects it
+// from an insecure u if it is Secure or would shadow a stored Secure
+// cookie.  The results are as in validate.  It does not modify jar.
+func (jar *Jar) accept(u *url.URL, host, defaultpath string, recieved *http.Cookie) (domain string, hostOnly bool, path string, ok bool) {
+	if !jar.sizeOK(recieved) {
+		return "", false, "", false
+	}
+	domain, hostOnly, path, ok = jar.validate(u, host, defaultpath, recieved)
+	if !ok {
+		return "", false, "", false
+	}
+	if jar.ProtectSecureCookies && !isSecure(u) &&
+		(recieved.Secure || jar.shadowsSecure(domain, path, recieved.Name)) {
+		return "", false, "", false
+	}
+	return domain, hostOnly, path, true
+}
+
+// validate checks whether recieved from u may be stored and returns its
+// Domain, HostOnly and Path attributes.  host and defaultpath are as in
+// update.  It does not modify jar.
+func (jar *Jar) validate(u *url.URL, host, defaultpath string, recieved *http.Cookie) (domain string, hostOnly bool, path string, ok bool) {
+	// Domain and hostOnly
+	domain, hostOnly, err := jar.domainAndType(host, recieved.Domain)
+	if err != nil {
+		return "", false, "", false
+	}
+	if jar.EnforceSameSiteNoneSecure &&
+		recieved.SameSite == http.SameSiteNoneMode && !recieved.Secure {
+		return "", false, "", false
+	}
+
+	// Path
+	path = recieved.Path
+	if jar.NormalizePaths {
+		defaultpath = normalizePath(defaultpath)
+		if path != "" && path[0] == '/' {
+			path = normalizePath(path)
+		}
+	}
+	if path == "" || path[0] != '/' {
+		path = defaultpath
+	} else if jar.RequirePathScope && !(&Cookie{Path: path}).pathMatch(defaultpath) {
+		return "", false, "", false
+	}
+	if jar.MaxPathBytes > 0 && len(path) > jar.MaxPathBytes {
+		return "", false, "", false
+	}
+
+	// constraints of cookie name prefixes
+	candidate := Cookie{Name: recieved.Name, Secure: recieved.Secure,
+		HostOnly: hostOnly, Path: path}
+	if !candidate.prefixOK() || candidate.prefixed() && !isSecure(u) {
+		return "", false, "", false
+	}
+	return domain, hostOnly, path, true
+}
+
+// shadowsSecure reports whether a cookie name with the given domain and
+// path would overwrite or shadow a stored Secure cookie.  Only cookies of
+// the same registrable domain can be shadowed, so only the box of domain
+// is searched.
+func (jar *Jar) shadowsSecure(domain, path, name string) bool {
+	for _, c := range jar.content.related(domain) {
+		if c.Secure && shadows(domain, path, name, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// shadows reports whether a cookie name with the given domain and path
+// overwrites or shadows the cookie c: The names are equal, one of the
+// domains domain-matches the other and path path-matches the path of c.
+// See draft-ietf-httpbis-rfc6265bis section 5.6.
+func shadows(domain, path, name string, c *Cookie) bool {
+	return c.Name == name &&
+		(c.Domain == domain || isSubdomain(c.Domain, domain) || isSubdomain(domain, c.Domain)) &&
+		c.pathMatch(path)
+}
+
+// AuditSecure returns copies of the Secure cookies in jar which are
+// shadowed by a non-Secure cookie of the same name as described in
+// ProtectSecureCookies.  Such pairs hint at a downgrade attempt, e.g. by
+// cookies set before ProtectSecureCookies was enabled or imported ones.
+// The result is sorted by domain, path and name.
+func (jar *Jar) AuditSecure() []*Cookie {
+	jar.Lock()
+	defer jar.Unlock()
+
+	all := jar.content.all()
+	var flagged []*Cookie
+	for _, c := range all {
+		if !c.Secure {
+			continue
+		}
+		for _, o := range all {
+			if !o.Secure && shadows(o.Domain, o.Path, o.Name, c) {
+				cookie := *c
+				flagged = append(flagged, &cookie)
+				break
+			}
+		}
+	}
+	sort.Sort(keyList(flagged))
+	return flagged
+}
+
+// WouldReject returns those of cookies which SetCookies(u, cookies) would
+// not store because they are invalid for u, e.g. due to a domain attribute
+// not matching the host of u or exceeding MaxBytesPerCookie.  Deletion
+// requests for non-existing cookies are not rejected.  jar is not
+// modified.
+func (jar *Jar) WouldReject(u *url.URL, cookies []*http.Cookie) []*http.Cookie {
+	if u == nil || !isHTTP(u) {
+		return cookies
+	}
+	host, err := jar.host(u)
+	if err != nil {
+		return cookies
+	}
+	defaultpath := defaultPath(u)
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	var rejected []*http.Cookie
+	for _, cookie := range cookies {
+		if _, _, _, ok := jar.accept(u, host, defaultpath, cookie); !ok {
+			rejected = append(rejected, cookie)
+		}
+	}
+	return rejected
+}
+
+// now returns the current time but strictly later than the time it
+// returned before.  Cookies set in one batch thus get distinct creation
+// and access times which makes eviction of the least recently used
+// cookie deterministic.
+func (jar *Jar) now() time.Time {
+	now := time.Now()
+	if !now.After(jar.stamp) {
+		now = jar.stamp.Add(time.Nanosecond)
+	}
+	jar.stamp = now
+	return now
+}
+
+// expired reports whether c, which need not be stored in jar, is expired
+// taking ClockSkew into account.
+func (jar *Jar) expired(c *Cookie) bool {
+	return !c.Session() && c.Expires.Before(time.Now().Add(-jar.ClockSkew))
+}
+
+// limitExpires caps expires to MaxFutureExpiry from now.
+func (jar *Jar) limitExpires(expires, now time.Time) time.Time {
+	if jar.MaxFutureExpiry > 0 && !expires.IsZero() {
+		if limit := now.Add(jar.MaxFutureExpiry); expires.After(limit) {
+			return limit
+		}
+	}
+	return expires
+}
+
+// restore is called for each cookie loaded into jar with its times and
+// sequence number kept.  It makes sure cookies stored later are newer
+// than c, even if the clock of the process which saved c was ahead, caps
+// the expiration time of c to MaxFutureExpiry and applies ClockSkew.  It
+// must be called with jar locked.
+func (jar *Jar) restore(c *Cookie) {
+	c.Expires = jar.limitExpires(c.Expires, time.Now())
+	c.skew = jar.ClockSkew
+	for _, t := range []time.Time{c.Created, c.LastAccess} {
+		if t.After(jar.stamp) {
+			jar.stamp = t
+		}
+	}
+	if c.Seq > jar.seq {
+		jar.seq = c.Seq
+	}
+}
+
+// replace makes content, freshly loaded with cookies, the storage of jar.
+// The cookies are restored and, unless DeferCleanup is set, jar is tidied
+// up before it is unlocked again.  It must be called with jar locked.
+func (jar *Jar) replace(content storage) {
+	jar.content = content
+	for _, c := range content.all() {
+		jar.restore(c)
+	}
+	if !jar.DeferCleanup {
+		jar.tidy()
+	}
+	jar.invalidate()
+}
+
+// Cleanup removes all expired cookies from jar and enforces
+// MaxCookiesPerHost, MaxCookiesTotal and MaxDomains by evicting the least
+// recently used cookies.  It is needed if DeferCleanup is set but may be called
+// any time.  The number of removed cookies is returned.
+func (jar *Jar) Cleanup() int {
+	jar.Lock()
+	defer jar.Unlock()
+
+	jar.invalidate()
+	return jar.tidy()
+}
+
+// tidy is the work horse of Cleanup, also run after bulk loading cookies.
+// It must be called with jar locked.
+func (jar *Jar) tidy() int {
+	removed := jar.remove((*Cookie).Expired, EventExpire)
+	n := len(jar.content.all())
+	jar.cleanup()
+	return removed + n - len(jar.content.all())
+}
+
+// cleanup enforces MaxCookiesPerHost, MaxCookiesTotal and MaxDomains on
+// the whole content of jar.  Removals are published as events without URL.
+func (jar *Jar) cleanup() {
+	if jar.MaxCookiesPerHost > 0 {
+		domains := make(map[string]bool)
+		for _, cookie := range jar.content.all() {
+			domains[cookie.Domain] = true
+		}
+		for domain := range domains {
+			jar.limitHost(nil, domain)
+		}
+	}
+	if jar.MaxCookiesTotal > 0 {
+		jar.limitTotal(nil)
+	}
+	if jar.MaxDomains > 0 {
+		jar.limitDomains(nil)
+	}
+}
+
+// limitHost removes the least recently used cookies with Domain domain
+// until at most MaxCookiesPerHost such cookies are left.  Removals are
+// published as events for u.
+func (jar *Jar) limitHost(u *url.URL, domain string) {
+	jar.evict(u, jar.content.domain(domain), jar.MaxCookiesPerHost)
+}
+
+// limitTotal removes the least recently used cookies until at most
+// MaxCookiesTotal cookies are left.  Removals are published as events
+// for u.
+func (jar *Jar) limitTotal(u *url.URL) {
+	jar.evict(u, jar.content.all(), jar.MaxCookiesTotal)
+}
+
+// limitDomains removes all cookies of the least recently used registrable
+// domains until cookies for at most MaxDomains domains are left.  A domain
+// is as recent as its most recently used cookie.  Removals are published
+// as events for u.
+func (jar *Jar) limitDomains(u *url.URL) {
+	lastUse := make(map[string]time.Time)
+	for _, cookie := range jar.content.all() {
+		box := boxKey(cookie.Domain)
+		if last, ok := lastUse[box]; !ok || cookie.LastAccess.After(last) {
+			lastUse[box] = cookie.LastAccess
+		}
+	}
+	if len(lastUse) <= jar.MaxDomains {
+		return
+	}
+
+	evicted := make(map[string]bool)
+	for n := len(lastUse); n > jar.MaxDomains; n-- {
+		lru := ""
+		for box, last := range lastUse {
+			if lru == "" || last.Before(lastUse[lru]) ||
+				last.Equal(lastUse[lru]) && box < lru {
+				lru = box
+			}
+		}
+		evicted[lru] = true
+		delete(lastUse, lru)
+	}
+
+	vetoed := make(map[string]bool)
+	jar.content.deleteFunc(func(cookie *Cookie) bool {
+		box := boxKey(cookie.Domain)
+		if !evicted[box] {
+			return false
+		}
+		if cookie.Expired() {
+			return true
+		}
+		if jar.EvictionVeto != nil && jar.EvictionVeto(cookie) {
+			vetoed[box] = true
+			return false
+		}
+		jar.publish(EventEvict, *cookie, u)
+		return true
+	})
+	jar.vetoedEvictions += len(vetoed)
+}
+
+// evict removes the least recently used of cookies from the storage until
+// at most max of them are left.  Cookies vetoed by EvictionVeto are never
+// removed, even if this leaves more than max cookies.
+func (jar *Jar) evict(u *url.URL, cookies []*Cookie, max int) {
+	for n := len(cookies); n > max; n-- {
+		lru := -1
+		for i, cookie := range cookies {
+			if jar.EvictionVeto != nil && jar.EvictionVeto(cookie) {
+				continue
+			}
+			if lru == -1 || cookie.LastAccess.Before(cookies[lru].LastAccess) {
+				lru = i
+			}
+		}
+		if lru == -1 {
+			// all remaining cookies are protected
+			jar.vetoedEvictions++
+			return
+		}
+		c := cookies[lru]
+		jar.content.delete(c.Domain, c.Path, c.Name, c.Scheme)
+		jar.publish(EventEvict, *c, u)
+		cookies[lru] = cookies[len(cookies)-1]
+		cookies = cookies[:len(cookies)-1]
+	}
+}
+
 var (
 	errNoHostname      = errors.New("No hostname (IP only) available")
 	errMalformedDomain = errors.New("Domain attribute of cookie is malformed")
 	errTLDDomainCookie = errors.New("No domain cookies for TLDs allowed")
 	errIllegalPSDomain = errors.New("Illegal cookie domain attribute for public suffix")
 	errBadDomain       = errors.New("Bad cookie domaine attribute")
+	errNonHTTPURL      = errors.New("URL is not a HTTP or HTTPS URL")
+	errNoHost          = errors.New("URL has no host")
+	errHostTooLong     = errors.New("Host name exceeds 253 bytes")
+	errHostCharacter   = errors.New("Invalid character in host name")
 )
 
+// stripPort removes a trailing port like ":80" or a lone ":" from domain.
+func stripPort(domain string) string {
+	i := strings.LastIndex(domain, ":")
+	if i == -1 {
+		return domain
+	}
+	for _, r := range domain[i+1:] {
+		if r < '0' || r > '9' {
+			return domain
+		}
+	}
+	return domain[:i]
+}
+
 // domainAndType determines the Cookies Domain and HostOnly attribute.
 // It uses the host name the cookie was recieved from and the domain attribute
 // of the cookie.
@@ -398,6 +1983,13 @@ func (jar *Jar) domainAndType(host, domainAttr string) (domain string, hostOnly
 		return "", false, errNoHostname
 	}
 
+	if jar.StripPortFromDomain {
+		domainAttr = stripPort(domainAttr)
+		if domainAttr == "" {
+			return "", false, errMalformedDomain
+		}
+	}
+
 	// If valid: A Domain Cookie (with one strange exeption).
 	// We note the fact "domain cookie" as hostOnly==false and strip
 	// possible leading "." from the domain.
@@ -412,6 +2004,10 @@ func (jar *Jar) domainAndType(host, domainAttr string) (domain string, hostOnly
 		return "", false, errMalformedDomain
 	}
 	domain = strings.ToLower(domain) // see RFC 6265 section 5.2.3
+	domain, err = punycodeToASCII(domain)
+	if err != nil {
+		return "", false, errMalformedDomain
+	}
 
 	if domain[len(domain)-1] == '.' {
 		// we recieved stuff like "Domain=www.example.com."
@@ -423,11 +2019,30 @@ func (jar *Jar) domainAndType(host, domainAttr string) (domain string, hostOnly
 		return "", false, errMalformedDomain
 	}
 
+	// host is not an IP address, so a domain attribute which is an IP
+	// address cannot domain-match.
+	if isIP(domain) {
+		return "", false, errBadDomain
+	}
+
+	if jar.ExactDomainIsHostOnly && domain == host {
+		return host, true, nil
+	}
+
 	// Never allow Domain Cookies for TLDs.  TODO: decide on "localhost".
 	if i := strings.Index(domain, "."); i == -1 {
 		return "", false, errTLDDomainCookie
 	}
 
+	// Even if DomainCookiesOnPublicSuffixes is set: Never allow domain
+	// cookies on a public suffix directly below a TLD like co.uk.
+	if isSecondLevelSuffix(domain) {
+		if host == domain {
+			return host, true, nil
+		}
+		return "", false, errIllegalPSDomain
+	}
+
 	if !jar.DomainCookiesOnPublicSuffixes {
 		// RFC 6265 section 5.3:
 		// 5. If the user agent is configured to reject "public
@@ -443,7 +2058,7 @@ func (jar *Jar) domainAndType(host, domainAttr string) (domain string, hostOnly
 
 		if !allowDomainCookies(domain) {
 			// the "domain is a public suffix" case
-			if host == domainAttr {
+			if host == domain {
 				return host, true, nil
 			}
 			return "", false, errIllegalPSDomain
@@ -452,7 +2067,7 @@ func (jar *Jar) domainAndType(host, domainAttr string) (domain string, hostOnly
 
 	// domain must domain-match host:  www.mycompany.com cannot
 	// set cookies for .ourcompetitors.com.
-	if host != domain && !strings.HasSuffix(host, "."+domain) {
+	if host != domain && !isSubdomain(host, domain) {
 		return "", false, errBadDomain
 	}
 
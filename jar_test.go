@@ -7,9 +7,21 @@ package cookiejar
 // Tests for the exported methods of Jar.
 
 import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
 	"testing"
@@ -417,6 +429,44 @@ var updateAndDeleteTests = []jarTest{
 	},
 }
 
+func TestMalformedExpires(t *testing.T) {
+	u := URL("http://www.host.test")
+	for _, b := range []bool{true, false} {
+		for _, lenient := range []bool{false, true} {
+			jar := NewJar(b)
+			jar.LenientParsing = lenient
+			rejected := jar.SetCookieHeader(u,
+				"a=1; Expires=garbage",
+				"b=2; Max-Age=100; Expires=garbage",
+				"c=3; Expires=Mon, 32 Foo 2099 99:99:99 GMT",
+				"d=4; Expires="+time.Now().Add(time.Hour).UTC().Format(http.TimeFormat),
+				"e=5")
+			if got := jar.list(); got != "b=2 d=4 e=5" {
+				t.Errorf("lenient=%t: Got %q, want %q", lenient, got, "b=2 d=4 e=5")
+			}
+			if rejected != 2 {
+				t.Errorf("lenient=%t: Got %d rejected, want 2", lenient, rejected)
+			}
+		}
+	}
+}
+
+// Cookies parsed by net/http keep the baseline behaviour: An unparsable
+// Expires is ignored as RFC 6265 section 5.2.1 requires.
+func TestMalformedExpiresFromResponse(t *testing.T) {
+	header := http.Header{"Set-Cookie": {
+		"a=1; Expires=garbage",
+		"b=2; Expires=Thu, 01 Jan 2099 00:00:00"}}
+	cookies := (&http.Response{Header: header}).Cookies()
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("http://www.host.test"), cookies)
+		if got := jar.list(); got != "a=1 b=2" {
+			t.Errorf("Got %q, want %q", got, "a=1 b=2")
+		}
+	}
+}
+
 func TestUpdateAndDelete(t *testing.T) {
 	jar := NewJar(false)
 	for _, test := range updateAndDeleteTests {
@@ -534,6 +584,25 @@ func TestMaxBytesPerCookie(t *testing.T) {
 	}.run(t, jar)
 }
 
+func TestMaxPathBytes(t *testing.T) {
+	jar := NewJar(false)
+	long := "/" + strings.Repeat("x", 1024)
+	jarTest{"Too long paths", "http://www.host.test" + long + "/",
+		[]string{"a=1", "b=2; path=/", "c=3; path=" + long[:1024], "d=4; path=" + long},
+		"b=2 c=3",
+		[]query{{"http://www.host.test" + long[:1024], "c=3 b=2"}},
+	}.run(t, jar)
+	jar.MaxPathBytes = 0
+	jarTest{"Unlimited paths", "http://www.host.test",
+		[]string{"d=4; path=" + long},
+		"b=2 c=3 d=4",
+		[]query{
+			{"http://www.host.test" + long, "d=4 b=2"},
+			{"http://www.host.test" + long[:1024], "c=3 b=2"},
+		},
+	}.run(t, jar)
+}
+
 func TestHostCookieOnIP(t *testing.T) {
 	jar := NewJar(false)
 	jarTest{"Dissallow host cookie on IP", "http://127.0.0.1",
@@ -566,10 +635,15 @@ func TestDomainCookiesOnPublicSuffixes(t *testing.T) {
 		[]query{{"http://www.bbc.co.uk", "a=1"}},
 	}.run(t, jar)
 	jar.DomainCookiesOnPublicSuffixes = true
-	jarTest{"Allow PS", "http://www.bbc.co.uk",
-		[]string{"c=3; domain=co.uk"},
-		"a=1 c=3",
-		[]query{{"http://www.bbc.co.uk", "a=1 c=3"}},
+	jarTest{"Still dissallow PS below TLD", "http://www.google.co.uk",
+		[]string{"c=3; domain=co.uk", "d=4; domain=.co.uk"},
+		"a=1",
+		[]query{{"http://www.google.co.uk", ""}},
+	}.run(t, jar)
+	jarTest{"Allow PS", "http://www.foo.ide.kyoto.jp",
+		[]string{"e=5; domain=ide.kyoto.jp"},
+		"a=1 e=5",
+		[]query{{"http://www.bar.ide.kyoto.jp", "e=5"}},
 	}.run(t, jar)
 }
 
@@ -765,6 +839,17 @@ var chromiumTests = []jarTest{
 		"a=1 b=2",
 		[]query{{"http://www.google.com", "a=1 b=2"}},
 	},
+	{"CaseInsensitiveDomainTest with mixed case hosts",
+		"http://WWW.Google.Com",
+		[]string{"a=1; domain=.GOOGLE.COM", "b=2; domain=.www.gOOgLE.coM", "c=3"},
+		"a=1 b=2 c=3",
+		[]query{
+			{"http://WWW.Google.Com", "a=1 b=2 c=3"},
+			{"http://www.google.com", "a=1 b=2 c=3"},
+			{"http://Sub.WWW.GOOGLE.com", "a=1 b=2"},
+			{"http://MAIL.google.COM", "a=1"},
+		},
+	},
 	{"TestIpAddress 1: allow host cookies on IP address",
 		"http://1.2.3.4/foo",
 		[]string{"a=1; path=/"},
@@ -777,6 +862,12 @@ var chromiumTests = []jarTest{
 		"",
 		[]query{{"http://1.2.3.4/foo", ""}},
 	},
+	{"IP address domain attribute on hostname",
+		"http://example.com/foo",
+		[]string{"a=1; domain=1.2.3.4", "b=2; domain=.1.2.3.4"},
+		"",
+		[]query{{"http://example.com/foo", ""}, {"http://1.2.3.4/foo", ""}},
+	},
 	{"TestIpAddress 3: really disallow domain cookies on IP address (even if IE&FF allow this case)",
 		"http://1.2.3.4/foo",
 		[]string{"a=1; domain=1.2.3.4"},
@@ -1130,3 +1221,4108 @@ func TestLastAccess(t *testing.T) {
 		}
 	}
 }
+
+// -------------------------------------------------------------------------
+// Test stable ordering of cookies with same path length and creation time
+
+func TestStableOrder(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		created := time.Now().Add(-time.Hour)
+		cookies := make([]Cookie, 0, 5)
+		for _, name := range []string{"d", "b", "e", "a", "c"} {
+			cookies = append(cookies, Cookie{
+				Name: name, Value: "1",
+				Domain:  "www.host.test",
+				Path:    "/foo",
+				Created: created,
+			})
+		}
+		cookies = append(cookies, Cookie{
+			Name: "z", Value: "2",
+			Domain:  "www.host.test",
+			Path:    "/foo/bar",
+			Created: created,
+		})
+		jar.Add(cookies)
+
+		u := URL("http://www.host.test/foo/bar")
+		for i := 0; i < 10; i++ {
+			recieved := stringRep(jar.Cookies(u))
+			if recieved != "z=2 a=1 b=1 c=1 d=1 e=1" {
+				t.Fatalf("#%d: Wrong order. Got %q", i, recieved)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test HasCookies
+
+func TestHasCookies(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		t0 := time.Now().Add(-time.Second)
+		jar.Add([]Cookie{
+			Cookie{
+				Name: "a", Value: "1",
+				Domain:     "www.host.test",
+				Path:       "/foo",
+				HostOnly:   true,
+				LastAccess: t0,
+			},
+			Cookie{
+				Name: "b", Value: "2",
+				Domain:     "host.test",
+				Path:       "/bar",
+				Secure:     true,
+				LastAccess: t0,
+			},
+		})
+
+		for _, tt := range []struct {
+			url  string
+			want bool
+		}{
+			{"http://www.host.test/foo", true},
+			{"http://www.host.test/foo/x", true},
+			{"http://www.host.test/", false},
+			{"http://other.host.test/foo", false},
+			{"http://www.host.test/bar", false},
+			{"https://www.host.test/bar", true},
+			{"https://other.host.test/bar", true},
+			{"http://www.google.com/foo", false},
+			{"ftp://www.host.test/foo", false},
+		} {
+			if got := jar.HasCookies(URL(tt.url)); got != tt.want {
+				t.Errorf("%s: got %t, want %t", tt.url, got, tt.want)
+			}
+		}
+
+		for _, cookie := range jar.All() {
+			if cookie.LastAccess != t0 {
+				t.Errorf("LastAccess of %s modified", cookie.Name)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ValueCodec
+
+// base64Codec is a ValueCodec which stores values base64 encoded.
+type base64Codec struct{}
+
+func (base64Codec) Encode(value string) string {
+	return base64.URLEncoding.EncodeToString([]byte(value))
+}
+
+func (base64Codec) Decode(value string) string {
+	decoded, err := base64.URLEncoding.DecodeString(value)
+	if err != nil {
+		return value
+	}
+	return string(decoded)
+}
+
+func TestValueCodec(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.ValueCodec = base64Codec{}
+		u := URL("http://www.host.test/")
+		jar.SetCookies(u, []*http.Cookie{
+			&http.Cookie{Name: "a", Value: "hello"},
+			&http.Cookie{Name: "b", Value: ""},
+		})
+
+		if jar.list() != "a=aGVsbG8= b=" {
+			t.Errorf("Wrong content. Got %q", jar.list())
+		}
+		recieved := stringRep(jar.Cookies(u))
+		if recieved != "a=hello b=" {
+			t.Errorf("Wrong cookies. Got %q", recieved)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test AttachCookies
+
+func TestAttachCookies(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("https://www.host.test/"), []*http.Cookie{
+			parseCookie("a=1"),
+			parseCookie("b=2; secure"),
+			parseCookie("c=3; httponly"),
+			parseCookie("d=4; path=/foo"),
+		})
+
+		for _, tt := range []struct {
+			url      string
+			expected string
+		}{
+			{"http://www.host.test/", "a=1 c=3"},
+			{"https://www.host.test/", "a=1 b=2 c=3"},
+			{"https://www.host.test/foo", "d=4 a=1 b=2 c=3"},
+			{"http://www.other.test/", ""},
+		} {
+			r, err := http.NewRequest("GET", tt.url, nil)
+			if err != nil {
+				t.Fatalf("%s: %v", tt.url, err)
+			}
+			jar.AttachCookies(r)
+			if recieved := stringRep(r.Cookies()); recieved != tt.expected {
+				t.Errorf("%s: Wrong cookies. Got %q, want %q",
+					tt.url, recieved, tt.expected)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ParseCookieHeader and FormatCookies
+
+func TestCookieHeaderCodec(t *testing.T) {
+	header := "a=1; b=2; c=\"quoted\"; d="
+	cookies := ParseCookieHeader(header)
+	if got := stringRep(cookies); got != "a=1 b=2 c=quoted d=" {
+		t.Errorf("Wrong parsed cookies. Got %q", got)
+	}
+	if got := FormatCookies(cookies); got != header {
+		t.Errorf("Wrong formated cookies. Got %q", got)
+	}
+	if got := FormatCookies(ParseCookieHeader("x=1;;y=2 ; =3")); got != "x=1; y=2" {
+		t.Errorf("Malformed pairs not dropped. Got %q", got)
+	}
+	if got := FormatCookies(nil); got != "" {
+		t.Errorf("Wrong header for no cookies. Got %q", got)
+	}
+
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		u := URL("https://www.host.test/foo")
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("a=1; path=/"),
+			parseCookie("b=2; path=/foo"),
+			parseCookie("c=3; secure"),
+		})
+		formated := FormatCookies(jar.Cookies(u))
+		if formated != "b=2; a=1; c=3" {
+			t.Errorf("Boxed=%t: Wrong wire form. Got %q", b, formated)
+		}
+		r, _ := http.NewRequest("GET", u.String(), nil)
+		jar.AttachCookies(r)
+		if attached := r.Header.Get("Cookie"); attached != formated {
+			t.Errorf("Boxed=%t: AttachCookies sent %q, FormatCookies %q",
+				b, attached, formated)
+		}
+		if got := stringRep(ParseCookieHeader(formated)); got != "b=2 a=1 c=3" {
+			t.Errorf("Boxed=%t: Round trip failed. Got %q", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test MaxCookiesPerHost
+
+func TestMaxCookiesPerHost(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxCookiesPerHost = 3
+		u := URL("http://a.example.com")
+		for _, cs := range []string{"a=1", "b=2", "c=3", "d=4", "e=5"} {
+			jar.SetCookies(u, []*http.Cookie{parseCookie(cs)})
+		}
+		if jar.list() != "c=3 d=4 e=5" {
+			t.Errorf("Wrong content. Got %q", jar.list())
+		}
+
+		// limit is per host, not per registrable domain
+		jarTest{"Fill b.example.com", "http://b.example.com",
+			[]string{"f=6", "g=7", "h=8; domain=example.com"},
+			"c=3 d=4 e=5 f=6 g=7 h=8",
+			[]query{
+				{"http://a.example.com", "c=3 d=4 e=5 h=8"},
+				{"http://b.example.com", "f=6 g=7 h=8"},
+			},
+		}.run(t, jar)
+
+		// updating c=3 makes d=4 the least recently used one
+		jar.SetCookies(u, []*http.Cookie{parseCookie("c=X")})
+		jar.SetCookies(u, []*http.Cookie{parseCookie("i=9")})
+		if jar.list() != "c=X e=5 f=6 g=7 h=8 i=9" {
+			t.Errorf("Wrong content. Got %q", jar.list())
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test MaxFutureExpiry
+
+func TestMaxFutureExpiry(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		if jar.MaxFutureExpiry != 10*365*24*time.Hour {
+			t.Errorf("Default MaxFutureExpiry %s", jar.MaxFutureExpiry)
+		}
+		u := URL("http://www.host.test")
+		t0 := time.Now()
+		jar.SetCookies(u, []*http.Cookie{
+			&http.Cookie{Name: "a", Value: "1",
+				Expires: time.Date(9998, 12, 31, 23, 59, 59, 0, time.UTC)},
+			&http.Cookie{Name: "b", Value: "2",
+				Expires: time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)},
+			&http.Cookie{Name: "c", Value: "3", MaxAge: 1 << 31},
+			&http.Cookie{Name: "d", Value: "4", MaxAge: 3600},
+		})
+		t1 := time.Now()
+
+		for _, cookie := range jar.All() {
+			limit := t1.Add(jar.MaxFutureExpiry)
+			if cookie.Name == "d" {
+				limit = t1.Add(time.Hour)
+			}
+			if cookie.Expires.Before(t0) || cookie.Expires.After(limit) {
+				t.Errorf("Cookie %s: Bad Expires %s", cookie.Name, cookie.Expires)
+			}
+		}
+		if recieved := stringRep(jar.Cookies(u)); recieved != "a=1 b=2 c=3 d=4" {
+			t.Errorf("Wrong cookies. Got %q", recieved)
+		}
+	}
+}
+
+// Cookies loaded or imported are capped like cookies set by a server.
+func TestMaxFutureExpiryOnLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cookiejar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "cookies.json")
+
+	now := time.Now()
+	stored := []Cookie{
+		{Name: "a", Value: "1", Expires: time.Date(9998, 12, 31, 23, 59, 59, 0, time.UTC)},
+		{Name: "b", Value: "2", Expires: time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)},
+		{Name: "c", Value: "3", Expires: now.Add(time.Hour)},
+	}
+	for i := range stored {
+		stored[i].Domain, stored[i].Path, stored[i].HostOnly = "www.host.test", "/", true
+		stored[i].Created, stored[i].LastAccess = now, now
+	}
+
+	loads := map[string]func(jar *Jar) error{
+		"Add": func(jar *Jar) error {
+			jar.Add(stored)
+			return nil
+		},
+		"Import": func(jar *Jar) error {
+			cookies := make([]*Cookie, len(stored))
+			for i := range stored {
+				c := stored[i]
+				cookies[i] = &c
+			}
+			jar.Import(cookies)
+			return nil
+		},
+		"LoadReplace": func(jar *Jar) error {
+			data, err := json.Marshal(stored)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(file, data, 0600); err != nil {
+				return err
+			}
+			return jar.LoadReplace(file)
+		},
+		"ImportHAR": func(jar *Jar) error {
+			har := `[{"name":"a","value":"1","domain":"www.host.test","expires":"9998-12-31T23:59:59Z"},` +
+				`{"name":"b","value":"2","domain":"www.host.test","expires":"9999-12-31T23:59:59Z"},` +
+				`{"name":"c","value":"3","domain":"www.host.test","expires":"` +
+				now.Add(time.Hour).UTC().Format(time.RFC3339Nano) + `"}]`
+			_, _, err := jar.ImportHAR(strings.NewReader(har))
+			return err
+		},
+		"GobDecode": func(jar *Jar) error {
+			source := NewJar(true)
+			source.MaxFutureExpiry = 0
+			source.Add(stored)
+			data, err := source.GobEncode()
+			if err != nil {
+				return err
+			}
+			return jar.GobDecode(data)
+		},
+	}
+
+	for name, load := range loads {
+		for _, b := range []bool{true, false} {
+			jar := NewJar(b)
+			if err := load(jar); err != nil {
+				t.Fatalf("%s Boxed=%t: %v", name, b, err)
+			}
+			limit := time.Now().Add(jar.MaxFutureExpiry)
+			for _, cookie := range jar.All() {
+				if cookie.Expires.After(limit) {
+					t.Errorf("%s Boxed=%t: %s expires %s", name, b, cookie.Name, cookie.Expires)
+				}
+				if cookie.Name == "c" && !cookie.Expires.Equal(stored[2].Expires) {
+					t.Errorf("%s Boxed=%t: c expires %s, want %s", name, b,
+						cookie.Expires, stored[2].Expires)
+				}
+			}
+			if got := jar.list(); got != "a=1 b=2 c=3" {
+				t.Errorf("%s Boxed=%t: Got %q", name, b, got)
+			}
+
+			// the capped cookies take part in least recently used eviction
+			jar.MaxCookiesTotal = 3
+			jar.SetCookies(URL("http://www.host.test"), []*http.Cookie{parseCookie("d=4")})
+			if n := len(jar.All()); n != 3 {
+				t.Errorf("%s Boxed=%t: Got %d cookies, want 3", name, b, n)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CacheRetrieval
+
+func TestCacheRetrieval(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.CacheRetrieval = true
+		jarTest{"Fill jar", "http://www.host.test",
+			[]string{"a=1", "b=2; max-age=1"},
+			"a=1 b=2",
+			[]query{
+				{"http://www.host.test", "a=1 b=2"},
+				{"http://www.host.test", "a=1 b=2"},
+			},
+		}.run(t, jar)
+
+		jarTest{"Update jar", "http://www.host.test",
+			[]string{"a=X", "c=3; path=/foo"},
+			"a=X b=2 c=3",
+			[]query{
+				{"http://www.host.test", "a=X b=2"},
+				{"http://www.host.test/foo", "c=3 a=X b=2"},
+			},
+		}.run(t, jar)
+
+		jar.Remove("www.host.test", "/", "a")
+		if recieved := stringRep(jar.Cookies(URL("http://www.host.test"))); recieved != "b=2" {
+			t.Errorf("Wrong cookies after Remove. Got %q", recieved)
+		}
+
+		time.Sleep(1005 * time.Millisecond)
+		if recieved := stringRep(jar.Cookies(URL("http://www.host.test"))); recieved != "" {
+			t.Errorf("Wrong cookies after expiry. Got %q", recieved)
+		}
+	}
+}
+
+// The retrieval cache must not grow without bound if many different URLs
+// are read without modifying the jar.
+func TestCacheRetrievalBound(t *testing.T) {
+	jar := NewJar(true)
+	jar.CacheRetrieval = true
+	jar.SetCookies(URL("http://www.host.test"), []*http.Cookie{parseCookie("a=1")})
+	for i := 0; i < 10000; i++ {
+		u := URL(fmt.Sprintf("http://www.host.test/p%d", i))
+		if got := stringRep(jar.Cookies(u)); got != "a=1" {
+			t.Fatalf("%s: Got %q", u, got)
+		}
+		if n := len(jar.cache.entries); n > maxCacheEntries {
+			t.Fatalf("After %d reads the cache has %d entries", i+1, n)
+		}
+	}
+}
+
+func benchmarkCookies(b *testing.B, cache, reuse bool) {
+	jar := NewJar(true)
+	jar.CacheRetrieval = cache
+	u := URL("http://www.host.test/some/path")
+	cookies := make([]*http.Cookie, 0, 50)
+	for i := 0; i < 50; i++ {
+		cookies = append(cookies, &http.Cookie{
+			Name:  fmt.Sprintf("n%d", i),
+			Value: "value",
+			Path:  fmt.Sprintf("/%s", strings.Repeat("x", i%5)),
+		})
+	}
+	jar.SetCookies(u, cookies)
+	b.ReportAllocs()
+	b.ResetTimer()
+	var buf []*http.Cookie
+	for i := 0; i < b.N; i++ {
+		if reuse {
+			buf = jar.AppendCookies(buf[:0], u)
+		} else {
+			jar.Cookies(u)
+		}
+	}
+}
+
+func BenchmarkCookies(b *testing.B)       { benchmarkCookies(b, false, false) }
+func BenchmarkCachedCookies(b *testing.B) { benchmarkCookies(b, true, false) }
+func BenchmarkAppendCookies(b *testing.B) { benchmarkCookies(b, true, true) }
+
+func benchmarkParallelCookies(b *testing.B, granularity time.Duration) {
+	jar := NewJar(true)
+	jar.LastAccessGranularity = granularity
+	jar.CacheRetrieval = true
+	u := URL("http://www.host.test/some/path")
+	cookies := make([]*http.Cookie, 0, 50)
+	for i := 0; i < 50; i++ {
+		cookies = append(cookies, &http.Cookie{Name: fmt.Sprintf("n%d", i), Value: "value"})
+	}
+	jar.SetCookies(u, cookies)
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var buf []*http.Cookie
+		for pb.Next() {
+			buf = jar.AppendCookies(buf[:0], u)
+		}
+	})
+}
+
+func BenchmarkParallelCookies(b *testing.B)      { benchmarkParallelCookies(b, 0) }
+func BenchmarkParallelCoarseAccess(b *testing.B) { benchmarkParallelCookies(b, time.Minute) }
+
+// -------------------------------------------------------------------------
+// Test LastAccessGranularity
+
+func TestLastAccessGranularity(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.LastAccessGranularity = time.Hour
+		jar.MaxCookiesTotal = 2
+		a, x := URL("http://www.a.test"), URL("http://www.b.test")
+		jar.SetCookies(a, []*http.Cookie{parseCookie("a=1")})
+		jar.SetCookies(x, []*http.Cookie{parseCookie("b=2")})
+		lastAccess := func(host string) time.Time {
+			return jar.AllCookiesForHost(host)[0].LastAccess
+		}
+
+		// no write within the granularity
+		before := lastAccess("www.a.test")
+		jar.CookiesAt(a, before.Add(30*time.Minute))
+		if got := lastAccess("www.a.test"); !got.Equal(before) {
+			t.Errorf("Boxed=%t: LastAccess updated to %v within granularity", b, got)
+		}
+
+		// a is used after more than the granularity and becomes recent
+		later := before.Add(2 * time.Hour)
+		jar.CookiesAt(a, later)
+		if got := lastAccess("www.a.test"); !got.Equal(later) {
+			t.Errorf("Boxed=%t: LastAccess %v, want %v", b, got, later)
+		}
+		jar.SetCookies(URL("http://www.c.test"), []*http.Cookie{parseCookie("c=3")})
+		if got := jar.list(); got != "a=1 c=3" {
+			t.Errorf("Boxed=%t: Wrong cookie evicted. Got %q", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test internationalized domain names
+
+func TestIDN(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		// net/http drops non-ASCII domain attributes during parsing,
+		// so construct the cookies directly.
+		jar.SetCookies(URL("http://www.bücher.test"), []*http.Cookie{
+			&http.Cookie{Name: "a", Value: "1"},
+			&http.Cookie{Name: "b", Value: "2", Domain: "Bücher.test"},
+		})
+		jarTest{"Unicode domain attribute", "http://www.bücher.test",
+			[]string{},
+			"a=1 b=2",
+			[]query{
+				{"http://www.bücher.test", "a=1 b=2"},
+				{"http://www.xn--bcher-kva.test", "a=1 b=2"},
+				{"http://shop.bücher.test", "b=2"},
+				{"http://shop.xn--bcher-kva.test", "b=2"},
+				{"http://www.bucher.test", ""},
+				{"http://www.büchér.test", ""},
+				{"http://www.bu\u0308cher.test", "a=1 b=2"},
+				{"http://WWW.BÜCHER.test", "a=1 b=2"},
+				{"http://www.ｂüｃｈｅｒ.test", "a=1 b=2"},
+				{"http://www.bü\u0441her.test", ""}, // Cyrillic es
+			},
+		}.run(t, jar)
+
+		jar.SetCookies(URL("http://www.bücher.test"), []*http.Cookie{
+			&http.Cookie{Name: "d", Value: "4", Domain: "BU\u0308CHER.test"},
+			&http.Cookie{Name: "e", Value: "5", Domain: "bü\u0441her.test"},
+		})
+		jarTest{"Normalized domain attribute", "http://www.bücher.test",
+			[]string{},
+			"a=1 b=2 d=4",
+			[]query{
+				{"http://shop.xn--bcher-kva.test", "b=2 d=4"},
+			},
+		}.run(t, jar)
+		jar.Remove("xn--bcher-kva.test", "/", "d")
+
+		jarTest{"ASCII domain attribute", "http://www.xn--bcher-kva.test",
+			[]string{"c=3; domain=xn--bcher-kva.test"},
+			"a=1 b=2 c=3",
+			[]query{
+				{"http://shop.bücher.test", "b=2 c=3"},
+			},
+		}.run(t, jar)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ReplaceStorage
+
+func TestReplaceStorage(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jarTest{"Fill jar", "http://www.host.test/foo/bar",
+			[]string{"a=1", "b=2; path=/", "c=3; domain=host.test", "d=4; max-age=100"},
+			"a=1 b=2 c=3 d=4",
+			[]query{{"http://www.host.test/foo/", "a=1 c=3 d=4 b=2"}},
+		}.run(t, jar)
+		jarTest{"Fill jar", "https://www.google.com",
+			[]string{"e=5; secure"},
+			"a=1 b=2 c=3 d=4 e=5",
+			nil,
+		}.run(t, jar)
+		before := jar.All()
+
+		jar.ReplaceStorage(!b)
+		if _, ok := jar.content.(*boxed); ok == b {
+			t.Errorf("Storage not replaced")
+		}
+
+		after := jar.All()
+		if len(after) != len(before) {
+			t.Fatalf("Got %d cookies, want %d", len(after), len(before))
+		}
+		for _, c := range before {
+			found := false
+			for _, d := range after {
+				if c == d {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Lost cookie %#v", c)
+			}
+		}
+
+		jarTest{"Check jar", "http://www.host.test",
+			[]string{},
+			"a=1 b=2 c=3 d=4 e=5",
+			[]query{
+				{"http://www.host.test/foo/", "a=1 c=3 d=4 b=2"},
+				{"http://other.host.test/foo/", "c=3"},
+				{"http://www.google.com", ""},
+				{"https://www.google.com", "e=5"},
+			},
+		}.run(t, jar)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test Subscribe
+
+func TestSubscribe(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxCookiesPerHost = 2
+		events, unsubscribe := jar.Subscribe()
+		u := URL("http://www.host.test")
+
+		jar.SetCookies(u, []*http.Cookie{parseCookie("a=1"), parseCookie("b=2")})
+		jar.SetCookies(u, []*http.Cookie{parseCookie("a=X")})
+		jar.SetCookies(u, []*http.Cookie{parseCookie("c=3")})
+		jar.SetCookies(u, []*http.Cookie{parseCookie("c=3; max-age=-1")})
+		jar.SetCookies(u, []*http.Cookie{parseCookie("x=9; max-age=-1")})
+
+		want := []struct {
+			action EventAction
+			cookie string
+		}{
+			{EventCreate, "a=1"},
+			{EventCreate, "b=2"},
+			{EventUpdate, "a=X"},
+			{EventCreate, "c=3"},
+			{EventEvict, "b=2"},
+			{EventDelete, "c="},
+		}
+		for i, w := range want {
+			select {
+			case ev := <-events:
+				got := ev.Cookie.Name + "=" + ev.Cookie.Value
+				if ev.Action != w.action || got != w.cookie || ev.URL != u {
+					t.Errorf("#%d: got %d %q, want %d %q",
+						i, ev.Action, got, w.action, w.cookie)
+				}
+			default:
+				t.Fatalf("#%d: missing event", i)
+			}
+		}
+		select {
+		case ev := <-events:
+			t.Errorf("Unexpected event %#v", ev)
+		default:
+		}
+
+		unsubscribe()
+		unsubscribe()
+		if _, ok := <-events; ok {
+			t.Errorf("Channel not closed")
+		}
+		jar.SetCookies(u, []*http.Cookie{parseCookie("d=4")})
+	}
+}
+
+func TestSubscribeRemovals(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		u := URL("http://www.host.test")
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("a=1; max-age=3600"),
+			parseCookie("b=2; max-age=3600"),
+			parseCookie("c=3"),
+			parseCookie("d=4; max-age=3600"),
+			parseCookie("d=5; domain=host.test; max-age=3600"),
+			parseCookie("e=6; max-age=3600"),
+		})
+		for _, cookie := range jar.content.all() {
+			if cookie.Name == "e" {
+				cookie.Expires = time.Now().Add(-time.Second)
+			}
+		}
+		events, unsubscribe := jar.Subscribe()
+
+		jar.Remove("www.host.test", "/", "a")
+		jar.Remove("www.host.test", "/", "missing")
+		jar.DeleteMatching("host.test", "/", "b")
+		if n := jar.DeleteMatching("host.test", "/", "e"); n != 0 {
+			t.Errorf("Boxed=%t: Deleted %d expired cookies", b, n)
+		}
+		jar.ClearSession()
+		jar.ConsolidateName(u, "d")
+		jar.Cleanup()
+		unsubscribe()
+
+		want := []struct {
+			action EventAction
+			cookie string
+		}{
+			{EventDelete, "a="},
+			{EventDelete, "b=2"},
+			{EventDelete, "c=3"},
+			{EventDelete, "d=5"},
+			{EventExpire, "e=6"},
+		}
+		i := 0
+		for ev := range events {
+			got := ev.Cookie.Name + "=" + ev.Cookie.Value
+			if i >= len(want) {
+				t.Errorf("Boxed=%t: Unexpected event %d %q", b, ev.Action, got)
+				continue
+			}
+			if ev.Action != want[i].action || got != want[i].cookie || ev.URL != nil {
+				t.Errorf("Boxed=%t #%d: got %d %q, want %d %q",
+					b, i, ev.Action, got, want[i].action, want[i].cookie)
+			}
+			i++
+		}
+		if i < len(want) {
+			t.Errorf("Boxed=%t: Got %d events, want %d", b, i, len(want))
+		}
+		if got := jar.list(); got != "d=4" {
+			t.Errorf("Boxed=%t: Got %q, want %q", b, got, "d=4")
+		}
+	}
+}
+
+func TestSubscribeOverflow(t *testing.T) {
+	jar := NewJar(false)
+	events, unsubscribe := jar.Subscribe()
+	defer unsubscribe()
+
+	u := URL("http://www.host.test")
+	n := subscriberBuffer + 10
+	for i := 0; i < n; i++ {
+		jar.SetCookies(u, []*http.Cookie{parseCookie(fmt.Sprintf("n%d=1", i))})
+	}
+
+	// oldest events are dropped
+	for i := n - subscriberBuffer; i < n; i++ {
+		select {
+		case ev := <-events:
+			if name := fmt.Sprintf("n%d", i); ev.Cookie.Name != name {
+				t.Fatalf("Got event for %s, want %s", ev.Cookie.Name, name)
+			}
+		default:
+			t.Fatalf("Missing event for n%d", i)
+		}
+	}
+	select {
+	case ev := <-events:
+		t.Errorf("Unexpected event %#v", ev)
+	default:
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ExactDomainIsHostOnly
+
+func TestExactDomainIsHostOnly(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jarTest{"Domain equals host: domain cookie", "http://www.example.com",
+			[]string{"a=1; domain=www.example.com", "b=2; domain=.www.example.com"},
+			"a=1 b=2",
+			[]query{
+				{"http://www.example.com", "a=1 b=2"},
+				{"http://sub.www.example.com", "a=1 b=2"},
+				{"http://example.com", ""},
+			},
+		}.run(t, jar)
+
+		jar = NewJar(b)
+		jar.ExactDomainIsHostOnly = true
+		jarTest{"Domain equals host: host cookie", "http://www.example.com",
+			[]string{"a=1; domain=www.example.com", "b=2; domain=.www.example.com"},
+			"a=1 b=2",
+			[]query{
+				{"http://www.example.com", "a=1 b=2"},
+				{"http://sub.www.example.com", ""},
+				{"http://example.com", ""},
+			},
+		}.run(t, jar)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookiesSorted
+
+func TestCookiesSorted(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.CacheRetrieval = b // sorting must not modify cached cookies
+		jarTest{"Fill jar", "http://www.host.test/",
+			[]string{
+				"D=d; path=/foo",
+				"B=b; path=/foo/bar/baz/qux",
+				"C=c; path=/foo/bar/baz",
+				"A=a; path=/foo/bar",
+				"E=e; path=/foo/bar/baz"},
+			"A=a B=b C=c D=d E=e",
+			nil,
+		}.run(t, jar)
+
+		u := URL("http://www.host.test/foo/bar/baz/qux")
+		for _, tt := range []struct {
+			by       SortOrder
+			expected string
+		}{
+			{SortRFC, "B=b C=c E=e A=a D=d"},
+			{SortName, "A=a B=b C=c D=d E=e"},
+			{SortCreated, "D=d B=b C=c A=a E=e"},
+			{SortRFC, "B=b C=c E=e A=a D=d"},
+		} {
+			recieved := stringRep(jar.CookiesSorted(u, tt.by))
+			if recieved != tt.expected {
+				t.Errorf("Order %d: Want %q, got %q", tt.by, tt.expected, recieved)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test Import
+
+func TestImport(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxBytesPerCookie = 20
+		future := time.Now().Add(time.Hour)
+		past := time.Now().Add(-time.Hour)
+		imported, rejected := jar.Import([]*Cookie{
+			&Cookie{Name: "a", Value: "1", Domain: "www.host.test", Path: "/", HostOnly: true},
+			&Cookie{Name: "b", Value: "2", Domain: "host.test", Path: "/foo", Expires: future},
+			&Cookie{Name: "c", Value: "3", Domain: "www.host.test", Path: "/", Expires: past},
+			&Cookie{Name: "d", Value: "4", Domain: "", Path: "/"},
+			&Cookie{Name: "e", Value: "5", Domain: ".host.test", Path: "/"},
+			&Cookie{Name: "f", Value: "6", Domain: "www.host.test", Path: "foo"},
+			&Cookie{Name: "", Value: "7", Domain: "www.host.test", Path: "/"},
+			&Cookie{Name: "h", Value: strings.Repeat("x", 20), Domain: "www.host.test", Path: "/"},
+			&Cookie{Name: "i", Value: "9", Domain: "WWW.host.test", Path: "/"},
+			nil,
+		})
+		if imported != 2 || rejected != 8 {
+			t.Errorf("Got %d imported and %d rejected, want 2 and 8",
+				imported, rejected)
+		}
+
+		jarTest{"Check jar", "http://www.host.test",
+			[]string{},
+			"a=1 b=2",
+			[]query{
+				{"http://www.host.test/foo", "b=2 a=1"},
+				{"http://other.host.test/foo", "b=2"},
+			},
+		}.run(t, jar)
+	}
+}
+
+func TestImportValueCodec(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		src := NewJar(b)
+		src.ValueCodec = base64Codec{}
+		u := URL("http://www.host.test/")
+		src.SetCookies(u, []*http.Cookie{parseCookie("a=hello")})
+
+		jar := NewJar(b)
+		jar.ValueCodec = base64Codec{}
+		all := src.All()
+		cookies := []*Cookie{
+			&all[0],
+			jar.NewCookie("www.host.test", "/", "b", "world"),
+		}
+		if imported, _ := jar.Import(cookies); imported != 2 {
+			t.Fatalf("Got %d imported, want 2", imported)
+		}
+		if got := jar.list(); got != "a=aGVsbG8= b=d29ybGQ=" {
+			t.Errorf("Wrong content. Got %q", got)
+		}
+		if got := stringRep(jar.Cookies(u)); got != "a=hello b=world" {
+			t.Errorf("Wrong cookies. Got %q", got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test SessionCookies and PersistentCookies
+
+func TestSessionAndPersistentCookies(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jarTest{"Fill jar", "http://www.host.test/foo/",
+			[]string{
+				"a=1",
+				"b=2; max-age=100",
+				"c=3; " + expiresIn(100),
+				"d=4; path=/",
+				"e=5; path=/; max-age=100",
+				"f=6; domain=host.test; path=/"},
+			"a=1 b=2 c=3 d=4 e=5 f=6",
+			nil,
+		}.run(t, jar)
+
+		for _, tt := range []struct {
+			url, session, persistent string
+		}{
+			{"http://www.host.test/foo/", "a=1 d=4 f=6", "b=2 c=3 e=5"},
+			{"http://www.host.test/", "d=4 f=6", "e=5"},
+			{"http://other.host.test/foo/", "f=6", ""},
+		} {
+			u := URL(tt.url)
+			if got := stringRep(jar.SessionCookies(u)); got != tt.session {
+				t.Errorf("%s: Wrong session cookies. Want %q, got %q",
+					tt.url, tt.session, got)
+			}
+			if got := stringRep(jar.PersistentCookies(u)); got != tt.persistent {
+				t.Errorf("%s: Wrong persistent cookies. Want %q, got %q",
+					tt.url, tt.persistent, got)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookiesForURLString and SetCookiesForURLString
+
+func TestURLString(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		for _, tt := range []struct {
+			raw string
+			ok  bool
+		}{
+			{"http://www.host.test/", true},
+			{"HTTPS://www.host.test/foo", true},
+			{"ftp://www.host.test/", false},
+			{"mailto:someone@host.test", false},
+			{"www.host.test", false},
+			{"http://www.host.test/%zz", false},
+			{"http:///foo", false},
+		} {
+			err := jar.SetCookiesForURLString(tt.raw,
+				[]*http.Cookie{parseCookie("a=1; path=/")})
+			if (err == nil) != tt.ok {
+				t.Errorf("SetCookiesForURLString(%q): unexpected error %v", tt.raw, err)
+			}
+			cookies, err := jar.CookiesForURLString(tt.raw)
+			if (err == nil) != tt.ok {
+				t.Errorf("CookiesForURLString(%q): unexpected error %v", tt.raw, err)
+			}
+			if got := stringRep(cookies); tt.ok && got != "a=1" || !tt.ok && got != "" {
+				t.Errorf("CookiesForURLString(%q): Got %q", tt.raw, got)
+			}
+		}
+		if jar.list() != "a=1" {
+			t.Errorf("Wrong content. Got %q", jar.list())
+		}
+	}
+}
+
+func TestImportHttpOnly(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		for _, tt := range []struct {
+			policy           HttpOnlyPolicy
+			stored, imported bool
+			want             bool
+		}{
+			{HttpOnlyFromSource, false, true, true},
+			{HttpOnlyFromSource, true, false, false},
+			{HttpOnlyFromTarget, false, true, false},
+			{HttpOnlyFromTarget, true, false, true},
+		} {
+			jar := NewJar(b)
+			jar.ImportHttpOnly = tt.policy
+			jar.Import([]*Cookie{
+				&Cookie{Name: "a", Value: "1", Domain: "www.host.test", Path: "/",
+					HttpOnly: tt.stored},
+				&Cookie{Name: "b", Value: "2", Domain: "www.host.test", Path: "/"},
+			})
+			jar.Import([]*Cookie{
+				&Cookie{Name: "a", Value: "X", Domain: "www.host.test", Path: "/",
+					HttpOnly: tt.imported},
+				&Cookie{Name: "c", Value: "3", Domain: "www.host.test", Path: "/",
+					HttpOnly: tt.imported},
+			})
+			if jar.list() != "a=X b=2 c=3" {
+				t.Errorf("Wrong content. Got %q", jar.list())
+			}
+			for _, cookie := range jar.All() {
+				if cookie.Name == "a" && cookie.HttpOnly != tt.want {
+					t.Errorf("Policy %d, stored %t, imported %t: got %t",
+						tt.policy, tt.stored, tt.imported, cookie.HttpOnly)
+				}
+				if cookie.Name == "c" && cookie.HttpOnly != tt.imported {
+					t.Errorf("New cookie c: got HttpOnly %t", cookie.HttpOnly)
+				}
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test TrackSource
+
+func TestTrackSource(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.TrackSource = true
+		jar.SetCookies(URL("http://www.host.test/login"), []*http.Cookie{
+			parseCookie("a=1; domain=host.test; path=/"),
+			parseCookie("b=2; domain=host.test; path=/"),
+		})
+		jar.SetCookies(URL("http://other.HOST.test:8080/refresh?x=y"), []*http.Cookie{
+			parseCookie("a=X; domain=host.test; path=/"),
+		})
+
+		for _, cookie := range jar.All() {
+			want := map[string]string{
+				"a": "other.host.test/refresh",
+				"b": "www.host.test/login",
+			}[cookie.Name]
+			if cookie.Source != want {
+				t.Errorf("Cookie %s: Got source %q, want %q",
+					cookie.Name, cookie.Source, want)
+			}
+		}
+
+		// the source survives Save and LoadReplace
+		file := filepath.Join(os.TempDir(), fmt.Sprintf("cookiejar-source-%d.json", os.Getpid()))
+		if err := jar.Save(file); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		loaded := NewJar(b)
+		err := loaded.LoadReplace(file)
+		os.Remove(file)
+		if err != nil {
+			t.Fatalf("LoadReplace failed: %v", err)
+		}
+		for _, cookie := range loaded.All() {
+			if cookie.Source == "" {
+				t.Errorf("Cookie %s: Source lost", cookie.Name)
+			}
+		}
+
+		jar = NewJar(b)
+		jar.SetCookies(URL("http://www.host.test/login"),
+			[]*http.Cookie{parseCookie("a=1")})
+		if all := jar.All(); all[0].Source != "" {
+			t.Errorf("Untracked source %q", all[0].Source)
+		}
+		if data, _ := json.Marshal(jar.All()); bytes.Contains(data, []byte("Source")) {
+			t.Errorf("Untracked source in JSON: %s", data)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test Save and LoadReplace
+
+func TestLoadReplace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cookiejar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jarTest{"Fill jar", "http://www.host.test",
+			[]string{"a=1", "b=2; domain=host.test; max-age=100", "c=3; path=/foo"},
+			"a=1 b=2 c=3",
+			nil,
+		}.run(t, jar)
+		good := filepath.Join(dir, "good.json")
+		if err := jar.Save(good); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		data, err := ioutil.ReadFile(good)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, field := range []string{`"Source"`, `"Scheme"`, `"Seq"`} {
+			if bytes.Contains(data, []byte(field)) {
+				t.Errorf("Saved unused field %s: %s", field, data)
+			}
+		}
+
+		other := NewJar(!b)
+		other.SetCookies(URL("http://www.google.com"), []*http.Cookie{parseCookie("x=9")})
+		for i, corrupt := range []string{
+			`[{"Name":"d","Value":"4","Domain":"www.host.test","Path":"/"`,
+			`[{"Name":"d","Value":"4","Domain":"","Path":"/"}]`,
+			`{"Name":"d"}`,
+		} {
+			bad := filepath.Join(dir, fmt.Sprintf("bad%d.json", i))
+			if err := ioutil.WriteFile(bad, []byte(corrupt), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if err := other.LoadReplace(bad); err == nil {
+				t.Errorf("#%d: Loaded corrupt file", i)
+			}
+			if other.list() != "x=9" {
+				t.Errorf("#%d: Jar modified. Got %q", i, other.list())
+			}
+		}
+		if err := other.LoadReplace(filepath.Join(dir, "missing.json")); err == nil {
+			t.Errorf("Loaded missing file")
+		}
+
+		if err := other.LoadReplace(good); err != nil {
+			t.Fatalf("LoadReplace failed: %v", err)
+		}
+		jarTest{"Check jar", "http://www.host.test",
+			[]string{},
+			"a=1 b=2 c=3",
+			[]query{
+				{"http://www.host.test/foo", "c=3 a=1 b=2"},
+				{"http://other.host.test/foo", "b=2"},
+				{"http://www.google.com", ""},
+			},
+		}.run(t, other)
+	}
+}
+
+// Loading enforces the limits of the target jar.
+func TestLoadOverLimits(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cookiejar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "cookies.json")
+
+	loads := map[string]func(from, to *Jar) error{
+		"json": func(from, to *Jar) error {
+			if err := from.Save(file); err != nil {
+				return err
+			}
+			return to.LoadReplace(file)
+		},
+		"text": func(from, to *Jar) error {
+			text, err := from.MarshalText()
+			if err != nil {
+				return err
+			}
+			return to.UnmarshalText(text)
+		},
+		"gob": func(from, to *Jar) error {
+			data, err := from.GobEncode()
+			if err != nil {
+				return err
+			}
+			return to.GobDecode(data)
+		},
+	}
+
+	now := time.Now()
+	stored := []Cookie{
+		{Name: "a", Value: "1", Domain: "www.host.test", LastAccess: now.Add(-1 * time.Hour)},
+		{Name: "b", Value: "2", Domain: "www.host.test", LastAccess: now.Add(-3 * time.Hour)},
+		{Name: "c", Value: "3", Domain: "www.host.test", LastAccess: now.Add(-2 * time.Hour)},
+		{Name: "d", Value: "4", Domain: "www.other.test", LastAccess: now.Add(-4 * time.Hour)},
+		{Name: "e", Value: "5", Domain: "www.third.test", LastAccess: now.Add(-30 * time.Minute)},
+	}
+	for i := range stored {
+		stored[i].Path, stored[i].HostOnly = "/", true
+		stored[i].Expires = now.Add(24 * time.Hour)
+		stored[i].Created = stored[i].LastAccess
+	}
+
+	for name, load := range loads {
+		for _, b := range []bool{true, false} {
+			source := NewJar(b)
+			source.Add(stored)
+			for _, tt := range []struct {
+				limit func(*Jar)
+				want  string
+			}{
+				{func(jar *Jar) { jar.MaxCookiesPerHost = 2 }, "a=1 c=3 d=4 e=5"},
+				{func(jar *Jar) { jar.MaxCookiesTotal = 3 }, "a=1 c=3 e=5"},
+				{func(jar *Jar) { jar.MaxDomains = 2 }, "a=1 b=2 c=3 e=5"},
+			} {
+				jar := NewJar(b)
+				tt.limit(jar)
+				if err := load(source, jar); err != nil {
+					t.Fatalf("%s Boxed=%t: %v", name, b, err)
+				}
+				if got := jar.list(); got != tt.want {
+					t.Errorf("%s Boxed=%t: Got %q, want %q", name, b, got, tt.want)
+				}
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test DeleteMatching
+
+func TestDeleteMatching(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		fill := func() *Jar {
+			jar := NewJar(b)
+			jar.Add([]Cookie{
+				Cookie{Name: "a", Value: "1", Domain: "example.com", Path: "/"},
+				Cookie{Name: "a", Value: "2", Domain: "www.example.com", Path: "/foo"},
+				Cookie{Name: "b", Value: "3", Domain: "www.example.com", Path: "/foo/bar"},
+				Cookie{Name: "a", Value: "4", Domain: "myexample.com", Path: "/foo"},
+				Cookie{Name: "b", Value: "5", Domain: "www.google.com", Path: "/"},
+				Cookie{Name: "c", Value: "6", Domain: "example.com", Path: "/foobar"},
+			})
+			return jar
+		}
+
+		for _, tt := range []struct {
+			domain, path, name string
+			deleted            int
+			content            string
+		}{
+			{"example.com", "", "", 4, "a=4 b=5"},
+			{".Example.COM", "", "", 4, "a=4 b=5"},
+			{"www.example.com", "", "", 2, "a=1 a=4 b=5 c=6"},
+			{"", "/foo", "", 3, "a=1 b=5 c=6"},
+			{"", "/foo/", "", 1, "a=1 a=2 a=4 b=5 c=6"},
+			{"", "/foobar", "", 1, "a=1 a=2 a=4 b=3 b=5"},
+			{"example.com", "/foo", "a", 1, "a=1 a=4 b=3 b=5 c=6"},
+			{"", "", "b", 2, "a=1 a=2 a=4 c=6"},
+			{"", "", "", 6, ""},
+			{"xample.com", "", "", 0, "a=1 a=2 a=4 b=3 b=5 c=6"},
+		} {
+			jar := fill()
+			deleted := jar.DeleteMatching(tt.domain, tt.path, tt.name)
+			if deleted != tt.deleted || jar.list() != tt.content {
+				t.Errorf("%q %q %q: Deleted %d (want %d), content %q (want %q)",
+					tt.domain, tt.path, tt.name, deleted, tt.deleted,
+					jar.list(), tt.content)
+			}
+			if bx, ok := jar.content.(*boxed); ok {
+				for box, f := range *bx {
+					if len(*f) == 0 {
+						t.Errorf("Empty box %q left", box)
+					}
+				}
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test AppendCookies
+
+func TestAppendCookies(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jarTest{"Fill jar", "http://www.host.test/",
+			[]string{
+				"A=a; path=/foo/bar",
+				"B=b; path=/foo/bar/baz/qux",
+				"C=c; path=/foo/bar/baz",
+				"D=d; path=/foo"},
+			"A=a B=b C=c D=d",
+			nil,
+		}.run(t, jar)
+
+		buf := make([]*http.Cookie, 0, 2)
+		for _, s := range []string{
+			"http://www.host.test/foo/bar/baz/qux",
+			"http://www.host.test/foo/bar",
+			"http://www.host.test/",
+			"ftp://www.host.test/foo/bar",
+			"http://www.host.test/foo/bar/baz/",
+		} {
+			u := URL(s)
+			buf = jar.AppendCookies(buf[:0], u)
+			if got, want := stringRep(buf), stringRep(jar.Cookies(u)); got != want {
+				t.Errorf("%s: Got %q, want %q", s, got, want)
+			}
+		}
+
+		prefix := []*http.Cookie{&http.Cookie{Name: "X", Value: "x"}}
+		got := jar.AppendCookies(prefix, URL("http://www.host.test/foo"))
+		if stringRep(got) != "X=x D=d" {
+			t.Errorf("Got %q", stringRep(got))
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test duplicates in one call to SetCookies
+
+func TestDuplicatesInBatch(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		events, unsubscribe := jar.Subscribe()
+		jarTest{"Duplicates", "http://www.host.test/foo/",
+			[]string{
+				"a=1", "b=2", "a=3",
+				"c=4; domain=host.test", "c=5; domain=.HOST.test",
+				"d=6; path=/", "d=7; path=/x", "a=8"},
+			"a=8 b=2 c=5 d=6 d=7",
+			[]query{{"http://www.host.test/foo/", "b=2 c=5 a=8 d=6"}},
+		}.run(t, jar)
+		unsubscribe()
+
+		created := 0
+		for ev := range events {
+			if ev.Action != EventCreate {
+				t.Errorf("Unexpected event %d for %s", ev.Action, ev.Cookie.Name)
+			}
+			created++
+		}
+		if created != 5 {
+			t.Errorf("Got %d created cookies, want 5", created)
+		}
+
+		// a rejected duplicate does not replace a valid cookie
+		jar = NewJar(b)
+		jarTest{"Invalid duplicates", "http://www.host.test/foo/",
+			[]string{
+				"a=1", "a=" + strings.Repeat("x", 5000),
+				"b=2", "b=3; domain=other.test"},
+			"a=1 b=2",
+			nil,
+		}.run(t, jar)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookieMap
+
+func TestCookieMap(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jarTest{"Fill jar", "http://www.test.org/",
+			[]string{"A=1; path=/",
+				"A=2; path=/path",
+				"A=3; path=/quux",
+				"A=4; path=/path/foo",
+				"A=5; domain=.test.org; path=/path",
+				"A=6; domain=.test.org; path=/quux",
+				"A=7; domain=.test.org; path=/path/foo",
+				"B=8",
+			},
+			"A=1 A=2 A=3 A=4 A=5 A=6 A=7 B=8",
+			nil,
+		}.run(t, jar)
+
+		for _, tt := range []struct {
+			url  string
+			want map[string]string
+		}{
+			{"http://www.test.org/path", map[string]string{"A": "5", "B": "8"}},
+			{"http://www.test.org/path/foo", map[string]string{"A": "7", "B": "8"}},
+			{"http://www.test.org/", map[string]string{"A": "1", "B": "8"}},
+			{"http://other.test.org/quux", map[string]string{"A": "6"}},
+			{"http://www.google.com/", map[string]string{}},
+		} {
+			got := jar.CookieMap(URL(tt.url))
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("%s: Got %v, want %v", tt.url, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestImportTimes(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		for _, reset := range []bool{false, true} {
+			jar := NewJar(b)
+			jar.MaxCookiesPerHost = 2
+			jar.ImportResetTimes = reset
+			now := time.Now()
+			cookie := func(name string, age time.Duration) *Cookie {
+				return &Cookie{Name: name, Value: "1",
+					Domain: "www.host.test", Path: "/",
+					Created:    now.Add(-2 * age),
+					LastAccess: now.Add(-age),
+				}
+			}
+			jar.Import([]*Cookie{
+				cookie("b", time.Hour),
+				cookie("c", 2*time.Hour),
+				cookie("a", 3*time.Hour),
+			})
+
+			want := "b=1 c=1" // a is the least recently used one
+			if reset {
+				want = "a=1 c=1" // b was imported first
+			}
+			if jar.list() != want {
+				t.Errorf("reset=%t: Got %q, want %q", reset, jar.list(), want)
+			}
+			for _, c := range jar.All() {
+				preserved := c.Created.Before(now.Add(-time.Hour)) &&
+					c.LastAccess.Before(now.Add(-time.Minute))
+				if preserved == reset {
+					t.Errorf("reset=%t: Cookie %s has Created=%s LastAccess=%s",
+						reset, c.Name, c.Created, c.LastAccess)
+				}
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test StorageStats
+
+func TestStorageStats(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		cookies := make([]Cookie, 0, 100)
+		for i := 0; i < 100; i++ {
+			domain := "www.host.test"
+			if i%2 == 1 {
+				domain = "www.google.com"
+			}
+			cookies = append(cookies, Cookie{
+				Name: fmt.Sprintf("n%d", i), Value: "1",
+				Domain: domain, Path: "/",
+			})
+		}
+		jar.Add(cookies)
+
+		s := jar.StorageStats()
+		if s.Cookies != 100 || s.Expired != 0 || s.Capacity < 100 {
+			t.Errorf("Full jar: got %+v", s)
+		}
+
+		for i := 0; i < 90; i++ {
+			domain := "www.host.test"
+			if i%2 == 1 {
+				domain = "www.google.com"
+			}
+			jar.Remove(domain, "/", fmt.Sprintf("n%d", i))
+		}
+		s = jar.StorageStats()
+		if s.Cookies != 10 || s.Capacity < 100 {
+			t.Errorf("After deletion: got %+v", s)
+		}
+		if b && (s.Boxes["host.test"] != 5 || s.Boxes["google.com"] != 5) {
+			t.Errorf("Wrong boxes %v", s.Boxes)
+		}
+		if !b && s.Boxes != nil {
+			t.Errorf("Unexpected boxes %v", s.Boxes)
+		}
+
+		// Compact shrinks the storage but keeps the cookies
+		before := jar.list()
+		jar.Compact()
+		s = jar.StorageStats()
+		if s.Cookies != 10 || s.Capacity != 10 {
+			t.Errorf("After Compact: got %+v", s)
+		}
+		if jar.list() != before {
+			t.Errorf("Compact changed content to %q", jar.list())
+		}
+
+		// empty boxes are dropped
+		for i := 91; i < 100; i += 2 {
+			jar.Remove("www.google.com", "/", fmt.Sprintf("n%d", i))
+		}
+		jar.Compact()
+		if s = jar.StorageStats(); s.Capacity != 5 || len(s.Boxes) > 1 {
+			t.Errorf("After second Compact: got %+v", s)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test IsThirdParty and ThirdPartyCookies
+
+var isThirdPartyTests = []struct {
+	cookieHost, topLevelSite string
+	thirdParty               bool
+}{
+	{"www.shop.com", "shop.com", false},
+	{"shop.com", "www.shop.com", false},
+	{"static.shop.com", "WWW.Shop.COM.", false},
+	{"tracker.com", "shop.com", true},
+	{"shop.com.tracker.com", "shop.com", true},
+	{"www.bbc.co.uk", "news.bbc.co.uk", false},
+	{"www.bbc.co.uk", "www.itv.co.uk", true},
+	{"co.uk", "bbc.co.uk", true},
+	{"1.2.3.4", "1.2.3.4", false},
+	{"1.2.3.4", "1.2.3.5", true},
+	{"www.bücher.test", "xn--bcher-kva.test", false},
+}
+
+func TestIsThirdParty(t *testing.T) {
+	jar := NewJar(false)
+	for i, tt := range isThirdPartyTests {
+		if got := jar.IsThirdParty(tt.cookieHost, tt.topLevelSite); got != tt.thirdParty {
+			t.Errorf("#%d %q on %q: got %t", i, tt.cookieHost, tt.topLevelSite, got)
+		}
+	}
+}
+
+func TestThirdPartyCookies(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jarTest{"Fill jar", "http://ads.tracker.com",
+			[]string{"a=1", "b=2; domain=tracker.com"},
+			"a=1 b=2",
+			nil,
+		}.run(t, jar)
+
+		u := URL("http://ads.tracker.com")
+		if got := stringRep(jar.ThirdPartyCookies(u, "www.shop.com")); got != "a=1 b=2" {
+			t.Errorf("Cross-site: got %q", got)
+		}
+		if got := stringRep(jar.ThirdPartyCookies(u, "tracker.com")); got != "" {
+			t.Errorf("Same-site: got %q", got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test BlockThirdParty
+
+func TestBlockThirdParty(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		for _, block := range []bool{true, false} {
+			jar := NewJar(b)
+			jar.BlockThirdParty = block
+			shop := URL("http://www.shop.com/")
+			tracker := URL("http://ads.tracker.com:8080/pixel")
+
+			// navigation to shop.com loads a tracking pixel
+			jar.SetCookiesForSite(shop, "shop.com", []*http.Cookie{parseCookie("s=1")})
+			jar.SetCookiesForSite(tracker, "shop.com",
+				[]*http.Cookie{parseCookie("t=1; domain=tracker.com; path=/")})
+
+			want, wantSent := "s=1 t=1", "t=1"
+			if block {
+				want, wantSent = "s=1", ""
+			}
+			if jar.list() != want {
+				t.Errorf("block=%t: Wrong content. Got %q, want %q", block, jar.list(), want)
+			}
+			if got := stringRep(jar.CookiesForSite(tracker, "shop.com")); got != wantSent {
+				t.Errorf("block=%t: Sent %q, want %q", block, got, wantSent)
+			}
+			if got := stringRep(jar.CookiesForSite(shop, "www.shop.com")); got != "s=1" {
+				t.Errorf("block=%t: First party got %q", block, got)
+			}
+
+			// without top-level site nothing is blocked
+			jar.SetCookies(tracker, []*http.Cookie{parseCookie("u=2; domain=tracker.com; path=/")})
+			got := stringRep(jar.Cookies(tracker))
+			if block && got != "u=2" || !block && got != "t=1 u=2" {
+				t.Errorf("block=%t: Cookies got %q", block, got)
+			}
+			if got := stringRep(jar.CookiesForSite(tracker, "tracker.com")); block && got != "u=2" {
+				t.Errorf("block=%t: First party tracker got %q", block, got)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookiesForRequestContext
+
+func TestCookiesForRequestContext(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		u := URL("https://www.shop.com/cart")
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("lax=1; samesite=lax"),
+			parseCookie("none=2; samesite=none; secure"),
+			parseCookie("plain=3"),
+			parseCookie("strict=4; samesite=strict"),
+		})
+
+		for _, tt := range []struct {
+			desc     string
+			ctx      RequestContext
+			expected string
+		}{
+			{"no top-level site", RequestContext{}, "lax=1 none=2 plain=3 strict=4"},
+			{"same-site subresource",
+				RequestContext{TopLevelSite: "shop.com", Method: "POST"},
+				"lax=1 none=2 plain=3 strict=4"},
+			{"cross-site GET navigation",
+				RequestContext{TopLevelSite: "blog.test", IsTopLevelNavigation: true, Method: "GET"},
+				"lax=1 none=2 plain=3"},
+			{"cross-site HEAD navigation",
+				RequestContext{TopLevelSite: "blog.test", IsTopLevelNavigation: true, Method: "HEAD"},
+				"lax=1 none=2 plain=3"},
+			{"cross-site navigation without method",
+				RequestContext{TopLevelSite: "blog.test", IsTopLevelNavigation: true},
+				"lax=1 none=2 plain=3"},
+			{"cross-site POST navigation",
+				RequestContext{TopLevelSite: "blog.test", IsTopLevelNavigation: true, Method: "POST"},
+				"none=2 plain=3"},
+			{"cross-site subresource",
+				RequestContext{TopLevelSite: "blog.test", Method: "GET"},
+				"none=2 plain=3"},
+		} {
+			got := stringRep(jar.CookiesForRequestContext(u, tt.ctx))
+			if got != tt.expected {
+				t.Errorf("Boxed=%t %s: Got %q, want %q", b, tt.desc, got, tt.expected)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ExportHAR and ImportHAR
+
+func TestHARRoundTrip(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jarTest{"Fill jar", "https://www.host.test/foo/",
+			[]string{"a=1", "b=2; domain=host.test; path=/; secure",
+				"c=3; max-age=100; httponly"},
+			"a=1 b=2 c=3",
+			nil,
+		}.run(t, jar)
+
+		var buf bytes.Buffer
+		if err := jar.ExportHAR(&buf); err != nil {
+			t.Fatalf("ExportHAR failed: %v", err)
+		}
+		if !strings.Contains(buf.String(), `"domain":".host.test"`) {
+			t.Errorf("Domain cookie without leading dot: %s", buf.String())
+		}
+
+		other := NewJar(!b)
+		if _, rejected, err := other.ImportHAR(&buf); err != nil || rejected != 0 {
+			t.Fatalf("ImportHAR failed: %d rejected, %v", rejected, err)
+		}
+		before, after := jar.All(), other.All()
+		if len(before) != len(after) {
+			t.Fatalf("Got %d cookies, want %d", len(after), len(before))
+		}
+		for _, c := range before {
+			found := false
+			for _, d := range after {
+				if c.Name == d.Name && c.Value == d.Value &&
+					c.Domain == d.Domain && c.Path == d.Path &&
+					c.HostOnly == d.HostOnly && c.Secure == d.Secure &&
+					c.HttpOnly == d.HttpOnly && c.Expires.Equal(d.Expires) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Lost cookie %#v", c)
+			}
+		}
+	}
+}
+
+func TestHARValueCodec(t *testing.T) {
+	u := URL("http://www.host.test/")
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.ValueCodec = base64Codec{}
+		jar.SetCookies(u, []*http.Cookie{parseCookie("a=hello")})
+
+		var buf bytes.Buffer
+		if err := jar.ExportHAR(&buf); err != nil {
+			t.Fatalf("ExportHAR failed: %v", err)
+		}
+		if !strings.Contains(buf.String(), `"value":"hello"`) {
+			t.Errorf("Value not decoded: %s", buf.String())
+		}
+		data := buf.String()
+
+		plain := NewJar(b)
+		if _, _, err := plain.ImportHAR(strings.NewReader(data)); err != nil {
+			t.Fatalf("ImportHAR failed: %v", err)
+		}
+		if got := stringRep(plain.Cookies(u)); got != "a=hello" {
+			t.Errorf("Without codec: Got %q, want %q", got, "a=hello")
+		}
+
+		encoded := NewJar(b)
+		encoded.ValueCodec = base64Codec{}
+		if _, _, err := encoded.ImportHAR(strings.NewReader(data)); err != nil {
+			t.Fatalf("ImportHAR failed: %v", err)
+		}
+		if got := encoded.list(); got != "a=aGVsbG8=" {
+			t.Errorf("Value not encoded: Got %q", got)
+		}
+		if got := stringRep(encoded.Cookies(u)); got != "a=hello" {
+			t.Errorf("With codec: Got %q, want %q", got, "a=hello")
+		}
+	}
+}
+
+func TestImportHAR(t *testing.T) {
+	har := `[
+  {"name": "a", "value": "1", "path": "/", "domain": "www.host.test",
+   "httpOnly": false, "secure": false},
+  {"name": "b", "value": "2", "domain": ".Host.test",
+   "expires": "2099-07-24T19:20:30.45+01:00", "httpOnly": true, "secure": true},
+  {"name": "c", "value": "3", "path": "/", "domain": "www.host.test",
+   "expires": "2009-07-24T19:20:30.45+01:00"},
+  {"name": "d", "value": "4", "path": "/foo", "domain": "www.host.test"}
+]`
+	jar := NewJar(false)
+	imported, rejected, err := jar.ImportHAR(strings.NewReader(har))
+	if err != nil {
+		t.Fatalf("ImportHAR failed: %v", err)
+	}
+	if imported != 3 || rejected != 1 {
+		t.Errorf("Imported %d, rejected %d cookies, want 3 and 1", imported, rejected)
+	}
+	jarTest{"Check jar", "http://www.host.test",
+		[]string{},
+		"a=1 b=2 d=4",
+		[]query{
+			{"http://www.host.test/foo", "d=4 a=1"},
+			{"https://www.host.test/foo", "d=4 a=1 b=2"},
+			{"https://other.host.test/", "b=2"},
+		},
+	}.run(t, jar)
+
+	for _, bad := range []string{`{"name": "a"}`, `[{"name": "a", "expires": "tomorrow"}]`} {
+		if _, _, err := jar.ImportHAR(strings.NewReader(bad)); err == nil {
+			t.Errorf("Imported %q", bad)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test NewDefaultJar
+
+func TestNewDefaultJar(t *testing.T) {
+	jar := NewDefaultJar()
+
+	u := URL("http://www.host.test")
+	jar.SetCookies(u, []*http.Cookie{
+		&http.Cookie{Name: "big", Value: strings.Repeat("x", 5000)},
+		&http.Cookie{Name: "small", Value: strings.Repeat("x", 4000)},
+	})
+	if len(jar.All()) != 1 || jar.All()[0].Name != "small" {
+		t.Errorf("Wrong content. Got %d cookies", len(jar.All()))
+	}
+
+	// 50 cookies per host
+	for i := 0; i < 60; i++ {
+		jar.SetCookies(u, []*http.Cookie{parseCookie(fmt.Sprintf("n%d=1", i))})
+	}
+	if n := len(jar.All()); n != 50 {
+		t.Errorf("Got %d cookies for one host, want 50", n)
+	}
+
+	// 3000 cookies in total
+	for h := 0; h < 70; h++ {
+		u := URL(fmt.Sprintf("http://www.host%d.test", h))
+		cookies := make([]*http.Cookie, 50)
+		for i := range cookies {
+			cookies[i] = parseCookie(fmt.Sprintf("n%d=1", i))
+		}
+		jar.SetCookies(u, cookies)
+	}
+	if n := len(jar.All()); n != 3000 {
+		t.Errorf("Got %d cookies, want 3000", n)
+	}
+	if got := stringRep(jar.Cookies(URL("http://www.host69.test"))); got == "" {
+		t.Errorf("Newest cookies evicted")
+	}
+	if got := stringRep(jar.Cookies(u)); got != "" {
+		t.Errorf("Oldest cookies not evicted: %q", got)
+	}
+}
+
+func TestMaxCookiesTotal(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxCookiesTotal = 3
+		jarTest{"Fill jar", "http://www.host.test",
+			[]string{"a=1", "b=2", "c=3"},
+			"a=1 b=2 c=3",
+			nil,
+		}.run(t, jar)
+		jarTest{"Evict", "http://www.google.com",
+			[]string{"d=4", "e=5"},
+			"c=3 d=4 e=5",
+			nil,
+		}.run(t, jar)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test MaxDomains
+
+func TestMaxDomains(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.DeferCleanup = true
+		jar.MaxDomains = 2
+		jar.SetCookies(URL("http://www.a.test"), []*http.Cookie{parseCookie("a1=1")})
+		jar.SetCookies(URL("http://x.a.test"), []*http.Cookie{parseCookie("a2=2")})
+		jar.SetCookies(URL("http://www.b.test"), []*http.Cookie{
+			parseCookie("b1=1"), parseCookie("b2=2"),
+		})
+		jar.SetCookies(URL("http://www.c.test"), []*http.Cookie{parseCookie("c1=1")})
+		jar.Cookies(URL("http://www.a.test")) // a.test is now most recent
+
+		if removed := jar.Cleanup(); removed != 2 {
+			t.Errorf("Boxed=%t: Cleanup removed %d cookies, want 2", b, removed)
+		}
+		if got := jar.list(); got != "a1=1 a2=2 c1=1" {
+			t.Errorf("Boxed=%t: After Cleanup got %q", b, got)
+		}
+
+		// limit enforced on new cookies
+		jar.DeferCleanup = false
+		jar.SetCookies(URL("http://www.d.test"), []*http.Cookie{parseCookie("d1=1")})
+		if got := jar.list(); got != "a1=1 a2=2 d1=1" {
+			t.Errorf("Boxed=%t: After new domain got %q", b, got)
+		}
+		if b {
+			if boxes := len(jar.StorageStats().Boxes); boxes != 2 {
+				t.Errorf("Boxed=%t: Got %d boxes, want 2", b, boxes)
+			}
+		}
+
+		// protected cookies keep their domain
+		jar.EvictionVeto = func(c *Cookie) bool { return c.Name == "a2" }
+		jar.MaxDomains = 1
+		jar.SetCookies(URL("http://www.e.test"), []*http.Cookie{parseCookie("e1=1")})
+		if got := jar.list(); got != "a2=2 e1=1" {
+			t.Errorf("Boxed=%t: With veto got %q", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test AllCookiesForHost
+
+func TestAllCookiesForHost(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("https://www.host.test/some/path"), []*http.Cookie{
+			parseCookie("a=1"),
+			parseCookie("b=2; secure"),
+			parseCookie("c=3; path=/other"),
+			parseCookie("d=4; domain=host.test"),
+		})
+		jar.SetCookies(URL("https://sub.www.host.test"), []*http.Cookie{
+			parseCookie("e=5"),
+		})
+		jar.SetCookies(URL("https://www.google.com"), []*http.Cookie{
+			parseCookie("f=6"),
+		})
+
+		var names []string
+		for _, cookie := range jar.AllCookiesForHost("WWW.host.test") {
+			names = append(names, cookie.Name)
+		}
+		sort.Strings(names)
+		if got := strings.Join(names, " "); got != "a b c d" {
+			t.Errorf("Boxed=%t: Got %q, want %q", b, got, "a b c d")
+		}
+		// Cookies applies the full send policy
+		if got := stringRep(jar.Cookies(URL("http://www.host.test/some/x"))); got != "a=1 d=4" {
+			t.Errorf("Boxed=%t: Cookies returned %q", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test MaxLoadBytes and MaxLoadCookies
+
+// endlessHAR is an endless JSON list of HAR cookies.
+type endlessHAR struct{ pos int }
+
+func (e *endlessHAR) Read(p []byte) (int, error) {
+	const elem = `{"name":"a","value":"1","domain":"www.host.test"},`
+	n := 0
+	if e.pos == 0 {
+		p[0] = '['
+		n, e.pos = 1, 1
+	}
+	for n < len(p) {
+		c := copy(p[n:], elem[(e.pos-1)%len(elem):])
+		n += c
+		e.pos += c
+	}
+	return n, nil
+}
+
+func TestLoadLimits(t *testing.T) {
+	jar := NewJar(true)
+	jar.MaxLoadBytes = 1 << 16
+	if _, _, err := jar.ImportHAR(&endlessHAR{}); err != errLoadTooLarge {
+		t.Errorf("Got error %v, want %v", err, errLoadTooLarge)
+	}
+
+	jar = NewJar(true)
+	jar.MaxLoadCookies = 100
+	if _, _, err := jar.ImportHAR(&endlessHAR{}); err != errLoadTooMany {
+		t.Errorf("Got error %v, want %v", err, errLoadTooMany)
+	}
+	if n := len(jar.All()); n != 0 {
+		t.Errorf("Got %d cookies after failed import", n)
+	}
+
+	dir, err := ioutil.TempDir("", "cookiejar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cookies.json")
+	full := NewJar(true)
+	full.SetCookies(URL("http://www.host.test"), []*http.Cookie{
+		parseCookie("a=1"), parseCookie("b=2"), parseCookie("c=3"),
+	})
+	if err := full.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	jar = NewJar(true)
+	jar.MaxLoadCookies = 2
+	if err := jar.LoadReplace(path); err != errLoadTooMany {
+		t.Errorf("Got error %v, want %v", err, errLoadTooMany)
+	}
+	jar.MaxLoadCookies = 3
+	jar.MaxLoadBytes = 10
+	if err := jar.LoadReplace(path); err != errLoadTooLarge {
+		t.Errorf("Got error %v, want %v", err, errLoadTooLarge)
+	}
+	jar.MaxLoadBytes = 0
+	if err := jar.LoadReplace(path); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test EnforceSameSiteNoneSecure
+
+func TestEnforceSameSiteNoneSecure(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.EnforceSameSiteNoneSecure = true
+		jarTest{"SameSite=None without Secure", "https://www.host.test",
+			[]string{"a=1; SameSite=None", "b=2; SameSite=Lax"},
+			"b=2",
+			[]query{{"https://www.host.test", "b=2"}},
+		}.run(t, jar)
+		jarTest{"SameSite=None with Secure", "https://www.host.test",
+			[]string{"a=1; SameSite=None; Secure"},
+			"a=1 b=2",
+			[]query{{"https://www.host.test", "b=2 a=1"}},
+		}.run(t, jar)
+
+		jar = NewJar(b)
+		jarTest{"Not enforced", "https://www.host.test",
+			[]string{"a=1; SameSite=None"},
+			"a=1",
+			[]query{{"https://www.host.test", "a=1"}},
+		}.run(t, jar)
+	}
+	if !NewDefaultJar().EnforceSameSiteNoneSecure {
+		t.Errorf("NewDefaultJar does not enforce SameSite=None requiring Secure")
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ExpiringSoon
+
+func TestExpiringSoon(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("http://www.host.test"), []*http.Cookie{
+			parseCookie("session=1"),
+			parseCookie("a=2; max-age=30"),
+			parseCookie("b=3; max-age=90"),
+			parseCookie("c=4; max-age=600"),
+			parseCookie("d=5; max-age=60; path=/other"),
+		})
+		u := URL("http://www.host.test")
+		for _, tt := range []struct {
+			within time.Duration
+			want   string
+		}{
+			{0, ""},
+			{time.Minute, "a=2"},
+			{2 * time.Minute, "a=2 b=3"},
+			{time.Hour, "a=2 b=3 c=4"},
+		} {
+			if got := stringRep(jar.ExpiringSoon(u, tt.within)); got != tt.want {
+				t.Errorf("Boxed=%t, within %s: Got %q, want %q",
+					b, tt.within, got, tt.want)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test IsolateByScheme
+
+func TestIsolateByScheme(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.IsolateByScheme = true
+		jarTest{"Set over http", "http://www.host.test",
+			[]string{"a=1", "b=2"},
+			"a=1 b=2",
+			[]query{
+				{"http://www.host.test", "a=1 b=2"},
+				{"https://www.host.test", ""},
+			},
+		}.run(t, jar)
+		jarTest{"Set over https", "https://www.host.test",
+			[]string{"a=3", "c=4"},
+			"a=1 a=3 b=2 c=4",
+			[]query{
+				{"http://www.host.test", "a=1 b=2"},
+				{"https://www.host.test", "a=3 c=4"},
+			},
+		}.run(t, jar)
+		jarTest{"Delete over http", "http://www.host.test",
+			[]string{"a=1; max-age=-1"},
+			"a=3 b=2 c=4",
+			[]query{
+				{"http://www.host.test", "b=2"},
+				{"https://www.host.test", "a=3 c=4"},
+			},
+		}.run(t, jar)
+		if !jar.Remove("www.host.test", "/", "c") {
+			t.Errorf("Boxed=%t: Cannot remove isolated cookie", b)
+		}
+
+		jar = NewJar(b)
+		jarTest{"Shared by default", "http://www.host.test",
+			[]string{"a=1"},
+			"a=1",
+			[]query{
+				{"http://www.host.test", "a=1"},
+				{"https://www.host.test", "a=1"},
+			},
+		}.run(t, jar)
+		jarTest{"Overwrite over https", "https://www.host.test",
+			[]string{"a=2"},
+			"a=2",
+			[]query{{"http://www.host.test", "a=2"}},
+		}.run(t, jar)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test EvictionVeto
+
+func TestEvictionVeto(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxCookiesPerHost = 3
+		jar.EvictionVeto = func(c *Cookie) bool { return c.Name == "auth" }
+		jarTest{"Fill host", "http://www.host.test",
+			[]string{"auth=1", "b=2", "c=3"},
+			"auth=1 b=2 c=3",
+			nil,
+		}.run(t, jar)
+		jarTest{"Pinned cookie survives", "http://www.host.test",
+			[]string{"d=4", "e=5"},
+			"auth=1 d=4 e=5",
+			nil,
+		}.run(t, jar)
+		if n := jar.StorageStats().VetoedEvictions; n != 0 {
+			t.Errorf("Boxed=%t: Got %d vetoed evictions", b, n)
+		}
+
+		jar.EvictionVeto = func(c *Cookie) bool { return true }
+		jarTest{"All vetoed", "http://www.host.test",
+			[]string{"f=6"},
+			"auth=1 d=4 e=5 f=6",
+			nil,
+		}.run(t, jar)
+		if n := jar.StorageStats().VetoedEvictions; n != 1 {
+			t.Errorf("Boxed=%t: Got %d vetoed evictions, want 1", b, n)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test MarshalText and UnmarshalText
+
+func TestMarshalText(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("https://www.host.test"), []*http.Cookie{
+			parseCookie("a=1; max-age=3600"),
+			parseCookie("b=2; max-age=3600; secure; domain=host.test"),
+			parseCookie("session=3"),
+		})
+		text, err := jar.MarshalText()
+		if err != nil {
+			t.Fatalf("Boxed=%t: MarshalText failed: %v", b, err)
+		}
+		if bytes.ContainsAny(text, "\n\r\t =") {
+			t.Errorf("Boxed=%t: Text not single-line and URL-safe: %q", b, text)
+		}
+
+		other := NewJar(b)
+		other.SetCookies(URL("http://www.google.com"), []*http.Cookie{
+			parseCookie("x=9; max-age=3600"),
+		})
+		if err := other.UnmarshalText(text); err != nil {
+			t.Fatalf("Boxed=%t: UnmarshalText failed: %v", b, err)
+		}
+		if got := stringRep(other.Cookies(URL("https://www.host.test"))); got != "a=1 b=2" {
+			t.Errorf("Boxed=%t: Got %q after round trip", b, got)
+		}
+		if got := stringRep(other.Cookies(URL("http://www.google.com"))); got != "" {
+			t.Errorf("Boxed=%t: Old content %q not replaced", b, got)
+		}
+
+		for _, garbage := range []string{"!!!", "bm90IGpzb24", "e30"} {
+			if err := other.UnmarshalText([]byte(garbage)); err != errBadText {
+				t.Errorf("Boxed=%t: Got error %v for %q, want %v",
+					b, err, garbage, errBadText)
+			}
+		}
+		if err := other.UnmarshalText(bytes.Repeat([]byte("A"), maxTextBytes+1)); err != errTextTooLarge {
+			t.Errorf("Boxed=%t: Got error %v, want %v", b, err, errTextTooLarge)
+		}
+		if got := stringRep(other.Cookies(URL("https://www.host.test"))); got != "a=1 b=2" {
+			t.Errorf("Boxed=%t: Got %q after failed UnmarshalText", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test SetCookieHeader and LenientParsing
+
+var setCookieHeaderTests = []struct {
+	line    string
+	strict  string // cookies sent to https://www.host.test after strict parsing
+	lenient string // and after lenient parsing
+}{
+	{"a=1; Path=/; Max-Age=3600; Secure; HttpOnly", "a=1", "a=1"},
+	{"a=1; Foo=bar", "a=1", "a=1"},
+	{"a", "", "a="},
+	{"a=1; Secure=yes", "", "a=1"},
+	{"a=1; HttpOnly=true", "", "a=1"},
+	{"a=1; max-age=3600", "", "a=1"},
+	{"a=1; PATH=/", "", "a=1"},
+	{"a=1; domain=host.test", "", "a=1"},
+}
+
+func TestSetCookieHeader(t *testing.T) {
+	u := URL("https://www.host.test")
+	for _, b := range []bool{true, false} {
+		for i, tt := range setCookieHeaderTests {
+			for _, lenient := range []bool{false, true} {
+				jar := NewJar(b)
+				jar.LenientParsing = lenient
+				want := tt.strict
+				if lenient {
+					want = tt.lenient
+				}
+				rejected := jar.SetCookieHeader(u, tt.line)
+				if got := stringRep(jar.Cookies(u)); got != want {
+					t.Errorf("#%d %q lenient=%t: Got %q, want %q",
+						i, tt.line, lenient, got, want)
+				}
+				if (rejected == 1) != (want == "") {
+					t.Errorf("#%d %q lenient=%t: Got %d rejected",
+						i, tt.line, lenient, rejected)
+				}
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test DomainKey
+
+func TestDomainKey(t *testing.T) {
+	for _, ps := range []bool{false, true} {
+		jar := NewJar(true)
+		jar.DomainCookiesOnPublicSuffixes = ps
+		for _, tt := range []struct{ host, key string }{
+			{"www.bbc.co.uk", "bbc.co.uk"},
+			{"WWW.BBC.co.uk.", "bbc.co.uk"},
+			{"bbc.co.uk", "bbc.co.uk"},
+			{"co.uk", "co.uk"},
+			{"localhost", "localhost"},
+			{"192.168.0.10", "192.168.0.10"},
+			{"www.bücher.test", "xn--bcher-kva.test"},
+		} {
+			if got := jar.DomainKey(tt.host); got != tt.key {
+				t.Errorf("%t: DomainKey(%q)=%q, want %q", ps, tt.host, got, tt.key)
+			}
+		}
+
+		// all cookies of a key are stored in one box
+		jar.SetCookies(URL("http://www.bbc.co.uk"), []*http.Cookie{parseCookie("a=1")})
+		jar.SetCookies(URL("http://news.bbc.co.uk"), []*http.Cookie{parseCookie("b=2")})
+		if boxes := jar.StorageStats().Boxes; len(boxes) != 1 || boxes["bbc.co.uk"] != 2 {
+			t.Errorf("%t: Unexpected boxes %v", ps, boxes)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookiesFiltered
+
+var cookiesFilteredTests = []struct {
+	opts FilterOpts
+	want string
+}{
+	{FilterOpts{}, "a=1 b=2 c=3 d=4 e=5"},
+	{FilterOpts{Secure: true}, "b=2 d=4"},
+	{FilterOpts{HttpOnly: true}, "c=3 d=4"},
+	{FilterOpts{Secure: true, HttpOnly: true}, "d=4"},
+	{FilterOpts{ExcludeSession: true}, "d=4 e=5"},
+	{FilterOpts{ExcludePersistent: true}, "a=1 b=2 c=3"},
+	{FilterOpts{ExcludeSession: true, ExcludePersistent: true}, ""},
+	{FilterOpts{Secure: true, ExcludeSession: true}, "d=4"},
+	{FilterOpts{HttpOnly: true, ExcludePersistent: true}, "c=3"},
+}
+
+func TestCookiesFiltered(t *testing.T) {
+	u := URL("https://www.host.test")
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("a=1"),
+			parseCookie("b=2; secure"),
+			parseCookie("c=3; httponly"),
+			parseCookie("d=4; secure; httponly; max-age=3600"),
+			parseCookie("e=5; max-age=3600"),
+		})
+		for i, tt := range cookiesFilteredTests {
+			if got := stringRep(jar.CookiesFiltered(u, tt.opts)); got != tt.want {
+				t.Errorf("Boxed=%t #%d %+v: Got %q, want %q",
+					b, i, tt.opts, got, tt.want)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test GobEncode and GobDecode
+
+func TestGobEncode(t *testing.T) {
+	jar := NewJar(true)
+	jar.MaxCookiesPerHost = 7
+	jar.MaxCookiesTotal = 99
+	jar.MaxFutureExpiry = time.Hour
+	jar.IsolateByScheme = true
+	jar.SetCookies(URL("https://www.host.test"), []*http.Cookie{
+		parseCookie("a=1"),
+		parseCookie("b=2; domain=host.test; max-age=600"),
+	})
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(jar); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	data := buf.Bytes()
+
+	// a zero Jar restores settings and kind of storage
+	var zero Jar
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&zero); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if zero.MaxBytesPerCookie != 4096 || zero.MaxCookiesPerHost != 7 ||
+		zero.MaxCookiesTotal != 99 || zero.MaxFutureExpiry != time.Hour ||
+		!zero.IsolateByScheme {
+		t.Errorf("Settings not restored: %+v", zero.config())
+	}
+	if _, ok := zero.content.(*boxed); !ok {
+		t.Errorf("Storage not restored: %T", zero.content)
+	}
+	if got := stringRep(zero.Cookies(URL("https://www.host.test"))); got != "a=1 b=2" {
+		t.Errorf("Got %q after decoding", got)
+	}
+
+	// a set up jar keeps its own settings and storage
+	other := NewJar(false)
+	other.MaxCookiesPerHost = 3
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(other); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if other.MaxCookiesPerHost != 3 || other.IsolateByScheme {
+		t.Errorf("Settings overwritten: %+v", other.config())
+	}
+	if _, ok := other.content.(*flat); !ok {
+		t.Errorf("Storage replaced: %T", other.content)
+	}
+	if got := stringRep(other.Cookies(URL("https://www.host.test"))); got != "a=1 b=2" {
+		t.Errorf("Got %q after decoding", got)
+	}
+
+	if err := other.GobDecode([]byte("garbage")); err == nil {
+		t.Errorf("Garbage decoded")
+	}
+	raw, err := jar.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode failed: %v", err)
+	}
+	other.MaxLoadCookies = 1
+	if err := other.GobDecode(raw); err != errLoadTooMany {
+		t.Errorf("Got error %v, want %v", err, errLoadTooMany)
+	}
+}
+
+// TestGobConfigFields makes sure that every exported setting of Jar which
+// is not a function is part of gobConfig and survives config and setConfig.
+func TestGobConfigFields(t *testing.T) {
+	jar := &Jar{}
+	val := reflect.ValueOf(jar).Elem()
+	typ := val.Type()
+	configType := reflect.TypeOf(gobConfig{})
+	var names []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		kind := field.Type.Kind()
+		if field.PkgPath != "" || field.Anonymous ||
+			kind == reflect.Func || kind == reflect.Interface {
+			continue
+		}
+		if c, ok := configType.FieldByName(field.Name); !ok || c.Type != field.Type {
+			t.Errorf("Jar.%s is missing in gobConfig", field.Name)
+			continue
+		}
+		switch v := val.Field(i); kind {
+		case reflect.Bool:
+			v.SetBool(true)
+		case reflect.Int, reflect.Int64:
+			v.SetInt(int64(i + 1))
+		default:
+			t.Errorf("Jar.%s: unexpected kind %s", field.Name, kind)
+			continue
+		}
+		names = append(names, field.Name)
+	}
+
+	restored := &Jar{}
+	restored.setConfig(jar.config())
+	for _, name := range names {
+		got := reflect.ValueOf(restored).Elem().FieldByName(name).Interface()
+		want := val.FieldByName(name).Interface()
+		if got != want {
+			t.Errorf("Jar.%s: got %v, want %v after setConfig", name, got, want)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test restoring Created and LastAccess
+
+func TestRestoredTimes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cookiejar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "cookies.json")
+
+	roundTrips := map[string]func(from, to *Jar) error{
+		"gob": func(from, to *Jar) error {
+			data, err := from.GobEncode()
+			if err != nil {
+				return err
+			}
+			return to.GobDecode(data)
+		},
+		"json": func(from, to *Jar) error {
+			if err := from.Save(file); err != nil {
+				return err
+			}
+			return to.LoadReplace(file)
+		},
+		"text": func(from, to *Jar) error {
+			text, err := from.MarshalText()
+			if err != nil {
+				return err
+			}
+			return to.UnmarshalText(text)
+		},
+	}
+
+	now := time.Now()
+	expires := now.Add(24 * time.Hour)
+	stored := []Cookie{
+		{Name: "a", Value: "1", LastAccess: now.Add(-1 * time.Hour)},
+		{Name: "b", Value: "2", LastAccess: now.Add(-3 * time.Hour)},
+		{Name: "c", Value: "3", LastAccess: now.Add(-2 * time.Hour)},
+		{Name: "f", Value: "4", LastAccess: now.Add(time.Hour)}, // clock ahead
+	}
+	for i := range stored {
+		stored[i].Domain, stored[i].Path, stored[i].HostOnly = "www.host.test", "/", true
+		stored[i].Expires = expires
+		stored[i].Created = stored[i].LastAccess.Add(-time.Minute)
+	}
+
+	for name, roundTrip := range roundTrips {
+		for _, b := range []bool{true, false} {
+			source := NewJar(b)
+			source.Add(stored)
+			jar := NewJar(b)
+			if err := roundTrip(source, jar); err != nil {
+				t.Fatalf("%s Boxed=%t: %v", name, b, err)
+			}
+			for _, cookie := range jar.All() {
+				for _, s := range stored {
+					if s.Name == cookie.Name && (!s.Created.Equal(cookie.Created) ||
+						!s.LastAccess.Equal(cookie.LastAccess)) {
+						t.Errorf("%s Boxed=%t: %s restored with created %v, last access %v",
+							name, b, s.Name, cookie.Created, cookie.LastAccess)
+					}
+				}
+			}
+
+			// the oldest restored cookie is evicted first, new
+			// cookies are newer than all restored ones
+			jar.MaxCookiesTotal = 4
+			u := URL("http://www.host.test")
+			for _, tt := range []struct{ set, want string }{
+				{"d=5", "a=1 c=3 d=5 f=4"},
+				{"e=6", "a=1 d=5 e=6 f=4"},
+				{"g=7", "d=5 e=6 f=4 g=7"},
+				{"h=8", "d=5 e=6 g=7 h=8"},
+			} {
+				jar.SetCookies(u, []*http.Cookie{parseCookie(tt.set)})
+				if got := jar.list(); got != tt.want {
+					t.Errorf("%s Boxed=%t: After %s got %q, want %q",
+						name, b, tt.set, got, tt.want)
+				}
+			}
+		}
+	}
+}
+
+// Restored times in the future order new cookies but do not shift their
+// expiration.
+func TestRestoredFutureTimes(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxFutureExpiry = 3 * time.Hour
+		ahead := time.Now().Add(2 * time.Hour)
+		jar.Import([]*Cookie{{Name: "f", Value: "1", Domain: "www.host.test",
+			Path: "/", HostOnly: true, Created: ahead, LastAccess: ahead}})
+
+		u := URL("http://www.host.test")
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("x=1; " + expiresIn(3600)),
+			parseCookie("y=2; max-age=36000"),
+		})
+		if got := jar.list(); got != "f=1 x=1 y=2" {
+			t.Errorf("Boxed=%t: Got %q, want %q", b, got, "f=1 x=1 y=2")
+		}
+		for _, cookie := range jar.AllCookiesForHost("www.host.test") {
+			switch cookie.Name {
+			case "x", "y":
+				if !cookie.Created.After(ahead) {
+					t.Errorf("Boxed=%t: %s created %v before restored cookie",
+						b, cookie.Name, cookie.Created)
+				}
+				if limit := time.Now().Add(3 * time.Hour); cookie.Expires.After(limit) {
+					t.Errorf("Boxed=%t: %s expires %v, after %v",
+						b, cookie.Name, cookie.Expires, limit)
+				}
+			}
+		}
+
+		// cookies just read are more recently used than restored ones
+		later := ahead.Add(time.Minute)
+		jar.Import([]*Cookie{{Name: "g", Value: "2", Domain: "www.other.test",
+			Path: "/", HostOnly: true, Created: later, LastAccess: later}})
+		jar.Cookies(u)
+		jar.MaxCookiesTotal = 4
+		jar.SetCookies(u, []*http.Cookie{parseCookie("z=3")})
+		if got := jar.list(); got != "f=1 x=1 y=2 z=3" {
+			t.Errorf("Boxed=%t: Got %q, want %q", b, got, "f=1 x=1 y=2 z=3")
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test NewCookie
+
+func TestNewCookie(t *testing.T) {
+	jar := NewJar(true)
+	for i, tt := range []struct {
+		host, path       string
+		domain, wantPath string
+		hostOnly         bool
+	}{
+		{"www.host.test", "/foo", "www.host.test", "/foo", true},
+		{"WWW.Host.Test", "", "www.host.test", "/", true},
+		{".host.test", "foo", "host.test", "/", false},
+		{".Bücher.test", "/", "xn--bcher-kva.test", "/", false},
+	} {
+		c := jar.NewCookie(tt.host, tt.path, "a", "1")
+		if c == nil {
+			t.Errorf("#%d: Got nil", i)
+			continue
+		}
+		if c.Domain != tt.domain || c.Path != tt.wantPath || c.HostOnly != tt.hostOnly {
+			t.Errorf("#%d: Got %q %q %t, want %q %q %t", i,
+				c.Domain, c.Path, c.HostOnly, tt.domain, tt.wantPath, tt.hostOnly)
+		}
+	}
+	if c := jar.NewCookie("", "/", "a", "1"); c != nil {
+		t.Errorf("Got cookie for empty host: %v", c)
+	}
+
+	jar.Import([]*Cookie{
+		jar.NewCookie("www.host.test", "", "a", "1"),
+		jar.NewCookie(".host.test", "", "b", "2"),
+	})
+	if got := stringRep(jar.Cookies(URL("http://sub.host.test"))); got != "b=2" {
+		t.Errorf("Got %q", got)
+	}
+	if got := stringRep(jar.Cookies(URL("http://www.host.test"))); got != "a=1 b=2" {
+		t.Errorf("Got %q", got)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test PreserveSetOrder
+
+func TestPreserveSetOrder(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.PreserveSetOrder = true
+		u := URL("http://www.host.test/")
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("C=1"), parseCookie("A=2"), parseCookie("B=3"),
+			parseCookie("X=4; path=/long"),
+		})
+		jar.SetCookies(u, []*http.Cookie{parseCookie("D=5"), parseCookie("A=6")})
+
+		// equal creation times must not change the order
+		for _, c := range jar.content.all() {
+			c.Created = time.Time{}
+		}
+		if got := stringRep(jar.Cookies(URL("http://www.host.test/long"))); got != "X=4 C=1 A=6 B=3 D=5" {
+			t.Errorf("Boxed=%t: Got %q", b, got)
+		}
+
+		jar.PreserveSetOrder = false
+		if got := stringRep(jar.Cookies(URL("http://www.host.test/long"))); got != "X=4 A=6 B=3 C=1 D=5" {
+			t.Errorf("Boxed=%t: Got %q without PreserveSetOrder", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test IP hosts with and without port
+
+func TestIPHostWithPort(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		for _, tt := range []struct{ set, get string }{
+			{"http://1.2.3.4:8080", "http://1.2.3.4"},
+			{"http://1.2.3.4", "http://1.2.3.4:8080"},
+			{"http://[2001:db8::1]:8080", "http://[2001:db8::1]"},
+			{"http://[2001:db8::1]", "http://[2001:DB8:0::1]:8080"},
+		} {
+			jar := NewJar(b)
+			jar.SetCookies(URL(tt.set), []*http.Cookie{parseCookie("a=1")})
+			if got := stringRep(jar.Cookies(URL(tt.get))); got != "a=1" {
+				t.Errorf("Boxed=%t: Set on %s, got %q on %s", b, tt.set, got, tt.get)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test Get
+
+func TestGet(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("http://www.host.test/foo/bar"), []*http.Cookie{
+			parseCookie("a=1"),
+			parseCookie("b=2; domain=host.test; path=/"),
+		})
+
+		c, ok := jar.Get("www.host.test", "/foo", "a")
+		if !ok || c.Value != "1" || !c.HostOnly {
+			t.Errorf("Boxed=%t: Got %v, %t", b, c, ok)
+		}
+		c.Value = "X" // a copy
+		if got := stringRep(jar.Cookies(URL("http://www.host.test/foo/"))); got != "a=1 b=2" {
+			t.Errorf("Boxed=%t: Stored cookie modified: %q", b, got)
+		}
+		if c, ok := jar.Get(".Host.Test", "/", "b"); !ok || c.Value != "2" {
+			t.Errorf("Boxed=%t: Got %v, %t", b, c, ok)
+		}
+
+		before := jar.StorageStats()
+		for _, miss := range [][3]string{
+			{"www.host.test", "/", "a"},
+			{"www.host.test", "/foo", "x"},
+			{"www.other.test", "/", "a"},
+		} {
+			if c, ok := jar.Get(miss[0], miss[1], miss[2]); ok || c != nil {
+				t.Errorf("Boxed=%t: Got %v for %v", b, c, miss)
+			}
+		}
+		after := jar.StorageStats()
+		if after.Capacity != before.Capacity || len(after.Boxes) != len(before.Boxes) ||
+			after.Cookies+after.Expired != before.Cookies+before.Expired {
+			t.Errorf("Boxed=%t: Get modified storage: %+v -> %+v", b, before, after)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test SetCookiesBatch
+
+func TestSetCookiesBatch(t *testing.T) {
+	batches := []CookieBatch{
+		{URL("http://www.host.test/foo/bar"), []*http.Cookie{
+			parseCookie("a=1"), parseCookie("b=2; domain=host.test")}},
+		{URL("https://www.google.com"), []*http.Cookie{
+			parseCookie("c=3; secure"), parseCookie("c=4; secure")}},
+		{URL("http://WWW.Bücher.test:8080/x"), []*http.Cookie{
+			parseCookie("d=5")}},
+		{URL("ftp://www.host.test"), []*http.Cookie{parseCookie("e=6")}},
+	}
+	queries := []string{
+		"http://www.host.test/foo/",
+		"https://www.google.com",
+		"http://www.xn--bcher-kva.test",
+	}
+	for _, b := range []bool{true, false} {
+		single, batched := NewJar(b), NewJar(b)
+		for _, batch := range batches {
+			single.SetCookies(batch.URL, batch.Cookies)
+		}
+		batched.SetCookiesBatch(batches)
+		for _, q := range queries {
+			want := stringRep(single.Cookies(URL(q)))
+			if got := stringRep(batched.Cookies(URL(q))); got != want {
+				t.Errorf("Boxed=%t %s: Got %q, want %q", b, q, got, want)
+			}
+		}
+		if got, want := len(batched.All()), 4; got != want {
+			t.Errorf("Boxed=%t: Got %d cookies, want %d", b, got, want)
+		}
+	}
+}
+
+// Limits are enforced on the state after all batches.
+func TestSetCookiesBatchLimits(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxCookiesTotal = 2
+		u := URL("http://www.host.test")
+		jar.SetCookiesBatch([]CookieBatch{
+			{u, []*http.Cookie{parseCookie("a=1"), parseCookie("b=2"), parseCookie("c=3")}},
+			{u, []*http.Cookie{parseCookie("c=3; max-age=-1")}},
+		})
+		if got := jar.list(); got != "a=1 b=2" {
+			t.Errorf("Boxed=%t: Got %q, want %q", b, got, "a=1 b=2")
+		}
+
+		jar.SetCookiesBatch([]CookieBatch{
+			{u, []*http.Cookie{parseCookie("d=4")}},
+			{URL("http://www.other.test"), []*http.Cookie{parseCookie("e=5")}},
+		})
+		if got := jar.list(); got != "d=4 e=5" {
+			t.Errorf("Boxed=%t: Got %q, want %q", b, got, "d=4 e=5")
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test DeferCleanup
+
+func TestDeferCleanup(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxCookiesPerHost = 3
+		jar.MaxCookiesTotal = 5
+		jar.DeferCleanup = true
+		jarTest{"Exceed limits", "http://www.host.test",
+			[]string{"a=1", "b=2", "c=3", "d=4"},
+			"a=1 b=2 c=3 d=4",
+			nil,
+		}.run(t, jar)
+		jarTest{"Exceed limits", "http://www.google.com",
+			[]string{"e=5", "f=6"},
+			"a=1 b=2 c=3 d=4 e=5 f=6",
+			nil,
+		}.run(t, jar)
+
+		jar.cleanup()
+		if got := jar.list(); got != "b=2 c=3 d=4 e=5 f=6" {
+			t.Errorf("Boxed=%t: Got %q after cleanup", b, got)
+		}
+	}
+}
+
+func benchmarkIngest(b *testing.B, deferCleanup bool) {
+	cookies := make([]*http.Cookie, 100)
+	for i := range cookies {
+		cookies[i] = &http.Cookie{Name: fmt.Sprintf("n%d", i), Value: "v"}
+	}
+	urls := make([]*url.URL, 100)
+	for i := range urls {
+		urls[i] = URL(fmt.Sprintf("http://www.host%d.test", i))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		jar := NewJar(true)
+		jar.MaxCookiesPerHost = 50
+		jar.MaxCookiesTotal = 3000
+		jar.DeferCleanup = deferCleanup
+		for _, u := range urls {
+			jar.SetCookies(u, cookies)
+		}
+		jar.cleanup()
+	}
+}
+
+func BenchmarkIngest(b *testing.B)         { benchmarkIngest(b, false) }
+func BenchmarkDeferredIngest(b *testing.B) { benchmarkIngest(b, true) }
+
+// -------------------------------------------------------------------------
+// Test Cleanup
+
+func TestCleanup(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxCookiesPerHost = 2
+		jar.MaxCookiesTotal = 3
+		jar.DeferCleanup = true
+		jar.SetCookies(URL("http://www.host.test"), []*http.Cookie{
+			parseCookie("a=1"), parseCookie("b=2"), parseCookie("c=3"),
+			parseCookie("x=0; max-age=1"),
+		})
+		jar.SetCookies(URL("http://www.google.com"), []*http.Cookie{
+			parseCookie("d=4"), parseCookie("e=5"),
+		})
+		if got := len(jar.All()); got != 6 {
+			t.Fatalf("Boxed=%t: Got %d cookies before Cleanup", b, got)
+		}
+		// x expires; a is evicted (host limit), then b (total limit)
+		for _, c := range jar.content.all() {
+			if c.Name == "x" {
+				c.Expires = time.Now().Add(-time.Second)
+			}
+		}
+		if n := jar.Cleanup(); n != 3 {
+			t.Errorf("Boxed=%t: Cleanup removed %d cookies, want 3", b, n)
+		}
+		if got := jar.list(); got != "c=3 d=4 e=5" {
+			t.Errorf("Boxed=%t: Got %q after Cleanup", b, got)
+		}
+		if n := jar.Cleanup(); n != 0 {
+			t.Errorf("Boxed=%t: Second Cleanup removed %d cookies", b, n)
+		}
+	}
+}
+
+// Import cleans up the jar once after adding the cookies.
+func TestImportCleanup(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxCookiesPerHost = 2
+		jar.SetCookies(URL("http://www.host.test"), []*http.Cookie{
+			parseCookie("a=0"), parseCookie("x=0; max-age=1")})
+		for _, c := range jar.content.all() {
+			if c.Name == "x" {
+				c.Expires = time.Now().Add(-time.Second)
+			}
+		}
+
+		// a overwrites the stored a and does not reuse the slot of x
+		a := jar.NewCookie("www.host.test", "/", "a", "1")
+		if imported, _ := jar.Import([]*Cookie{a}); imported != 1 {
+			t.Errorf("Boxed=%t: Imported %d cookies, want 1", b, imported)
+		}
+		if stats := jar.StorageStats(); stats.Expired != 0 {
+			t.Errorf("Boxed=%t: %d expired cookies left", b, stats.Expired)
+		}
+
+		now := time.Now()
+		cookies := make([]*Cookie, 2)
+		for i, name := range []string{"b", "c"} {
+			cookies[i] = jar.NewCookie("www.host.test", "/", name, "1")
+			cookies[i].LastAccess = now.Add(time.Duration(i+1) * time.Second)
+		}
+		jar.Import(cookies)
+		if got := jar.list(); got != "b=1 c=1" {
+			t.Errorf("Boxed=%t: Got %q after Import", b, got)
+		}
+	}
+}
+
+// With DeferCleanup the bulk loaders leave the limits to Cleanup.
+func TestImportDeferCleanup(t *testing.T) {
+	har := `[{"name":"c","value":"3","domain":"www.host.test"}]`
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxCookiesPerHost = 1
+		jar.DeferCleanup = true
+		jar.Import([]*Cookie{
+			jar.NewCookie("www.host.test", "/", "a", "1"),
+			jar.NewCookie("www.host.test", "/", "b", "2"),
+		})
+		if _, _, err := jar.ImportHAR(strings.NewReader(har)); err != nil {
+			t.Fatalf("Boxed=%t: %v", b, err)
+		}
+		if got := jar.list(); got != "a=1 b=2 c=3" {
+			t.Errorf("Boxed=%t: Got %q before Cleanup", b, got)
+		}
+		if n := jar.Cleanup(); n != 2 {
+			t.Errorf("Boxed=%t: Cleanup removed %d cookies, want 2", b, n)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test cookie name prefixes
+
+func TestCookiePrefixes(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jarTest{"Prefixes over https", "https://www.host.test/foo/",
+			[]string{
+				"__Secure-a=1; secure",
+				"__Secure-b=2",                                 // not secure
+				"__Host-c=3; secure; path=/",                   // ok
+				"__Host-d=4; secure",                           // path /foo
+				"__Host-e=5; secure; path=/; domain=host.test", // domain cookie
+				"__host-f=6; path=/",                           // case insensitive
+			},
+			"__Host-c=3 __Secure-a=1",
+			nil,
+		}.run(t, jar)
+		jarTest{"Prefixes over http", "http://www.host.test/",
+			[]string{"__Secure-g=7; secure", "__Host-h=8; secure; path=/"},
+			"__Host-c=3 __Secure-a=1",
+			nil,
+		}.run(t, jar)
+
+		// export and import round trip
+		var buf bytes.Buffer
+		if err := jar.ExportHAR(&buf); err != nil {
+			t.Fatalf("ExportHAR failed: %v", err)
+		}
+		exported := buf.String()
+		other := NewJar(b)
+		if _, _, err := other.ImportHAR(strings.NewReader(exported)); err != nil {
+			t.Fatalf("ImportHAR failed: %v", err)
+		}
+		if got := other.list(); got != "__Host-c=3 __Secure-a=1" {
+			t.Errorf("Boxed=%t: Got %q after round trip", b, got)
+		}
+
+		// a tampered __Host- cookie is rejected
+		tampered := strings.Replace(exported, `"domain":"www.host.test"`,
+			`"domain":".host.test"`, -1)
+		if tampered == exported {
+			t.Fatalf("Boxed=%t: Cannot tamper with %s", b, exported)
+		}
+		other = NewJar(b)
+		imported, rejected, err := other.ImportHAR(strings.NewReader(tampered))
+		if err != nil {
+			t.Fatalf("ImportHAR failed: %v", err)
+		}
+		if imported != 1 || rejected != 1 {
+			t.Errorf("Boxed=%t: Imported %d, rejected %d, want 1 and 1",
+				b, imported, rejected)
+		}
+		if got := other.list(); got != "__Secure-a=1" {
+			t.Errorf("Boxed=%t: Got %q after tampered import", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookieMapPrecedence and SortPrecedence
+
+func TestCookieMapPrecedence(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("http://www.host.test/"), []*http.Cookie{
+			parseCookie("sid=host"),
+			parseCookie("sid=domain; domain=host.test"),
+			parseCookie("sid=sub; domain=www.host.test; path=/foo"),
+			parseCookie("x=1"),
+		})
+		for _, tt := range []struct {
+			url, want string
+		}{
+			{"http://www.host.test/", "map[sid:host x:1]"},
+			{"http://www.host.test/foo", "map[sid:host x:1]"},
+			{"http://api.host.test/", "map[sid:domain]"},
+			{"http://a.www.host.test/foo", "map[sid:sub]"},
+		} {
+			if got := fmt.Sprint(jar.CookieMapPrecedence(URL(tt.url))); got != tt.want {
+				t.Errorf("Boxed=%t %s: Got %s, want %s", b, tt.url, got, tt.want)
+			}
+		}
+		got := stringRep(jar.CookiesSorted(URL("http://www.host.test/foo"), SortPrecedence))
+		if want := "x=1 sid=host sid=sub sid=domain"; got != want {
+			t.Errorf("Boxed=%t: Got %q, want %q", b, got, want)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookiesAt
+
+func TestCookiesAt(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.CacheRetrieval = true
+		u := URL("http://www.host.test/")
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("a=1; max-age=3600"),
+			parseCookie("b=2"),
+		})
+		t1 := time.Now().Add(30 * time.Minute)
+		t2 := time.Now().Add(2 * time.Hour)
+
+		if got := stringRep(jar.CookiesAt(u, t1)); got != "a=1 b=2" {
+			t.Errorf("Boxed=%t at t1: Got %q", b, got)
+		}
+		if c, _ := jar.Get("www.host.test", "/", "a"); !c.LastAccess.Equal(t1) {
+			t.Errorf("Boxed=%t: LastAccess %v, want %v", b, c.LastAccess, t1)
+		}
+		if got := stringRep(jar.CookiesAt(u, t2)); got != "b=2" {
+			t.Errorf("Boxed=%t at t2: Got %q", b, got)
+		}
+		// the cookie is still there for the current time
+		if got := stringRep(jar.Cookies(u)); got != "a=1 b=2" {
+			t.Errorf("Boxed=%t now: Got %q", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ClearSession
+
+func TestClearSession(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("http://www.host.test/"), []*http.Cookie{
+			parseCookie("s1=1"),
+			parseCookie("p1=2; max-age=3600"),
+		})
+		jar.SetCookies(URL("http://www.other.test/"), []*http.Cookie{
+			parseCookie("s2=3; domain=other.test"),
+		})
+		if n := jar.ClearSession(); n != 2 {
+			t.Errorf("Boxed=%t: Removed %d cookies, want 2", b, n)
+		}
+		if got := jar.list(); got != "p1=2" {
+			t.Errorf("Boxed=%t: Got %q, want \"p1=2\"", b, got)
+		}
+		if s := jar.StorageStats(); b && len(s.Boxes) != 1 {
+			t.Errorf("Boxed=%t: Got %d boxes, want 1", b, len(s.Boxes))
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test SessionTTL
+
+func TestSessionTTL(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SessionTTL = time.Hour
+		u := URL("http://www.host.test/")
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("a=1"),
+			parseCookie("b=2; max-age=60"),
+		})
+		a, _ := jar.Get("www.host.test", "/", "a")
+		if d := a.Expires.Sub(time.Now()); d < 59*time.Minute || d > time.Hour {
+			t.Errorf("Boxed=%t: a expires %v, want in one hour", b, a.Expires)
+		}
+		if c, _ := jar.Get("www.host.test", "/", "b"); c.Expires.Sub(time.Now()) > time.Minute {
+			t.Errorf("Boxed=%t: b expires %v, want in one minute", b, c.Expires)
+		}
+		jar.SetCookies(u, []*http.Cookie{parseCookie("b=2; max-age=-1")})
+
+		data, err := jar.GobEncode()
+		if err != nil {
+			t.Fatalf("GobEncode failed: %v", err)
+		}
+		var zero Jar
+		if err := zero.GobDecode(data); err != nil {
+			t.Fatalf("GobDecode failed: %v", err)
+		}
+		if zero.SessionTTL != time.Hour {
+			t.Errorf("Boxed=%t: SessionTTL %v not restored", b, zero.SessionTTL)
+		}
+		if got, _ := zero.Get("www.host.test", "/", "a"); got == nil || !got.Expires.Equal(a.Expires) {
+			t.Errorf("Boxed=%t: Got %v after round trip", b, got)
+		}
+		if got := zero.list(); got != "a=1" {
+			t.Errorf("Boxed=%t: Got %q, want \"a=1\"", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test Diff
+
+func TestDiff(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		here, there := NewJar(b), NewJar(!b)
+		u := URL("http://www.host.test/")
+		here.SetCookies(u, []*http.Cookie{
+			parseCookie("same=1"),
+			parseCookie("value=1"),
+			parseCookie("expiry=1; max-age=60"),
+			parseCookie("mine=1"),
+			parseCookie("path=1; path=/a"),
+		})
+		there.SetCookies(u, []*http.Cookie{
+			parseCookie("same=1"),
+			parseCookie("value=2"),
+			parseCookie("expiry=1; max-age=600"),
+			parseCookie("yours=1; domain=host.test"),
+			parseCookie("path=1; path=/b"),
+		})
+
+		onlyHere, onlyThere, changed := here.Diff(there)
+		for _, tt := range []struct {
+			what string
+			got  []*Cookie
+			want string
+		}{
+			{"onlyHere", onlyHere, "www.host.test/:mine www.host.test/a:path"},
+			{"onlyThere", onlyThere, "host.test/:yours www.host.test/b:path"},
+			{"changed", changed, "www.host.test/:expiry www.host.test/:value"},
+		} {
+			s := make([]string, len(tt.got))
+			for i, c := range tt.got {
+				s[i] = c.Domain + c.Path + ":" + c.Name
+			}
+			if got := strings.Join(s, " "); got != tt.want {
+				t.Errorf("Boxed=%t %s: Got %q, want %q", b, tt.what, got, tt.want)
+			}
+		}
+		if changed[1].Value != "1" {
+			t.Errorf("Boxed=%t: Changed value %q, want the one of here", b, changed[1].Value)
+		}
+
+		// the results are copies
+		onlyHere[0].Value = "modified"
+		if c, _ := here.Get("www.host.test", "/", "mine"); c.Value != "1" {
+			t.Errorf("Boxed=%t: Jar modified through Diff result", b)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test RequirePathScope
+
+func TestRequirePathScope(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		for _, scope := range []bool{false, true} {
+			jar := NewJar(b)
+			jar.RequirePathScope = scope
+			jar.SetCookies(URL("http://www.host.test/some/path/here.html"), []*http.Cookie{
+				parseCookie("a=1; path=/some"),
+				parseCookie("b=2; path=/other"),
+				parseCookie("c=3; path=/some/path/deeper"),
+				parseCookie("d=4; path=/"),
+				parseCookie("e=5"),
+			})
+			want := "a=1 b=2 c=3 d=4 e=5"
+			if scope {
+				want = "a=1 d=4 e=5"
+			}
+			if got := jar.list(); got != want {
+				t.Errorf("Boxed=%t scope=%t: Got %q, want %q", b, scope, got, want)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookiesJSON
+
+func TestCookiesJSON(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxFutureExpiry = 0 // keep the fixed expiration time of c
+		jar.SetCookies(URL("https://www.host.test/a/"), []*http.Cookie{
+			parseCookie("a=1"),
+			parseCookie("b=2; path=/a/b; secure; httponly"),
+			parseCookie("c=3; domain=host.test; expires=Fri, 01 Jan 2100 00:00:00 GMT"),
+			parseCookie("d=4; path=/x"),
+		})
+		before, _ := jar.Get("www.host.test", "/a", "a")
+		lastAccess := before.LastAccess
+
+		data, err := jar.CookiesJSON(URL("https://www.host.test/a/b/c"))
+		if err != nil {
+			t.Fatalf("Boxed=%t: Unexpected error %v", b, err)
+		}
+		want := `[{"name":"b","value":"2","path":"/a/b","domain":"www.host.test","httpOnly":true,"secure":true},` +
+			`{"name":"a","value":"1","path":"/a","domain":"www.host.test","httpOnly":false,"secure":false},` +
+			`{"name":"c","value":"3","path":"/a","domain":".host.test","expires":"2100-01-01T00:00:00Z","httpOnly":false,"secure":false}]`
+		if got := string(data); got != want {
+			t.Errorf("Boxed=%t:\nGot  %s\nwant %s", b, got, want)
+		}
+		if after, _ := jar.Get("www.host.test", "/a", "a"); !after.LastAccess.Equal(lastAccess) {
+			t.Errorf("Boxed=%t: LastAccess changed", b)
+		}
+
+		data, _ = jar.CookiesJSON(URL("ftp://www.host.test/"))
+		if string(data) != "[]" {
+			t.Errorf("Boxed=%t: Got %s for ftp URL", b, data)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test boxes of hosts without effective TLD plus one
+
+func TestBoxesOfSingleLabelHosts(t *testing.T) {
+	jar := NewJar(true)
+	for _, h := range []string{"com", "b", "localhost"} {
+		jar.SetCookies(URL("http://"+h+"/"), []*http.Cookie{parseCookie(h + "=1")})
+	}
+	for _, h := range []string{"com", "b", "localhost"} {
+		if got := stringRep(jar.Cookies(URL("http://" + h + "/"))); got != h+"=1" {
+			t.Errorf("Host %s: Got %q, want %q", h, got, h+"=1")
+		}
+	}
+	boxes := jar.StorageStats().Boxes
+	if len(boxes) != 3 || boxes["com"] != 1 || boxes["b"] != 1 || boxes["localhost"] != 1 {
+		t.Errorf("Got boxes %v", boxes)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ImportChromeCookies and ImportFirefoxCookies
+
+// fakeSQLite is a database/sql driver serving the rows in fakeTables:
+// The name of the database selects the table, the query is ignored.
+type fakeSQLite struct{}
+
+var fakeTables = map[string][][]driver.Value{
+	"chrome": {
+		// host_key, name, value, encrypted_value, path, expires_utc,
+		// is_secure, is_httponly, creation_utc, last_access_utc
+		{".host.test", "a", "1", []byte{}, "/", int64(13253932800000000), int64(1), int64(0),
+			int64(13253846400000000), int64(13253846400500000)},
+		{"www.host.test", "b", "2", []byte{}, "/p", int64(0), int64(0), int64(1),
+			int64(13253846400000000), int64(13253846400000000)},
+	},
+	"chrome-encrypted": {
+		{"www.host.test", "c", "", []byte("v10xyz"), "/", int64(0), int64(0), int64(0),
+			int64(0), int64(0)},
+		{"www.host.test", "d", "4", []byte{}, "/", int64(0), int64(0), int64(0),
+			int64(0), int64(0)},
+		{"www.host.test", "e", "", []byte("v10abc"), "/", int64(0), int64(0), int64(0),
+			int64(0), int64(0)},
+	},
+	"firefox": {
+		// host, name, value, path, expiry, isSecure, isHttpOnly,
+		// creationTime, lastAccessed
+		{".host.test", "a", "1", "/", int64(4102444800), int64(1), int64(0),
+			int64(4102358400000000), int64(4102358400000001)},
+		{"www.host.test", "b", "2", "/p", int64(4102444800), int64(0), int64(1),
+			int64(4102358400000000), int64(4102358400000000)},
+	},
+}
+
+func init() {
+	sql.Register("fakesqlite", fakeSQLite{})
+}
+
+func (fakeSQLite) Open(name string) (driver.Conn, error) { return fakeConn(name), nil }
+
+type fakeConn string
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt(c), nil }
+func (c fakeConn) Close() error                              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+type fakeStmt string
+
+func (s fakeStmt) Close() error                                    { return nil }
+func (s fakeStmt) NumInput() int                                   { return 0 }
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) { return nil, driver.ErrSkip }
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	rows := fakeTables[string(s)]
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+func (r *fakeRows) Close() error { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func TestImportBrowserCookies(t *testing.T) {
+	chrome := func(driverName, dbPath string) ([]*Cookie, error) {
+		cookies, encrypted, err := ImportChromeCookies(driverName, dbPath)
+		if encrypted != 0 {
+			t.Errorf("Got %d encrypted cookies", encrypted)
+		}
+		return cookies, err
+	}
+	for _, tt := range []struct {
+		browser string
+		load    func(string, string) ([]*Cookie, error)
+		want    string
+		expires time.Time
+	}{
+		{"chrome", chrome,
+			"host.test/ a=1 secure | www.host.test/p b=2 hostonly httponly",
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"firefox", ImportFirefoxCookies,
+			"host.test/ a=1 secure | www.host.test/p b=2 hostonly httponly",
+			time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		cookies, err := tt.load("fakesqlite", tt.browser)
+		if err != nil {
+			t.Fatalf("%s: Unexpected error %v", tt.browser, err)
+		}
+		s := make([]string, len(cookies))
+		for i, c := range cookies {
+			s[i] = c.Domain + c.Path + " " + c.Name + "=" + c.Value
+			for _, flag := range []struct {
+				set  bool
+				name string
+			}{{c.HostOnly, "hostonly"}, {c.Secure, "secure"}, {c.HttpOnly, "httponly"}} {
+				if flag.set {
+					s[i] += " " + flag.name
+				}
+			}
+		}
+		if got := strings.Join(s, " | "); got != tt.want {
+			t.Errorf("%s: Got %q, want %q", tt.browser, got, tt.want)
+			continue
+		}
+		if !cookies[0].Expires.Equal(tt.expires) {
+			t.Errorf("%s: Expires %v, want %v", tt.browser, cookies[0].Expires, tt.expires)
+		}
+		created := tt.expires.Add(-24 * time.Hour)
+		if !cookies[0].Created.Equal(created) ||
+			!cookies[0].LastAccess.After(cookies[0].Created) {
+			t.Errorf("%s: Created %v, LastAccess %v", tt.browser,
+				cookies[0].Created, cookies[0].LastAccess)
+		}
+	}
+
+	if cookies, _, _ := ImportChromeCookies("fakesqlite", "chrome"); !cookies[1].Session() {
+		t.Errorf("Got expires %v for chrome session cookie", cookies[1].Expires)
+	}
+	cookies, encrypted, err := ImportChromeCookies("fakesqlite", "chrome-encrypted")
+	if err != nil || encrypted != 2 || len(cookies) != 1 || cookies[0].Name != "d" {
+		t.Errorf("Got %d cookies, %d encrypted, error %v; want d only, 2 encrypted",
+			len(cookies), encrypted, err)
+	}
+	if _, _, err := ImportChromeCookies("nosuchdriver", "chrome"); err == nil {
+		t.Errorf("Unknown driver accepted")
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test MaxCookiesTotal with a batch exceeding the limit
+
+func TestMaxTotalSingleBatch(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		for run := 0; run < 20; run++ {
+			jar := NewJar(b)
+			jar.MaxCookiesTotal = 3
+			var cookies []*http.Cookie
+			for i := 0; i < 10; i++ {
+				cookies = append(cookies, parseCookie(fmt.Sprintf("c%d=%d", i, i)))
+			}
+			jar.SetCookies(URL("http://www.fresh.test/"), cookies)
+			if got := jar.list(); got != "c7=7 c8=8 c9=9" {
+				t.Fatalf("Boxed=%t run %d: Got %q, want \"c7=7 c8=8 c9=9\"", b, run, got)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test WouldReject
+
+func TestWouldReject(t *testing.T) {
+	invalid := []string{
+		"a=1; domain=.yo.foo.bar.com",
+		"b=2; domain=.foo.com",
+		"c=3; domain=.bar.foo.com",
+		"d=4; domain=.foo.bar.com.net",
+		"e=5; domain=ar.com",
+		"f=6; domain=.",
+		"g=7; domain=/",
+		"h=8; domain=http://foo.bar.com",
+		"i=9; domain=..foo.bar.com",
+		"j=10; domain=..bar.com",
+		"k=11; domain=.foo.bar.com?blah",
+		"l=12; domain=.foo.bar.com/blah",
+		"m=12; domain=.foo.bar.com:80",
+		"n=14; domain=.foo.bar.com:",
+		"o=15; domain=.foo.bar.com#sup",
+	}
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.MaxBytesPerCookie = 20
+		var cookies []*http.Cookie
+		for _, s := range invalid {
+			cookies = append(cookies, parseCookie(s))
+		}
+		cookies = append(cookies,
+			parseCookie("ok=1; domain=bar.com"),
+			parseCookie("big="+strings.Repeat("x", 20)),
+			parseCookie("__Host-x=1; secure"),
+			parseCookie("del=1; max-age=-1"))
+
+		rejected := jar.WouldReject(URL("http://foo.bar.com"), cookies)
+		var got []string
+		for _, c := range rejected {
+			got = append(got, c.Name)
+		}
+		want := "a b c d e f g h i j k l m n o big __Host-x"
+		if strings.Join(got, " ") != want {
+			t.Errorf("Boxed=%t: Got %v, want %s", b, got, want)
+		}
+		if jar.list() != "" {
+			t.Errorf("Boxed=%t: Jar modified: %q", b, jar.list())
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test Secure cookies across a redirect from https to http
+
+func TestSecureCookiesAcrossRedirect(t *testing.T) {
+	var sent string // Cookie header of the request to the http server
+	plain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = r.Header.Get("Cookie")
+		http.SetCookie(w, &http.Cookie{Name: "sec", Value: "evil"})
+		http.SetCookie(w, &http.Cookie{Name: "plain", Value: "2"})
+	}))
+	defer plain.Close()
+	secure := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "sec", Value: "1", Secure: true})
+		http.SetCookie(w, &http.Cookie{Name: "plain", Value: "1"})
+		http.Redirect(w, r, plain.URL+"/", http.StatusFound)
+	}))
+	defer secure.Close()
+
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.ProtectSecureCookies = true
+		client := secure.Client()
+		client.Jar = jar
+		resp, err := client.Get(secure.URL + "/")
+		if err != nil {
+			t.Fatalf("Boxed=%t: Unexpected error %v", b, err)
+		}
+		resp.Body.Close()
+
+		if sent != "plain=1" {
+			t.Errorf("Boxed=%t: Sent %q to http server, want \"plain=1\"", b, sent)
+		}
+		if got := stringRep(jar.Cookies(URL(secure.URL))); got != "sec=1 plain=2" {
+			t.Errorf("Boxed=%t: Got %q, want \"sec=1 plain=2\"", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ProtectSecureCookies
+
+func TestProtectSecureCookies(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.ProtectSecureCookies = true
+		jar.SetCookies(URL("https://www.host.test/"), []*http.Cookie{
+			parseCookie("a=1; secure; domain=host.test"),
+			parseCookie("b=2; secure; path=/p"),
+		})
+		jar.SetCookies(URL("http://www.host.test/"), []*http.Cookie{
+			parseCookie("a=evil"),                           // shadows domain cookie
+			parseCookie("a=evil; domain=sub.www.host.test"), // invalid anyway
+			parseCookie("b=evil; path=/p/q"),                // path-matches /p
+			parseCookie("b=ok; path=/"),                     // does not
+			parseCookie("c=3; secure"),                      // Secure over http
+			parseCookie("a=-; max-age=-1; domain=host.test"),
+		})
+		if got := jar.list(); got != "a=1 b=2 b=ok" {
+			t.Errorf("Boxed=%t: Got %q, want \"a=1 b=2 b=ok\"", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test cookies with an empty value
+
+func TestEmptyValue(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		u := URL("http://www.host.test/")
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("a=1"),
+			parseCookie("b=2"),
+		})
+		// an empty value replaces the value but does not delete the cookie
+		jar.SetCookies(u, []*http.Cookie{parseCookie("a="), parseCookie("c=")})
+
+		cookies := jar.Cookies(u)
+		if got := stringRep(cookies); got != "a= b=2 c=" {
+			t.Errorf("Boxed=%t: Got %q, want \"a= b=2 c=\"", b, got)
+		}
+		if len(cookies) > 0 {
+			if s := cookies[0].String(); s != "a=" {
+				t.Errorf("Boxed=%t: Serialized as %q, want \"a=\"", b, s)
+			}
+		}
+		if c, ok := jar.Get("www.host.test", "/", "c"); !ok || c.Value != "" {
+			t.Errorf("Boxed=%t: Got %v, %t", b, c, ok)
+		}
+
+		// an empty value cookie is not a free slot for a new cookie
+		jar.SetCookies(u, []*http.Cookie{parseCookie("d=4")})
+		if got := jar.list(); got != "a= b=2 c= d=4" {
+			t.Errorf("Boxed=%t: Got %q, want \"a= b=2 c= d=4\"", b, got)
+		}
+
+		data, err := jar.GobEncode()
+		if err != nil {
+			t.Fatalf("Boxed=%t: GobEncode failed: %v", b, err)
+		}
+		var decoded Jar
+		if err := decoded.GobDecode(data); err != nil {
+			t.Fatalf("Boxed=%t: GobDecode failed: %v", b, err)
+		}
+		if got := decoded.list(); got != "a= b=2 c= d=4" {
+			t.Errorf("Boxed=%t: Got %q after gob round trip", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookiesTopN
+
+func TestCookiesTopN(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("http://www.host.test/a/b/c"), []*http.Cookie{
+			parseCookie("r1=1; path=/"),
+			parseCookie("ab=2; path=/a/b"),
+			parseCookie("d=3; domain=host.test; path=/"),
+			parseCookie("a=4; path=/a"),
+			parseCookie("r2=5; path=/"),
+		})
+		u := URL("http://www.host.test/a/b/c")
+		for _, tt := range []struct {
+			n    int
+			want string
+		}{
+			{0, ""},
+			{1, "ab=2"},
+			{3, "ab=2 a=4 r1=1"},
+			{5, "ab=2 a=4 r1=1 d=3 r2=5"},
+			{9, "ab=2 a=4 r1=1 d=3 r2=5"},
+		} {
+			got := jar.CookiesTopN(u, tt.n)
+			if len(got) > tt.n || stringRep(got) != tt.want {
+				t.Errorf("Boxed=%t n=%d: Got %q, want %q", b, tt.n, stringRep(got), tt.want)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ExpiredCookies
+
+func TestExpiredCookies(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("http://www.host.test/"), []*http.Cookie{
+			parseCookie("a=1; max-age=60"),
+			parseCookie("b=2; max-age=60"),
+			parseCookie("c=3"),
+		})
+		jar.SetCookies(URL("http://www.other.test/"), []*http.Cookie{
+			parseCookie("d=4; max-age=60"),
+		})
+		if got := jar.ExpiredCookies(); len(got) != 0 {
+			t.Errorf("Boxed=%t: Got %d expired cookies, want none", b, len(got))
+		}
+
+		// let a and d expire without any sweep
+		past := time.Now().Add(-time.Second)
+		for _, c := range jar.content.all() {
+			if c.Name == "a" || c.Name == "d" {
+				c.Expires = past
+			}
+		}
+		expired := jar.ExpiredCookies()
+		var names []string
+		for _, c := range expired {
+			names = append(names, c.Name)
+		}
+		if got := strings.Join(names, " "); got != "a d" {
+			t.Errorf("Boxed=%t: Got %q, want \"a d\"", b, got)
+		}
+		if jar.StorageStats().Expired != 2 {
+			t.Errorf("Boxed=%t: Expired cookies removed", b)
+		}
+		expired[0].Value = "modified"
+		if got := jar.ExpiredCookies()[0].Value; got != "1" {
+			t.Errorf("Boxed=%t: Got value %q, want a copy", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ApproxMemoryBytes
+
+func TestApproxMemoryBytes(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		empty := jar.ApproxMemoryBytes()
+
+		add := func(n int) {
+			for i := 0; i < n; i++ {
+				jar.SetCookies(URL(fmt.Sprintf("http://www%d.host.test/", jar.StorageStats().Cookies)),
+					[]*http.Cookie{parseCookie("name=" + strings.Repeat("v", 100))})
+			}
+			jar.Compact()
+		}
+		add(10)
+		ten := jar.ApproxMemoryBytes()
+		add(10)
+		twenty := jar.ApproxMemoryBytes()
+		if ten <= empty+10*100 || twenty <= ten+10*100 {
+			t.Errorf("Boxed=%t: Estimate does not grow: %d %d %d", b, empty, ten, twenty)
+		}
+		if d1, d2 := ten-empty, twenty-ten; d2 < d1*9/10 || d2 > d1*11/10 {
+			t.Errorf("Boxed=%t: Estimate not proportional: +%d then +%d", b, d1, d2)
+		}
+
+		jar.DeleteMatching("", "", "")
+		jar.Compact()
+		if got := jar.ApproxMemoryBytes(); got >= ten {
+			t.Errorf("Boxed=%t: Estimate %d does not shrink after clearing", b, got)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ClockSkew
+
+func TestClockSkew(t *testing.T) {
+	past := func(d time.Duration) string {
+		return time.Now().Add(-d).UTC().Format(time.RFC1123)
+	}
+	for _, b := range []bool{true, false} {
+		for _, skew := range []time.Duration{0, 30 * time.Second} {
+			jar := NewJar(b)
+			jar.ClockSkew = skew
+			u := URL("http://www.host.test/")
+			jar.SetCookies(u, []*http.Cookie{
+				parseCookie("a=1; max-age=60"),
+				parseCookie("b=2"),
+				parseCookie("c=3"),
+			})
+			jar.SetCookies(u, []*http.Cookie{
+				parseCookie("a=skewed; expires=" + past(10*time.Second)),
+				parseCookie("b=old; expires=" + past(time.Minute)),
+				parseCookie("d=4; expires=" + past(10*time.Second)),
+			})
+			want := "c=3"
+			if skew > 0 {
+				want = "a=skewed c=3 d=4"
+			}
+			if got := stringRep(jar.Cookies(u)); got != want {
+				t.Errorf("Boxed=%t skew=%v: Got %q, want %q", b, skew, got, want)
+			}
+
+			// Expires is kept as received
+			if d, ok := jar.Get("www.host.test", "/", "d"); ok {
+				received := time.Now().Add(-10 * time.Second)
+				if d.Expires.After(received) {
+					t.Errorf("Boxed=%t skew=%v: d expires %s, after %s",
+						b, skew, d.Expires, received)
+				}
+			}
+
+			// imported cookies get the same tolerance
+			expires := time.Now().Add(-10 * time.Second)
+			jar.Import([]*Cookie{{Name: "e", Value: "5", Domain: "www.host.test",
+				Path: "/", HostOnly: true, Expires: expires}})
+			want = "c=3"
+			if skew > 0 {
+				want = "a=skewed c=3 d=4 e=5"
+			}
+			if got := jar.list(); got != want {
+				t.Errorf("Boxed=%t skew=%v: After import got %q, want %q", b, skew, got, want)
+			}
+			if e, ok := jar.Get("www.host.test", "/", "e"); ok && !e.Expires.Equal(expires) {
+				t.Errorf("Boxed=%t skew=%v: e expires %s, want %s", b, skew, e.Expires, expires)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookiesDetailed
+
+func TestCookiesDetailed(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		u := URL("http://www.host.test/a/b")
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("a=1"),
+			parseCookie("b=2; domain=host.test; path=/"),
+		})
+
+		var got []string
+		for _, c := range jar.CookiesDetailed(u) {
+			got = append(got, c.Name+"="+c.Value+" "+c.Domain+" "+c.Path)
+		}
+		want := "a=1 www.host.test /a|b=2 .host.test /"
+		if strings.Join(got, "|") != want {
+			t.Errorf("Boxed=%t: Got %q, want %q", b, strings.Join(got, "|"), want)
+		}
+
+		for _, c := range jar.Cookies(u) {
+			if c.Domain != "" || c.Path != "" {
+				t.Errorf("Boxed=%t: Cookies returned %s with domain and path", b, c.Name)
+			}
+		}
+
+		// a request carries only name and value
+		req, _ := http.NewRequest("GET", u.String(), nil)
+		jar.AttachCookies(req)
+		if h := req.Header.Get("Cookie"); h != "a=1; b=2" {
+			t.Errorf("Boxed=%t: Cookie header %q", b, h)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ConsolidateName
+
+func TestConsolidateName(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		u := URL("http://www.host.test/")
+		jar.SetCookies(u, []*http.Cookie{
+			parseCookie("id=host"),
+			parseCookie("id=domain; domain=host.test"),
+			parseCookie("other=1; domain=host.test"),
+		})
+		jar.SetCookies(URL("http://sub.www.host.test/"), []*http.Cookie{
+			parseCookie("id=sub"),
+		})
+		if got := stringRep(jar.Cookies(u)); got != "id=host id=domain other=1" {
+			t.Errorf("Boxed=%t: Both scopes expected, got %q", b, got)
+		}
+
+		if n := jar.ConsolidateName(u, "id"); n != 1 {
+			t.Errorf("Boxed=%t: Removed %d cookies, want 1", b, n)
+		}
+		if got := stringRep(jar.Cookies(u)); got != "id=host other=1" {
+			t.Errorf("Boxed=%t: After consolidation got %q", b, got)
+		}
+		if got := jar.list(); got != "id=host id=sub other=1" {
+			t.Errorf("Boxed=%t: Unrelated cookies touched, got %q", b, got)
+		}
+		if n := jar.ConsolidateName(u, "id"); n != 0 {
+			t.Errorf("Boxed=%t: Second call removed %d cookies", b, n)
+		}
+		if n := jar.ConsolidateName(URL("http://www.other.test/"), "id"); n != 0 {
+			t.Errorf("Boxed=%t: Other host removed %d cookies", b, n)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CookiesExplain
+
+func TestCookiesExplain(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.SetCookies(URL("https://www.host.test/"), []*http.Cookie{
+			parseCookie("a=1; path=/foo"),
+			parseCookie("b=2; path=/bar; secure"),
+			parseCookie("c=3; path=/old"),
+		})
+		jar.IsolateByScheme = true
+		jar.SetCookies(URL("https://www.host.test/"), []*http.Cookie{
+			parseCookie("d=4; path=/tls"),
+		})
+		jar.IsolateByScheme = false
+		for _, cookie := range jar.content.all() {
+			if cookie.Name == "c" {
+				cookie.Expires = time.Now().Add(-time.Hour)
+			}
+		}
+
+		for _, tt := range []struct {
+			url, cookies, reason string
+		}{
+			{"https://www.host.test/foo", "a=1", ""},
+			{"ftp://www.host.test/foo", "", "non-http scheme"},
+			{"http://www_1.host.test/", "", "invalid host: " + errHostCharacter.Error()},
+			{"http://www.other.test/foo", "", "no cookies for host"},
+			{"http://www.host.test/baz", "", "no cookies for path"},
+			{"http://www.host.test/old", "", "all matches are expired"},
+			{"http://www.host.test/bar", "", "all matches are Secure and request is http"},
+			{"http://www.host.test/tls", "", "all matches are bound to the other scheme"},
+		} {
+			cookies, reason := jar.CookiesExplain(URL(tt.url))
+			if got := stringRep(cookies); got != tt.cookies {
+				t.Errorf("Boxed=%t %s: Got cookies %q, want %q", b, tt.url, got, tt.cookies)
+			}
+			if reason != tt.reason {
+				t.Errorf("Boxed=%t %s: Got reason %q, want %q", b, tt.url, reason, tt.reason)
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test NewJarFromEnv
+
+func TestNewJarFromEnv(t *testing.T) {
+	source := NewJar(true)
+	source.SetCookies(URL("http://www.host.test/"), []*http.Cookie{
+		parseCookie("a=1; max-age=3600"),
+		parseCookie("b=2; max-age=3600; domain=host.test"),
+	})
+	text, err := source.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText failed: %v", err)
+	}
+	c := Cookie{Name: "c", Value: "3", Domain: "www.host.test", Path: "/", HostOnly: true}
+	single, _ := json.Marshal(c)
+	c.Value = "overwritten"
+	first, _ := json.Marshal(c)
+
+	env := map[string]string{
+		"TESTJAR_COOKIES":   string(text),
+		"TESTJAR_COOKIE_2":  string(first),
+		"TESTJAR_COOKIE_10": string(single),
+		"TESTJAR_COOKIE_X":  "ignored",
+	}
+	for name, value := range env {
+		os.Setenv(name, value)
+		defer os.Unsetenv(name)
+	}
+
+	jar, err := NewJarFromEnv("TESTJAR")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if got := jar.list(); got != "a=1 b=2 c=3" {
+		t.Errorf("Got %q, want \"a=1 b=2 c=3\"", got)
+	}
+
+	os.Setenv("TESTJAR_COOKIE_3", `{"Name":"d","Value":"4"}`)
+	defer os.Unsetenv("TESTJAR_COOKIE_3")
+	if _, err := NewJarFromEnv("TESTJAR"); err != errInvalidCookie {
+		t.Errorf("Got error %v, want %v", err, errInvalidCookie)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test StripPortFromDomain
+
+func TestStripPortFromDomain(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		for _, strip := range []bool{false, true} {
+			jar := NewJar(b)
+			jar.StripPortFromDomain = strip
+			jar.SetCookies(URL("http://www.example.com"), []*http.Cookie{
+				parseCookie("a=1; domain=example.com:80"),
+				parseCookie("b=2; domain=.example.com:"),
+				parseCookie("c=3; domain=example.com:http"),
+				parseCookie("d=4; domain=:80"),
+			})
+			want := ""
+			if strip {
+				want = "a=1 b=2"
+			}
+			if got := stringRep(jar.Cookies(URL("http://sub.example.com"))); got != want {
+				t.Errorf("Boxed=%t strip=%t: Got %q, want %q", b, strip, got, want)
+			}
+			for _, c := range jar.All() {
+				if c.Domain != "example.com" || c.HostOnly {
+					t.Errorf("Boxed=%t: Stored %s with domain %q", b, c.Name, c.Domain)
+				}
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test hosts with invalid characters
+
+func TestInvalidHostCharacters(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		for _, underscore := range []bool{false, true} {
+			jar := NewJar(b)
+			jar.UnderscoreInHost = underscore
+			for _, h := range []string{"www.exa mple.com", "www.example.com\x01", "my_host.example.com"} {
+				u := &url.URL{Scheme: "http", Host: h, Path: "/"}
+				jar.SetCookies(u, []*http.Cookie{parseCookie("a=1")})
+				jar.SetCookies(u, []*http.Cookie{parseCookie("b=2; domain=example.com")})
+			}
+			want := ""
+			if underscore {
+				want = "a=1 b=2"
+			}
+			if got := jar.list(); got != want {
+				t.Errorf("Boxed=%t underscore=%t: Got %q, want %q", b, underscore, got, want)
+			}
+			got := jar.Cookies(&url.URL{Scheme: "http", Host: "my_host.example.com", Path: "/"})
+			if stringRep(got) != want {
+				t.Errorf("Boxed=%t underscore=%t: Cookies returned %q", b, underscore, stringRep(got))
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test ImplicitSecureOnHTTPS
+
+func TestImplicitSecureOnHTTPS(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.ImplicitSecureOnHTTPS = true
+		jarTest{"Implicit secure", "https://www.host.test",
+			[]string{"a=a", "b=b; secure"},
+			"a=a b=b",
+			[]query{
+				{"https://www.host.test", "a=a b=b"},
+				{"http://www.host.test", ""},
+			},
+		}.run(t, jar)
+		jarTest{"No implicit secure over http", "http://www.host.test",
+			[]string{"c=c"},
+			"a=a b=b c=c",
+			[]query{{"http://www.host.test", "c=c"}},
+		}.run(t, jar)
+
+		jar = NewJar(b)
+		jarTest{"Implicit secure off", "https://www.host.test",
+			[]string{"a=a"},
+			"a=a",
+			[]query{{"http://www.host.test", "a=a"}},
+		}.run(t, jar)
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test sharded storage
+
+func TestShardedStorage(t *testing.T) {
+	boxedJar, shardedJar := NewJar(true), NewShardedJar(7)
+	var urls []string
+	for i := 0; i < 200; i++ {
+		host := fmt.Sprintf("www.host%d.test", i%50)
+		if i%3 == 0 {
+			host = fmt.Sprintf("sub%d.host%d.test", i, i%50)
+		}
+		urls = append(urls, "http://"+host+"/")
+		cookies := []*http.Cookie{
+			parseCookie(fmt.Sprintf("h%d=%d", i%7, i)),
+			parseCookie(fmt.Sprintf("d%d=%d; domain=host%d.test", i%5, i, i%50)),
+		}
+		if i%11 == 0 {
+			cookies = append(cookies, parseCookie(fmt.Sprintf("d%d=x; max-age=-1; domain=host%d.test", i%3, i%50)))
+		}
+		for _, jar := range []*Jar{boxedJar, shardedJar} {
+			jar.SetCookies(URL(urls[i]), cookies)
+		}
+	}
+
+	if b, s := boxedJar.list(), shardedJar.list(); b != s {
+		t.Errorf("Different content:\nboxed   %s\nsharded %s", b, s)
+	}
+	for _, u := range urls {
+		if b, s := stringRep(boxedJar.Cookies(URL(u))), stringRep(shardedJar.Cookies(URL(u))); b != s {
+			t.Errorf("%s: Got %q from boxed, %q from sharded", u, b, s)
+		}
+	}
+	if b, s := fmt.Sprint(boxedJar.StorageStats().Boxes), fmt.Sprint(shardedJar.StorageStats().Boxes); b != s {
+		t.Errorf("Different boxes:\nboxed   %s\nsharded %s", b, s)
+	}
+	if b, s := boxedJar.DeleteMatching("host1.test", "", ""), shardedJar.DeleteMatching("host1.test", "", ""); b != s {
+		t.Errorf("Deleted %d from boxed, %d from sharded", b, s)
+	}
+
+	// gob keeps the kind of storage
+	data, err := shardedJar.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode failed: %v", err)
+	}
+	var decoded Jar
+	if err := decoded.GobDecode(data); err != nil {
+		t.Fatalf("GobDecode failed: %v", err)
+	}
+	if s, ok := decoded.content.(*sharded); !ok || len(*s) != 7 {
+		t.Errorf("Got storage %T after decoding", decoded.content)
+	}
+	if decoded.list() != shardedJar.list() {
+		t.Errorf("Different content after decoding")
+	}
+}
+
+func benchmarkManyDomains(b *testing.B, newJar func() *Jar) {
+	const domains = 100000
+	urls := make([]*url.URL, domains)
+	for i := range urls {
+		urls[i] = URL(fmt.Sprintf("http://www.domain%d.test/", i))
+	}
+	cookies := []*http.Cookie{parseCookie("a=1"), parseCookie("b=2")}
+
+	b.Run("insert", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			jar := newJar()
+			for _, u := range urls {
+				jar.SetCookies(u, cookies)
+			}
+		}
+	})
+	b.Run("retrieve", func(b *testing.B) {
+		jar := newJar()
+		for _, u := range urls {
+			jar.SetCookies(u, cookies)
+		}
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			jar.Cookies(urls[n%domains])
+		}
+	})
+}
+
+func BenchmarkBoxedManyDomains(b *testing.B) {
+	benchmarkManyDomains(b, func() *Jar { return NewJar(true) })
+}
+
+func BenchmarkShardedManyDomains(b *testing.B) {
+	benchmarkManyDomains(b, func() *Jar { return NewShardedJar(64) })
+}
+
+// -------------------------------------------------------------------------
+// Test AuditSecure
+
+func TestAuditSecure(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		jar := NewJar(b)
+		jar.ProtectSecureCookies = true
+		jar.SetCookies(URL("https://www.host.test/"), []*http.Cookie{
+			parseCookie("a=1; secure; domain=host.test"),
+			parseCookie("b=2; secure; path=/p"),
+			parseCookie("c=3; secure"),
+			parseCookie("d=4"),
+		})
+		if got := jar.AuditSecure(); len(got) != 0 {
+			t.Errorf("Boxed=%t: Got %d flagged cookies for a clean jar", b, len(got))
+		}
+
+		// bypass the write guard by importing insecure siblings
+		jar.Import([]*Cookie{
+			{Name: "a", Value: "evil", Domain: "www.host.test", Path: "/", HostOnly: true},
+			{Name: "b", Value: "evil", Domain: "www.host.test", Path: "/p/q", HostOnly: true},
+			{Name: "c", Value: "ok", Domain: "www.other.test", Path: "/", HostOnly: true},
+		})
+		var got []string
+		for _, c := range jar.AuditSecure() {
+			got = append(got, c.Name+"="+c.Value+"@"+c.Domain+c.Path)
+		}
+		want := "a=1@host.test/ b=2@www.host.test/p"
+		if strings.Join(got, " ") != want {
+			t.Errorf("Boxed=%t: Got %v, want %s", b, got, want)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test NormalizePaths
+
+func TestNormalizePaths(t *testing.T) {
+	for _, b := range []bool{true, false} {
+		for _, normalize := range []bool{false, true} {
+			jar := NewJar(b)
+			jar.NormalizePaths = normalize
+			jar.SetCookies(URL("http://www.host.test/"), []*http.Cookie{
+				parseCookie("a=1; path=/a//b"),
+				parseCookie("b=2; path=/a/b"),
+				parseCookie("c=3; path=/a/./c"),
+			})
+			for _, tt := range []struct {
+				url, plain, normalized string
+			}{
+				{"http://www.host.test/a/b", "b=2", "a=1 b=2"},
+				{"http://www.host.test/a//b/x", "a=1", "a=1 b=2"},
+				{"http://www.host.test/a/c", "", "c=3"},
+			} {
+				want := tt.plain
+				if normalize {
+					want = tt.normalized
+				}
+				if got := stringRep(jar.Cookies(URL(tt.url))); got != want {
+					t.Errorf("Boxed=%t normalize=%t %s: Got %q, want %q",
+						b, normalize, tt.url, got, want)
+				}
+			}
+		}
+	}
+}
+
+// -------------------------------------------------------------------------
+// Test CheckInvariants
+
+// someFlat returns the flat storage holding the cookies of www.host.test.
+func someFlat(jar *Jar) *flat {
+	switch content := jar.content.(type) {
+	case *flat:
+		return content
+	case *boxed:
+		return content.flat("www.host.test")
+	case *sharded:
+		return content.shard("www.host.test").flat("www.host.test")
+	}
+	panic(fmt.Sprintf("Unknown storage %T", jar.content))
+}
+
+func TestCheckInvariants(t *testing.T) {
+	kinds := map[string]func() *Jar{
+		"flat":    func() *Jar { return NewJar(false) },
+		"boxed":   func() *Jar { return NewJar(true) },
+		"sharded": func() *Jar { return NewShardedJar(4) },
+	}
+	u := URL("http://www.host.test/")
+	for _, tt := range []struct {
+		desc    string
+		corrupt func(jar *Jar)
+		want    map[string]error // by kind of storage; missing means nil
+	}{
+		{"nothing", func(jar *Jar) {}, nil},
+		{"nil cookie", func(jar *Jar) {
+			f := someFlat(jar)
+			*f = append(*f, nil)
+		}, map[string]error{"flat": errNilCookie, "boxed": errNilCookie, "sharded": errNilCookie}},
+		{"empty name", func(jar *Jar) {
+			(*someFlat(jar))[0].Name = ""
+		}, map[string]error{"flat": errEmptyName, "boxed": errEmptyName, "sharded": errEmptyName}},
+		{"duplicate", func(jar *Jar) {
+			f := someFlat(jar)
+			c := *(*f)[0]
+			*f = append(*f, &c)
+		}, map[string]error{"flat": errDuplicateCookie, "boxed": errDuplicateCookie, "sharded": errDuplicateCookie}},
+		{"wrong box", func(jar *Jar) {
+			(*someFlat(jar))[0].Domain = "www.other.test"
+		}, map[string]error{"boxed": errWrongBox, "sharded": errWrongBox}},
+		{"wrong shard", func(jar *Jar) {
+			if s, ok := jar.content.(*sharded); ok {
+				from := s.shard("www.host.test")
+				for i := range *s {
+					if to := &(*s)[i]; to != from {
+						(*to)["host.test"] = (*from)["host.test"]
+						delete(*from, "host.test")
+						break
+					}
+				}
+			}
+		}, map[string]error{"sharded": errWrongShard}},
+		{"stale cache", func(jar *Jar) {
+			jar.CacheRetrieval = true
+			jar.Cookies(u)
+			c := jar.content.find("www.host.test", "/", "z", "")
+			*c = Cookie{Name: "z", Value: "9", Domain: "www.host.test", Path: "/", HostOnly: true}
+		}, map[string]error{"flat": errCountMismatch, "boxed": errCountMismatch, "sharded": errCountMismatch}},
+	} {
+		for kind, newJar := range kinds {
+			jar := newJar()
+			jar.SetCookies(u, []*http.Cookie{parseCookie("a=1"), parseCookie("b=2")})
+			jar.SetCookies(URL("http://www.other.test/"), []*http.Cookie{parseCookie("c=3")})
+			if err := jar.CheckInvariants(); err != nil {
+				t.Fatalf("%s: Fresh jar violates invariants: %v", kind, err)
+			}
+			tt.corrupt(jar)
+			if err := jar.CheckInvariants(); err != tt.want[kind] {
+				t.Errorf("%s %s: Got %v, want %v", kind, tt.desc, err, tt.want[kind])
+			}
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+//
+// recreate the IDNA tables with
+//   go run makeidna.go > idnatable.go && go fmt
+// This needs golang.org/x/text; the Unicode version of x/text should
+// match the one of the Go release the package is built with.
+//
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/bidi"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	hangulBase = 0xAC00
+	hangulEnd  = 0xD7A4
+)
+
+// the classes of bidiClass in idna.go
+var bidiNames = map[bidi.Class]string{
+	bidi.R:   "bidiR",
+	bidi.AL:  "bidiAL",
+	bidi.AN:  "bidiAN",
+	bidi.EN:  "bidiEN",
+	bidi.NSM: "bidiNSM",
+}
+
+func assigned(r rune) bool {
+	return utf8.ValidRune(r) &&
+		!unicode.In(r, unicode.Cs, unicode.Co) &&
+		unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P,
+			unicode.S, unicode.Z, unicode.C)
+}
+
+func main() {
+	mapping := make(map[rune]string)
+	decomposition := make(map[rune]string)
+	composition := make(map[[2]rune]rune)
+	combining := make(map[rune]uint8)
+	var classes []string // "lo, hi, class" triples
+
+	var runLo, runHi rune
+	var runClass string
+	flush := func() {
+		if runClass != "" {
+			classes = append(classes,
+				fmt.Sprintf("{0x%04X, 0x%04X, %s},", runLo, runHi, runClass))
+		}
+	}
+
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if !assigned(r) {
+			continue
+		}
+		s := string(r)
+
+		// bidi classes, merged to runs
+		p, _ := bidi.LookupRune(r)
+		class := bidiNames[p.Class()]
+		if class != runClass || r != runHi+1 {
+			flush()
+			runLo, runClass = r, class
+		}
+		runHi = r
+
+		if r < utf8.RuneSelf || hangulBase <= r && r < hangulEnd {
+			continue // ASCII and Hangul syllables are handled in code
+		}
+
+		if ccc := norm.NFD.PropertiesString(s).CCC(); ccc != 0 {
+			combining[r] = ccc
+		}
+
+		// the UTS #46 mapping approximated by NFKC and lower casing
+		m := norm.NFKC.String(strings.ToLower(norm.NFKC.String(s)))
+		if m != string(unicode.ToLower(r)) {
+			mapping[r] = m
+		}
+
+		d := norm.NFD.String(s)
+		if d == s {
+			continue
+		}
+		decomposition[r] = d
+		if norm.NFC.String(s) != s {
+			continue // excluded from composition
+		}
+		// a primary composite: find the pair it composes from
+		rs := []rune(d)
+		first := norm.NFC.String(string(rs[:len(rs)-1]))
+		if utf8.RuneCountInString(first) != 1 ||
+			norm.NFC.String(first+string(rs[len(rs)-1])) != s {
+			continue
+		}
+		f, _ := utf8.DecodeRuneInString(first)
+		composition[[2]rune{f, rs[len(rs)-1]}] = r
+	}
+	flush()
+
+	fmt.Printf(`// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+// This file was generated by performing
+//     go run makeidna.go
+// on  %s
+// Do not modify.
+
+// the Unicode version the tables were generated from
+const idnaUnicodeVersion = %q
+
+`, time.Now().Format(time.RFC1123Z), unicode.Version)
+
+	fmt.Println("// idnaMapping maps code points to their UTS #46 mapping if this")
+	fmt.Println("// differs from unicode.ToLower.")
+	fmt.Println("var idnaMapping = map[rune]string{")
+	var runes []rune
+	for r := range mapping {
+		runes = append(runes, r)
+	}
+	for _, r := range sortRunes(runes) {
+		fmt.Printf("\t0x%04X: %+q,\n", r, mapping[r])
+	}
+	fmt.Println("}")
+	fmt.Println()
+
+	fmt.Println("// decomposition is the full canonical decomposition of code points")
+	fmt.Println("// other than Hangul syllables.")
+	fmt.Println("var decomposition = map[rune]string{")
+	runes = runes[:0]
+	for r := range decomposition {
+		runes = append(runes, r)
+	}
+	for _, r := range sortRunes(runes) {
+		fmt.Printf("\t0x%04X: %+q,\n", r, decomposition[r])
+	}
+	fmt.Println("}")
+	fmt.Println()
+
+	pairs := make([][2]rune, 0, len(composition))
+	for pair := range composition {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	fmt.Println("// composition maps pairs of code points to their primary composite.")
+	fmt.Println("var composition = map[[2]rune]rune{")
+	for _, pair := range pairs {
+		fmt.Printf("\t{0x%04X, 0x%04X}: 0x%04X,\n", pair[0], pair[1], composition[pair])
+	}
+	fmt.Println("}")
+	fmt.Println()
+
+	fmt.Println("// combiningClass is the non-zero canonical combining class of code points.")
+	fmt.Println("var combiningClass = map[rune]uint8{")
+	runes = runes[:0]
+	for r := range combining {
+		runes = append(runes, r)
+	}
+	for _, r := range sortRunes(runes) {
+		fmt.Printf("\t0x%04X: %d,\n", r, combining[r])
+	}
+	fmt.Println("}")
+	fmt.Println()
+
+	fmt.Println("// bidiClasses lists the runs of code points with one of the bidi")
+	fmt.Println("// classes used by the Bidi Rule; all other code points count as bidiL.")
+	fmt.Println("var bidiClasses = []bidiRange{")
+	for _, c := range classes {
+		fmt.Printf("\t%s\n", c)
+	}
+	fmt.Println("}")
+}
+
+func sortRunes(runes []rune) []rune {
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	return runes
+}
@@ -9,9 +9,12 @@ package main
 //
 // recreate the tables with
 //   go run maketable.go > table.go && go fmt
+// and the reference tree used by the tests with
+//   go run maketable.go -tree > table_test.go && go fmt
 //
 
 import (
+	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -23,6 +26,8 @@ import (
 	"time"
 )
 
+var tree = flag.Bool("tree", false, "print the tree of Node nodes used as reference in the tests")
+
 const tableUrl = "http://mxr.mozilla.org/mozilla-central/source/netwerk/dns/effective_tld_names.dat?raw=1"
 
 type ruleKind uint8
@@ -91,6 +96,7 @@ func (t nodeList) Less(i, j int) bool {
 func (t nodeList) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
 
 func main() {
+	flag.Parse()
 	var input io.Reader
 
 	if file, err := os.Open("effective_tld_names.dat"); err == nil {
@@ -109,22 +115,6 @@ func main() {
 		log.Fatal(err)
 	}
 
-	fmt.Println("// Copyright 2012 Volker Dobler. All rights reserved.")
-	fmt.Println("// Use of this source code is governed by a BSD-style")
-	fmt.Println("// license that can be found in the LICENSE file.")
-	fmt.Println("")
-	fmt.Println("package cookiejar")
-	fmt.Println("")
-	fmt.Println("// This file was generated by performing")
-	fmt.Println("//     go run maketable.go")
-	fmt.Println("// on ", time.Now().Format(time.RFC1123Z))
-	fmt.Println("// Do not modify.")
-	fmt.Println("")
-	fmt.Println("// A 'public suffix' is one under which Internet users can directly register")
-	fmt.Println("// names.  This list is maintained on http://publicsuffix.org/")
-	fmt.Println("// See there for a description of the format and further details.")
-	fmt.Println("")
-
 	var root []node = make([]node, 0, 200)
 
 	// read in list: remove comments and empty lines, and fill tlds and rules
@@ -149,8 +139,36 @@ func main() {
 		root = insert(root, parts)
 	}
 
-	// write out data structure
-	fmt.Printf("var PublicSuffixes = Node{\"\", 0, []Node{\n")
+	fmt.Println("// Copyright 2012 Volker Dobler. All rights reserved.")
+	fmt.Println("// Use of this source code is governed by a BSD-style")
+	fmt.Println("// license that can be found in the LICENSE file.")
+	fmt.Println("")
+	fmt.Println("package cookiejar")
+	fmt.Println("")
+	fmt.Println("// This file was generated by performing")
+	if *tree {
+		fmt.Println("//     go run maketable.go -tree")
+	} else {
+		fmt.Println("//     go run maketable.go")
+	}
+	now := time.Now().Format(time.RFC1123Z)
+	fmt.Println("// on ", now)
+	fmt.Println("// Do not modify.")
+	fmt.Println("")
+
+	if *tree {
+		printTree(root)
+	} else {
+		printTable(root, now)
+	}
+}
+
+// printTree writes out the list as tree of Node nodes together with the
+// fibonacci numbers needed to search it.  The tests use this tree as
+// reference for the table.
+func printTree(root []node) {
+	fmt.Println("// referenceSuffixes is the public suffix list as tree of Node nodes.")
+	fmt.Printf("var referenceSuffixes = Node{\"\", 0, []Node{\n")
 	printNodelist(root, 1)
 	fmt.Printf("}}\n")
 	fmt.Println()
@@ -163,10 +181,87 @@ func main() {
 		a, b = b, n
 	}
 	fmt.Printf("}\n")
-	fmt.Println()
-	//fmt.Println("// the needed fibonacci numbers")
-	// fmt.Printf("var fibonacci = []int{0, 1")
+}
+
+// printTable writes out the list flattened into a suffixTable: The nodes
+// are numbered in breadth first order with the root as node 0, so the
+// children of a node are contiguous and sorted by label.  Labels are
+// interned into one string.
+func printTable(root []node, now string) {
+	fmt.Println("// the date of generation of this table")
+	fmt.Printf("const publicSuffixListDate = %q\n", now)
+	fmt.Println("")
+	fmt.Println("// A 'public suffix' is one under which Internet users can directly register")
+	fmt.Println("// names.  This list is maintained on http://publicsuffix.org/")
+	fmt.Println("// See there for a description of the format and further details.")
+	fmt.Println("")
 
+	var text []string
+	textLen := uint32(0)
+	interned := make(map[string]uint32)
+	var offset, length, kind, first, count []uint32
+	add := func(label string, k ruleKind) {
+		if len(label) > 255 {
+			log.Fatalf("Label %q too long", label)
+		}
+		off, ok := interned[label]
+		if !ok {
+			off = textLen
+			text = append(text, label)
+			textLen += uint32(len(label))
+			interned[label] = off
+		}
+		offset = append(offset, off)
+		length = append(length, uint32(len(label)))
+		kind = append(kind, uint32(k))
+		first = append(first, 0)
+		count = append(count, 0)
+	}
+
+	queue := [][]node{root}
+	add("", none)
+	for i := 0; i < len(queue); i++ {
+		sub := queue[i]
+		sort.Sort(nodeList(sub))
+		first[i] = uint32(len(kind))
+		count[i] = uint32(len(sub))
+		for j := range sub {
+			add(sub[j].label, sub[j].kind)
+			queue = append(queue, sub[j].sub)
+		}
+	}
+
+	fmt.Println("// suffixes is the public suffix list flattened into a suffixTable.")
+	fmt.Println("var suffixes = &suffixTable{")
+	fmt.Println("\ttext: \"\" +")
+	line := ""
+	for _, label := range text {
+		if len(line)+len(label) > 64 {
+			fmt.Printf("\t\t%q +\n", line)
+			line = ""
+		}
+		line += label
+	}
+	fmt.Printf("\t\t%q,\n", line)
+	printNumbers("offset", "uint32", offset)
+	printNumbers("length", "uint8", length)
+	printNumbers("kind", "Rule", kind)
+	printNumbers("first", "uint32", first)
+	printNumbers("count", "uint32", count)
+	fmt.Println("}")
+}
+
+// printNumbers writes out the field name of a suffixTable as slice of typ.
+func printNumbers(name, typ string, numbers []uint32) {
+	fmt.Printf("\t%s: []%s{\n", name, typ)
+	for i := 0; i < len(numbers); i += 16 {
+		fmt.Printf("\t\t")
+		for j := i; j < i+16 && j < len(numbers); j++ {
+			fmt.Printf("%d, ", numbers[j])
+		}
+		fmt.Println()
+	}
+	fmt.Println("\t},")
 }
 
 var longest int
@@ -0,0 +1,376 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// -------------------------------------------------------------------------
+// Persistence
+
+var (
+	errInvalidCookie = errors.New("Invalid cookie in cookie file")
+	errLoadTooLarge  = errors.New("Cookie file exceeds MaxLoadBytes")
+	errLoadTooMany   = errors.New("Cookie file exceeds MaxLoadCookies")
+	errNoCookieList  = errors.New("Cookie file does not contain a list")
+)
+
+// limitedReader reads from r until n bytes have been read and fails with
+// errLoadTooLarge afterwards.
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n <= 0 {
+		return 0, errLoadTooLarge
+	}
+	if int64(len(p)) > l.n {
+		p = p[:l.n]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	return n, err
+}
+
+// decodeList decodes the JSON list read from r element by element by
+// calling elem for each element while honoring MaxLoadBytes and
+// MaxLoadCookies.  A JSON null is treated as an empty list.
+func (jar *Jar) decodeList(r io.Reader, elem func(dec *json.Decoder) error) error {
+	if jar.MaxLoadBytes > 0 {
+		r = &limitedReader{r, jar.MaxLoadBytes}
+	}
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('[') {
+		return errNoCookieList
+	}
+	for n := 0; dec.More(); n++ {
+		if jar.MaxLoadCookies > 0 && n >= jar.MaxLoadCookies {
+			return errLoadTooMany
+		}
+		if err := elem(dec); err != nil {
+			return err
+		}
+	}
+	_, err = dec.Token() // the closing ]
+	return err
+}
+
+// Save writes all non-expired cookies of jar as JSON to the file path.
+func (jar *Jar) Save(path string) error {
+	jar.Lock()
+	all := jar.All()
+	jar.Unlock()
+
+	data, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+// LoadReplace replaces the content of jar with the cookies read from the
+// file path (as written by Save).  The cookies are loaded completely
+// before they replace the current content: If reading or decoding the
+// file fails or the file contains an invalid cookie an error is returned
+// and jar is left untouched.  Expired cookies in the file are dropped.
+// MaxCookiesPerHost, MaxCookiesTotal and MaxDomains are enforced on the
+// loaded cookies unless DeferCleanup is set.
+func (jar *Jar) LoadReplace(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return jar.decodeReplace(file)
+}
+
+// decodeReplace replaces the content of jar with the JSON list of cookies
+// read from r as described in LoadReplace.
+func (jar *Jar) decodeReplace(r io.Reader) error {
+	var cookies []Cookie
+	err := jar.decodeList(r, func(dec *json.Decoder) error {
+		var cookie Cookie
+		if err := dec.Decode(&cookie); err != nil {
+			return err
+		}
+		cookies = append(cookies, cookie)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	jar.Lock()
+	defer jar.Unlock()
+
+	content := jar.content.empty()
+	for i := range cookies {
+		if jar.expired(&cookies[i]) {
+			continue
+		}
+		if !jar.valid(&cookies[i]) {
+			return errInvalidCookie
+		}
+		c := content.find(cookies[i].Domain, cookies[i].Path, cookies[i].Name,
+			cookies[i].Scheme)
+		*c = cookies[i]
+	}
+
+	jar.replace(content)
+	return nil
+}
+
+// maxTextBytes is the maximal length of the text produced by MarshalText
+// and accepted by UnmarshalText.
+const maxTextBytes = 32 << 10
+
+var (
+	errTextTooLarge = errors.New("Cookie text exceeds 32 KiB")
+	errBadText      = errors.New("Malformed cookie text")
+)
+
+// MarshalText encodes all persistent cookies of jar as a single line of
+// URL-safe base64 which can be stored e.g. in an environment variable.
+// Session cookies are omitted.  An error is returned if the encoded
+// cookies exceed 32 KiB.
+func (jar *Jar) MarshalText() ([]byte, error) {
+	jar.Lock()
+	all := jar.All()
+	jar.Unlock()
+
+	persistent := make([]Cookie, 0, len(all))
+	for _, cookie := range all {
+		if !cookie.Session() {
+			persistent = append(persistent, cookie)
+		}
+	}
+	data, err := json.Marshal(persistent)
+	if err != nil {
+		return nil, err
+	}
+	if base64.RawURLEncoding.EncodedLen(len(data)) > maxTextBytes {
+		return nil, errTextTooLarge
+	}
+	text := make([]byte, base64.RawURLEncoding.EncodedLen(len(data)))
+	base64.RawURLEncoding.Encode(text, data)
+	return text, nil
+}
+
+// UnmarshalText replaces the content of jar with the cookies encoded in
+// text by MarshalText.  Like LoadReplace it enforces the limits of jar
+// and leaves jar untouched if text is malformed or contains an invalid
+// cookie.
+func (jar *Jar) UnmarshalText(text []byte) error {
+	if len(text) > maxTextBytes {
+		return errTextTooLarge
+	}
+	data := make([]byte, base64.RawURLEncoding.DecodedLen(len(text)))
+	n, err := base64.RawURLEncoding.Decode(data, text)
+	if err != nil {
+		return errBadText
+	}
+	if err := jar.decodeReplace(bytes.NewReader(data[:n])); err != nil {
+		if err == errInvalidCookie {
+			return err
+		}
+		return errBadText
+	}
+	return nil
+}
+
+// NewJarFromEnv sets up a jar like NewDefaultJar and preloads it with the
+// cookies from the environment: The variable PREFIX_COOKIES may hold the
+// output of MarshalText and variables PREFIX_COOKIE_1, PREFIX_COOKIE_2 and
+// so on may hold a single cookie each, encoded as JSON like by Save.  The
+// latter are imported in numerical order after the former.  An error is
+// returned if a variable is malformed or holds an invalid cookie.
+func NewJarFromEnv(prefix string) (*Jar, error) {
+	jar := NewDefaultJar()
+	jar.DeferCleanup = true // clean up once after both loads
+	if text := os.Getenv(prefix + "_COOKIES"); text != "" {
+		if err := jar.UnmarshalText([]byte(text)); err != nil {
+			return nil, err
+		}
+	}
+
+	vars := make(map[int]string)
+	var numbers []int
+	for _, env := range os.Environ() {
+		name, value := env, ""
+		if i := strings.Index(env, "="); i != -1 {
+			name, value = env[:i], env[i+1:]
+		}
+		if !strings.HasPrefix(name, prefix+"_COOKIE_") {
+			continue
+		}
+		n, err := strconv.Atoi(name[len(prefix+"_COOKIE_"):])
+		if err != nil {
+			continue
+		}
+		vars[n] = value
+		numbers = append(numbers, n)
+	}
+	sort.Ints(numbers)
+
+	cookies := make([]*Cookie, len(numbers))
+	for i, n := range numbers {
+		cookies[i] = &Cookie{}
+		if err := json.Unmarshal([]byte(vars[n]), cookies[i]); err != nil {
+			return nil, err
+		}
+	}
+	if _, rejected := jar.Import(cookies); rejected > 0 {
+		return nil, errInvalidCookie
+	}
+	jar.DeferCleanup = false
+	jar.Cleanup()
+	return jar, nil
+}
+
+// harCookie is a cookie in the format of the HTTP Archive (HAR) format.
+// See http://www.softwareishard.com/blog/har-12-spec/#cookies
+type harCookie struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Path     string `json:"path,omitempty"`
+	Domain   string `json:"domain,omitempty"`
+	Expires  string `json:"expires,omitempty"`
+	HttpOnly bool   `json:"httpOnly"`
+	Secure   bool   `json:"secure"`
+}
+
+// ExportHAR writes all non-expired cookies of jar as JSON array of HAR
+// cookies to w.  Domain cookies are written with a leading dot in their
+// domain, session cookies without expires.  Values are decoded by
+// ValueCodec.
+func (jar *Jar) ExportHAR(w io.Writer) error {
+	jar.Lock()
+	all := jar.All()
+	jar.Unlock()
+
+	cookies := make([]harCookie, len(all))
+	for i := range all {
+		value := all[i].Value
+		if jar.ValueCodec != nil {
+			value = jar.ValueCodec.Decode(value)
+		}
+		cookies[i] = toHAR(&all[i], value)
+	}
+	return json.NewEncoder(w).Encode(cookies)
+}
+
+// toHAR converts c with the given value to a harCookie.
+func toHAR(c *Cookie, value string) harCookie {
+	h := harCookie{
+		Name:     c.Name,
+		Value:    value,
+		Path:     c.Path,
+		Domain:   c.displayDomain(),
+		HttpOnly: c.HttpOnly,
+		Secure:   c.Secure,
+	}
+	if !c.Session() {
+		h.Expires = c.Expires.UTC().Format(time.RFC3339Nano)
+	}
+	return h
+}
+
+// CookiesJSON returns the cookies Cookies(u) would return as JSON array
+// of HAR cookies (see ExportHAR), e.g. to hand them to a frontend.
+// Values are decoded by ValueCodec.  Unlike Cookies it does not update
+// the LastAccess time of the cookies.
+func (jar *Jar) CookiesJSON(u *url.URL) ([]byte, error) {
+	jar.Lock()
+	selection, _ := jar.selectCookies(u, SortRFC, nil, time.Time{})
+	cookies := make([]harCookie, len(selection))
+	for i, c := range selection {
+		value := c.Value
+		if jar.ValueCodec != nil {
+			value = jar.ValueCodec.Decode(value)
+		}
+		cookies[i] = toHAR(c, value)
+	}
+	jar.Unlock()
+
+	return json.Marshal(cookies)
+}
+
+// ImportHAR reads a JSON array of HAR cookies from r and adds them to jar
+// like Import does.  A domain with a leading dot yields a domain cookie,
+// otherwise a host cookie.  Cookies without path get the path "/".
+// Values are encoded by ValueCodec.  The numbers of imported and
+// rejected cookies are returned as by Import.  If
+// r cannot be decoded nothing is imported and an error is returned.
+func (jar *Jar) ImportHAR(r io.Reader) (imported, rejected int, err error) {
+	var hars []harCookie
+	err = jar.decodeList(r, func(dec *json.Decoder) error {
+		var h harCookie
+		if err := dec.Decode(&h); err != nil {
+			return err
+		}
+		hars = append(hars, h)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	now := time.Now()
+	cookies := make([]*Cookie, 0, len(hars))
+	for _, h := range hars {
+		domain, dotted := canonicalDomain(h.Domain)
+		value := h.Value
+		if jar.ValueCodec != nil {
+			value = jar.ValueCodec.Encode(value)
+		}
+		c := &Cookie{
+			Name:       h.Name,
+			Value:      value,
+			Domain:     domain,
+			Path:       h.Path,
+			HostOnly:   !dotted,
+			HttpOnly:   h.HttpOnly,
+			Secure:     h.Secure,
+			Created:    now,
+			LastAccess: now,
+		}
+		if c.Path == "" {
+			c.Path = "/"
+		}
+		if h.Expires != "" {
+			expires, err := time.Parse(time.RFC3339Nano, h.Expires)
+			if err != nil {
+				return 0, 0, err
+			}
+			c.Expires = expires
+		}
+		cookies = append(cookies, c)
+		now = now.Add(time.Nanosecond)
+	}
+
+	imported, rejected = jar.Import(cookies)
+	return imported, rejected, nil
+}
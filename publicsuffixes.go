@@ -33,17 +33,14 @@ package cookiejar
 //    7. The registered or registrable domain is the public suffix plus one
 //       additional label.
 // As this algorithm is prohibitive slow we store the list of rules as
-// a tree and search this tree for a longest match.  Beeing an exception rule
+// a tree and search this tree for a longest match.  The tree is compiled
+// by maketable.go into the compact suffixTable in table.go.  Beeing an exception rule
 // is stored naturaly on the node.  Wildcard rules are handled the same
 // A rule like "*.a.b" contains a node "a" and this node's kind is wildcard.
 // This data structure works as there are no two rules of the type.
 // "!a.b" and "*.a.b".
 //
 
-import (
-	"strings"
-)
-
 // Rule is the type or kind of a rule in the public suffix list
 type Rule uint8
 
@@ -55,62 +52,15 @@ const (
 )
 
 // Node describes a single label in public suffix rule.
-// The list of rules is stored as a tree of Node nodes.
+// A list of rules is built as a tree of Node nodes before it is
+// flattened into a suffixTable.
 type Node struct {
 	Label string
 	Kind  Rule
 	Sub   []Node
 }
 
-// findLabel looks up the node with label in nodes.
-func findLabel(label string, nodes []Node) *Node {
-	N := len(nodes)
-	if N == 0 {
-		return nil
-	}
-
-	// Fibonacci search
-	// k, M := T[N].k, T[N].M
-	k := 0
-	for ; fibonacci[k] <= N; k++ {
-	}
-	k--
-	M := fibonacci[k+1] - N - 1
-	i, p, q := fibonacci[k]-1, fibonacci[k-1], fibonacci[k-2]
-
-	if label > nodes[i].Label {
-		i -= M
-		if p == 1 {
-			return nil
-		}
-		i += q
-		p -= q
-		q -= p
-	}
-
-	for {
-		if label == nodes[i].Label {
-			return &nodes[i]
-		}
-		if label < nodes[i].Label {
-			if q == 0 {
-				return nil
-			}
-			i -= q
-			p, q = q, p-q
-		} else {
-			if p == 1 {
-				return nil
-			}
-			i += q
-			p -= q
-			q -= p
-		}
-	}
-	panic("not reached")
-}
-
-// effectiveTldPlusOne retrieves TLD + 1 respective the publicsuffix + 1.
+// EffectiveTLDPlusOne retrieves TLD + 1 respective the publicsuffix + 1.
 // For domains which are too short (tld ony, or publixsuffix only)
 // the empty string is returned.
 //
@@ -120,45 +70,7 @@ func findLabel(label string, nodes []Node) *Node {
 //    7. The registered or registrable domain is the public suffix plus one
 //       additional label.
 func EffectiveTLDPlusOne(domain string) (ret string) {
-	parts := strings.Split(domain, ".")
-	m := len(parts)
-	nodes := PublicSuffixes.Sub
-	var np *Node
-	for m > 0 {
-		m--
-		sub := findLabel(parts[m], nodes)
-		if sub == nil {
-			m++
-			break
-		}
-		nodes = sub.Sub
-		np = sub
-	}
-	// np now points to last matching node
-
-	if np == nil || np.Kind == None {
-		// no rule found, default is "*"
-		if len(parts) == 2 {
-			return domain
-		} else if len(parts) > 2 {
-			i := len(parts) - 1
-			return parts[i-1] + "." + parts[i]
-		} else {
-			return ""
-		}
-	}
-
-	switch np.Kind {
-	case Normal:
-		m--
-	case Exception:
-	case Wildcard:
-		m -= 2
-	}
-	if m < 0 {
-		return ""
-	}
-	return strings.Join(parts[m:], ".")
+	return publicSuffixList().table.effectiveTLDPlusOne(domain)
 }
 
 // check whether domain is "specific" enough to allow domain cookies
@@ -169,3 +81,9 @@ func allowDomainCookies(domain string) bool {
 	// fmt.Printf("  etldp1 = %s\n", etldp1)
 	return etldp1 != ""
 }
+
+// isSecondLevelSuffix checks whether domain consists of a known TLD plus
+// one label and is a public suffix itself like "co.uk" or "uk.com".
+func isSecondLevelSuffix(domain string) bool {
+	return publicSuffixList().table.isSecondLevelSuffix(domain)
+}
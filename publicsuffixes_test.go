@@ -5,6 +5,9 @@
 package cookiejar
 
 import (
+	"net/http"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -109,7 +112,7 @@ func TestEffectiveTLDPlusOneTests(t *testing.T) {
 	}
 }
 
-var allowCookiesOnTests = []struct {
+var allowDomainCookiesTests = []struct {
 	domain string
 	allow  bool
 }{
@@ -131,8 +134,8 @@ var allowCookiesOnTests = []struct {
 	{"foo.aisai.aichi.jp", true},
 }
 
-func TestAllowCookiesOn(t *testing.T) {
-	for i, tt := range allowCookiesOnTests {
+func TestAllowDomainCookies(t *testing.T) {
+	for i, tt := range allowDomainCookiesTests {
 		allow := allowDomainCookies(tt.domain)
 		if allow != tt.allow {
 			t.Errorf("%d: domain=%q expected %t got %t", i, tt.domain, tt.allow, allow)
@@ -140,9 +143,39 @@ func TestAllowCookiesOn(t *testing.T) {
 	}
 }
 
+// TestDomainCookiesOnAllowedDomains checks that a jar accepts a domain
+// cookie for exactly the domains allowDomainCookies allows.
+func TestDomainCookiesOnAllowedDomains(t *testing.T) {
+	for _, boxed := range []bool{true, false} {
+		for i, tt := range allowDomainCookiesTests {
+			jar := NewJar(boxed)
+			jar.SetCookies(URL("http://www."+tt.domain),
+				[]*http.Cookie{parseCookie("a=1; domain=" + tt.domain)})
+			cookies := jar.All()
+			stored := len(cookies) == 1 && !cookies[0].HostOnly
+			if stored != tt.allow {
+				t.Errorf("Boxed=%t %d: domain=%q stored %t, want %t",
+					boxed, i, tt.domain, stored, tt.allow)
+			}
+		}
+	}
+
+	// the boundary: a public suffix and its registrable domains
+	jar := NewJar(true)
+	u := URL("http://www.bbc.co.uk")
+	rejected := jar.WouldReject(u, []*http.Cookie{
+		parseCookie("a=1; domain=co.uk"),
+		parseCookie("b=2; domain=bbc.co.uk"),
+		parseCookie("c=3; domain=www.bbc.co.uk"),
+	})
+	if len(rejected) != 1 || rejected[0].Name != "a" {
+		t.Errorf("Got %d rejected cookies, want only a", len(rejected))
+	}
+}
+
 func BenchmarkAllowDomainCookies(b *testing.B) {
 	for i := 0; i < b.N; i++ {
-		for _, tt := range allowCookiesOnTests {
+		for _, tt := range allowDomainCookiesTests {
 			allowDomainCookies(tt.domain)
 		}
 	}
@@ -171,3 +204,396 @@ func BenchmarkAllowULDomainCookies(b *testing.B) {
 		}
 	}
 }
+
+var secondLevelSuffixTests = []struct {
+	domain string
+	ok     bool
+}{
+	{"com", false},
+	{"example.com", false},
+	{"uk.com", true},
+	{"co.uk", true},
+	{"bl.uk", false},
+	{"google.co.uk", false},
+	{"kyoto.jp", true},
+	{"ide.kyoto.jp", false},
+	{"example.example", false},
+}
+
+func TestIsSecondLevelSuffix(t *testing.T) {
+	for i, tt := range secondLevelSuffixTests {
+		if got := isSecondLevelSuffix(tt.domain); got != tt.ok {
+			t.Errorf("#%d %q: got %t, want %t", i, tt.domain, got, tt.ok)
+		}
+	}
+}
+
+// findLabel looks up the node with label in nodes.
+func findLabel(label string, nodes []Node) *Node {
+	N := len(nodes)
+	if N == 0 {
+		return nil
+	}
+
+	// Fibonacci search
+	// k, M := T[N].k, T[N].M
+	k := 0
+	for ; fibonacci[k] <= N; k++ {
+	}
+	k--
+	M := fibonacci[k+1] - N - 1
+	i, p, q := fibonacci[k]-1, fibonacci[k-1], fibonacci[k-2]
+
+	if label > nodes[i].Label {
+		i -= M
+		if p == 1 {
+			return nil
+		}
+		i += q
+		p -= q
+		q -= p
+	}
+
+	for {
+		if label == nodes[i].Label {
+			return &nodes[i]
+		}
+		if label < nodes[i].Label {
+			if q == 0 {
+				return nil
+			}
+			i -= q
+			p, q = q, p-q
+		} else {
+			if p == 1 {
+				return nil
+			}
+			i += q
+			p -= q
+			q -= p
+		}
+	}
+	panic("not reached")
+}
+
+// treeEffectiveTLDPlusOne is the implementation of EffectiveTLDPlusOne on
+// the tree referenceSuffixes.  It serves as reference for the compact
+// suffixTable.
+func treeEffectiveTLDPlusOne(domain string) (ret string) {
+	parts := strings.Split(domain, ".")
+	m := len(parts)
+	nodes := referenceSuffixes.Sub
+	var np *Node
+	for m > 0 {
+		m--
+		sub := findLabel(parts[m], nodes)
+		if sub == nil {
+			m++
+			break
+		}
+		nodes = sub.Sub
+		np = sub
+		if np.Kind == Exception {
+			break // an exception rule always prevails
+		}
+	}
+	// np now points to last matching node
+
+	if np == nil || np.Kind == None {
+		// no rule found, default is "*"
+		if len(parts) == 2 {
+			return domain
+		} else if len(parts) > 2 {
+			i := len(parts) - 1
+			return parts[i-1] + "." + parts[i]
+		} else {
+			return ""
+		}
+	}
+
+	switch np.Kind {
+	case Normal:
+		m--
+	case Exception:
+	case Wildcard:
+		m -= 2
+	}
+	if m < 0 {
+		return ""
+	}
+	return strings.Join(parts[m:], ".")
+}
+
+func TestSuffixTable(t *testing.T) {
+	domains := make([]string, 0, len(effectiveTLDPlusOneTests)+len(unlistedDomains))
+	for _, tt := range effectiveTLDPlusOneTests {
+		domains = append(domains, tt.domain)
+	}
+	domains = append(domains, unlistedDomains...)
+	domains = append(domains, "", ".", "a..b", "com.", ".com", "x.y.z.k12.ak.us")
+	for _, tt := range allowDomainCookiesTests {
+		domains = append(domains, tt.domain)
+	}
+
+	for i, domain := range domains {
+		got := suffixes.effectiveTLDPlusOne(domain)
+		want := treeEffectiveTLDPlusOne(domain)
+		if got != want {
+			t.Errorf("%d. domain=%q: got %q, want %q.", i, domain, got, want)
+		}
+	}
+}
+
+func BenchmarkTreeEffectiveTLDPlusOne(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, domain := range unlistedDomains {
+			treeEffectiveTLDPlusOne(domain)
+		}
+	}
+}
+
+func BenchmarkTableEffectiveTLDPlusOne(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, domain := range unlistedDomains {
+			suffixes.effectiveTLDPlusOne(domain)
+		}
+	}
+}
+
+// overlappingRules contains the rules "tld", "*.a.tld", "!x.a.tld" and
+// "y.x.a.tld" where the exception must prevail over the longer rule.
+var overlappingRules = Node{"", None, []Node{
+	{"tld", Normal, []Node{
+		{"a", Wildcard, []Node{
+			{"x", Exception, []Node{
+				{"y", Normal, nil},
+			}},
+		}},
+		{"b", Normal, nil},
+	}},
+}}
+
+var overlappingRulesTests = []struct {
+	domain string
+	etldp1 string
+}{
+	{"tld", ""},
+	{"foo.tld", "foo.tld"},
+	{"a.tld", ""},
+	{"z.a.tld", ""},
+	{"www.z.a.tld", "www.z.a.tld"},
+	{"x.a.tld", "x.a.tld"},
+	{"www.x.a.tld", "x.a.tld"},
+	{"y.x.a.tld", "x.a.tld"},
+	{"www.y.x.a.tld", "x.a.tld"},
+	{"b.tld", ""},
+	{"www.b.tld", "www.b.tld"},
+}
+
+func TestExceptionPrevails(t *testing.T) {
+	table := newSuffixTable(&overlappingRules)
+	for i, tt := range overlappingRulesTests {
+		if got := table.effectiveTLDPlusOne(tt.domain); got != tt.etldp1 {
+			t.Errorf("%d. domain=%q: got %q, want %q.", i, tt.domain, got, tt.etldp1)
+		}
+	}
+}
+
+// checkSorted checks the invariant the lookup relies on: the sub nodes
+// of every node are sorted strictly ascending by label.
+func checkSorted(t *testing.T, n *Node, path string) {
+	for i := range n.Sub {
+		if i > 0 && n.Sub[i-1].Label >= n.Sub[i].Label {
+			t.Errorf("Unsorted labels %q, %q below %q",
+				n.Sub[i-1].Label, n.Sub[i].Label, path)
+		}
+		checkSorted(t, &n.Sub[i], n.Sub[i].Label+"."+path)
+	}
+}
+
+func TestPublicSuffixesSorted(t *testing.T) {
+	checkSorted(t, &referenceSuffixes, "")
+}
+
+// checkTableSorted checks the same invariant on a suffixTable.
+func checkTableSorted(t *testing.T, table *suffixTable) {
+	for i := range table.kind {
+		first, count := int(table.first[i]), int(table.count[i])
+		for j := first + 1; j < first+count; j++ {
+			if table.label(j-1) >= table.label(j) {
+				t.Errorf("Unsorted labels %q, %q below node %d",
+					table.label(j-1), table.label(j), i)
+			}
+		}
+	}
+}
+
+// The table generated by maketable.go is the one newSuffixTable builds.
+func TestGeneratedTable(t *testing.T) {
+	checkTableSorted(t, suffixes)
+	if !reflect.DeepEqual(suffixes, newSuffixTable(&referenceSuffixes)) {
+		t.Errorf("Generated table differs from flattened reference tree")
+	}
+}
+
+const customSuffixList = `// a custom list
+// VERSION: 2026-10-01
+
+com
+co.uk
+*.kobe.jp
+!city.kobe.jp
+newgtld  // a comment
+`
+
+func TestLoadPublicSuffixList(t *testing.T) {
+	builtin := PublicSuffixListInfo()
+	if !builtin.Builtin || builtin.Rules < 1000 || builtin.Version != publicSuffixListDate {
+		t.Errorf("Unexpected info for compiled in list: %+v", builtin)
+	}
+
+	defer setPublicSuffixes(publicSuffixList())
+
+	if err := LoadPublicSuffixList(strings.NewReader(customSuffixList)); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	got := NewJar(false).PublicSuffixListInfo()
+	want := SuffixListInfo{Rules: 5, Version: "2026-10-01"}
+	if got != want {
+		t.Errorf("Got %+v, want %+v", got, want)
+	}
+	checkTableSorted(t, publicSuffixList().table)
+	for _, tt := range []struct{ domain, etldp1 string }{
+		{"www.example.newgtld", "example.newgtld"},
+		{"www.bbc.co.uk", "bbc.co.uk"},
+		{"a.b.c.kobe.jp", "b.c.kobe.jp"},
+		{"www.city.kobe.jp", "city.kobe.jp"},
+		{"newgtld", ""},
+	} {
+		if e := EffectiveTLDPlusOne(tt.domain); e != tt.etldp1 {
+			t.Errorf("%q: Got %q, want %q", tt.domain, e, tt.etldp1)
+		}
+	}
+
+	if err := LoadPublicSuffixList(strings.NewReader("*.a.*.b\n")); err == nil {
+		t.Errorf("Complex wildcard accepted")
+	}
+}
+
+// LoadPublicSuffixList may be called while jars are in use.  Run with
+// -race to detect unsynchronized access to the list.
+func TestLoadPublicSuffixListConcurrently(t *testing.T) {
+	defer setPublicSuffixes(publicSuffixList())
+
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 20; i++ {
+			if err := LoadPublicSuffixList(strings.NewReader(customSuffixList)); err != nil {
+				t.Errorf("Unexpected error %v", err)
+			}
+		}
+		done <- true
+	}()
+	jar := NewJar(true)
+	u := URL("http://www.bbc.co.uk")
+	for i := 0; i < 200; i++ {
+		jar.SetCookies(u, []*http.Cookie{parseCookie("a=1; domain=bbc.co.uk")})
+		if got := stringRep(jar.Cookies(u)); got != "a=1" {
+			t.Fatalf("Got %q, want %q", got, "a=1")
+		}
+	}
+	<-done
+	if PublicSuffixListInfo().Version != "2026-10-01" {
+		t.Errorf("Loaded list not in use: %+v", PublicSuffixListInfo())
+	}
+}
+
+// deepDomain has 100 labels below www.example.co.uk.
+var deepDomain = strings.Repeat("a.", 100) + "www.example.co.uk"
+
+func TestDeepDomain(t *testing.T) {
+	for _, domain := range []string{deepDomain, strings.Repeat("a.", 100) + "b.c.kobe.jp"} {
+		shallow := domain[strings.LastIndex(domain, "a.")+2:]
+		if got, want := EffectiveTLDPlusOne(domain), EffectiveTLDPlusOne(shallow); got != want {
+			t.Errorf("%q: got %q, want %q like %q", domain, got, want, shallow)
+		}
+		if got, want := treeEffectiveTLDPlusOne(domain), EffectiveTLDPlusOne(domain); got != want {
+			t.Errorf("%q: tree got %q, want %q", domain, got, want)
+		}
+	}
+}
+
+func BenchmarkDeepEffectiveTLDPlusOne(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		EffectiveTLDPlusOne(deepDomain)
+	}
+}
+
+// TestRejectPublicSuffixDomainCookies checks end to end that domain cookies
+// on public suffixes below the second level are rejected unless
+// DomainCookiesOnPublicSuffixes is set.
+func TestRejectPublicSuffixDomainCookies(t *testing.T) {
+	for _, allow := range []bool{false, true} {
+		jar := NewJar(false)
+		jar.DomainCookiesOnPublicSuffixes = allow
+		u := URL("http://foo.bar.kawasaki.jp/")
+		cookies := []*http.Cookie{
+			parseCookie("a=1; domain=bar.kawasaki.jp"),     // public suffix by wildcard
+			parseCookie("b=2; domain=city.kawasaki.jp"),    // not matching at all
+			parseCookie("c=3; domain=foo.bar.kawasaki.jp"), // registrable domain
+		}
+		rejected := jar.WouldReject(u, cookies)
+		jar.SetCookies(u, cookies)
+		wantRejected, want := 2, "c=3"
+		if allow {
+			wantRejected, want = 1, "a=1 c=3"
+		}
+		if len(rejected) != wantRejected {
+			t.Errorf("allow=%t: %d rejected, want %d", allow, len(rejected), wantRejected)
+		}
+		if got := stringRep(jar.Cookies(URL("http://www.foo.bar.kawasaki.jp/"))); got != want {
+			t.Errorf("allow=%t: Got %q, want %q", allow, got, want)
+		}
+	}
+}
+
+// TestUnlistedTLD checks that the default rule "*" applies to a TLD
+// missing from the list, e.g. because the list is older than the TLD.
+func TestUnlistedTLD(t *testing.T) {
+	for _, tt := range []struct {
+		domain, etldp1 string
+		allow          bool
+	}{
+		{"zzunlisted", "", false},
+		{"example.zzunlisted", "example.zzunlisted", true},
+		{"www.example.zzunlisted", "example.zzunlisted", true},
+	} {
+		if got := EffectiveTLDPlusOne(tt.domain); got != tt.etldp1 {
+			t.Errorf("EffectiveTLDPlusOne(%q)=%q, want %q", tt.domain, got, tt.etldp1)
+		}
+		if got := allowDomainCookies(tt.domain); got != tt.allow {
+			t.Errorf("allowDomainCookies(%q)=%t, want %t", tt.domain, got, tt.allow)
+		}
+	}
+
+	for _, boxed := range []bool{true, false} {
+		jar := NewJar(boxed)
+		jar.SetCookies(URL("http://www.example.zzunlisted/"), []*http.Cookie{
+			parseCookie("a=1; domain=example.zzunlisted"),
+			parseCookie("b=2; domain=www.example.zzunlisted"),
+			parseCookie("c=3; domain=zzunlisted"),
+		})
+		jar.SetCookies(URL("http://zzunlisted/"), []*http.Cookie{parseCookie("d=4")})
+		for _, q := range []struct{ url, want string }{
+			{"http://www.example.zzunlisted", "a=1 b=2"},
+			{"http://other.example.zzunlisted", "a=1"},
+			{"http://zzunlisted", "d=4"},
+			{"http://other.zzunlisted", ""},
+		} {
+			if got := stringRep(jar.Cookies(URL(q.url))); got != q.want {
+				t.Errorf("Boxed=%t %s: Got %q, want %q", boxed, q.url, got, q.want)
+			}
+		}
+	}
+}
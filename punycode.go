@@ -0,0 +1,173 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+// Punycode encoding of internationalized domain names.
+// See RFC 3492 and RFC 5891 for details.
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// The parameters of Punycode (see RFC 3492 section 5).
+const (
+	pcBase        = 36
+	pcTMin        = 1
+	pcTMax        = 26
+	pcSkew        = 38
+	pcDamp        = 700
+	pcInitialBias = 72
+	pcInitialN    = 128
+)
+
+var (
+	errPunycodeOverflow = errors.New("Punycode overflow")
+	errInvalidUTF8      = errors.New("Domain name is not valid UTF-8")
+)
+
+// punycodeToASCII converts a domain name to its ASCII (Punycode) form.
+// A domain name which is pure ASCII is left unaltered.  Otherwise it is
+// mapped and its labels validated as described in idna.go; labels which
+// are ASCII after the mapping are kept, all other labels are converted
+// to an "xn--" prefixed Punycode label.  ASCII s must be lower case.
+func punycodeToASCII(s string) (string, error) {
+	if isASCII(s) {
+		return s, nil // the common case
+	}
+	if !utf8.ValidString(s) {
+		return "", errInvalidUTF8
+	}
+	labels := strings.Split(idnaMap(s), ".")
+	for i, label := range labels {
+		if isASCII(label) {
+			if !hostLabelOK(label) {
+				return "", errDisallowedRune // e.g. a mapped space
+			}
+			continue
+		}
+		if err := idnaValidate(label); err != nil {
+			return "", err
+		}
+		encoded, err := punycodeEncode(label)
+		if err != nil {
+			return "", err
+		}
+		labels[i] = "xn--" + encoded
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// hostLabelOK checks that the ASCII label contains only letters, digits,
+// hyphens and underscores.
+func hostLabelOK(label string) bool {
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// isASCII checks whether s contains only ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// punycodeEncode implements the encoding procedure of RFC 3492 section 6.3.
+func punycodeEncode(s string) (string, error) {
+	output := make([]byte, 0, len(s)+8)
+	total := 0
+	for _, r := range s {
+		total++
+		if r < utf8.RuneSelf {
+			output = append(output, byte(r))
+		}
+	}
+	basic := len(output)
+	if basic > 0 {
+		output = append(output, '-')
+	}
+
+	n, delta, bias := rune(pcInitialN), 0, pcInitialBias
+	for h := basic; h < total; n++ {
+		// find the smallest code point >= n
+		m := rune(utf8.MaxRune + 1)
+		for _, r := range s {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+		delta += int(m-n) * (h + 1)
+		if delta < 0 {
+			return "", errPunycodeOverflow
+		}
+		n = m
+
+		for _, r := range s {
+			if r < n {
+				delta++
+				if delta < 0 {
+					return "", errPunycodeOverflow
+				}
+				continue
+			}
+			if r > n {
+				continue
+			}
+			q := delta
+			for k := pcBase; ; k += pcBase {
+				t := k - bias
+				if t < pcTMin {
+					t = pcTMin
+				} else if t > pcTMax {
+					t = pcTMax
+				}
+				if q < t {
+					break
+				}
+				output = append(output, punycodeDigit(t+(q-t)%(pcBase-t)))
+				q = (q - t) / (pcBase - t)
+			}
+			output = append(output, punycodeDigit(q))
+			bias = punycodeAdapt(delta, h+1, h == basic)
+			delta = 0
+			h++
+		}
+		delta++
+	}
+	return string(output), nil
+}
+
+// punycodeAdapt is the bias adaption function of RFC 3492 section 6.1.
+func punycodeAdapt(delta, numPoints int, firstTime bool) int {
+	if firstTime {
+		delta /= pcDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := 0
+	for delta > ((pcBase-pcTMin)*pcTMax)/2 {
+		delta /= pcBase - pcTMin
+		k += pcBase
+	}
+	return k + (pcBase-pcTMin+1)*delta/(delta+pcSkew)
+}
+
+// punycodeDigit encodes the digit d (0 <= d < 36) as "a"-"z" or "0"-"9".
+func punycodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
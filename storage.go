@@ -5,7 +5,11 @@
 package cookiejar
 
 import (
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"time"
+	"unsafe"
 )
 
 var _ = fmt.Printf
@@ -15,9 +19,42 @@ var _ = fmt.Printf
 
 // storage is the interface of a cookie monster.
 type storage interface {
-	retrieve(https bool, host, path string) []*Cookie
-	find(domain, path, name string) *Cookie
-	delete(domain, path, name string) bool
+	retrieve(https bool, host, path string, now time.Time) []*Cookie
+	contains(https bool, host, path string) bool
+	domain(domain string) []*Cookie
+	related(domain string) []*Cookie
+	all() []*Cookie
+	expired(now time.Time) []*Cookie
+	empty() storage
+	peek(domain, path, name, scheme string, now time.Time) (*Cookie, bool)
+	find(domain, path, name, scheme string) *Cookie
+	delete(domain, path, name, scheme string) bool
+	deleteFunc(match func(*Cookie) bool) int
+	stats(s *StorageStats)
+	compact()
+	check() error
+}
+
+var (
+	errNilCookie       = errors.New("Storage contains a nil cookie")
+	errEmptyName       = errors.New("Storage contains a live cookie without name")
+	errDuplicateCookie = errors.New("Storage contains a cookie twice")
+	errWrongBox        = errors.New("Cookie stored in wrong box")
+	errWrongShard      = errors.New("Box stored in wrong shard")
+	errCountMismatch   = errors.New("Cached cookie count does not match storage")
+)
+
+// StorageStats describes the memory usage of the storage of a Jar.
+type StorageStats struct {
+	Cookies  int            // number of live (non-expired) cookies
+	Expired  int            // number of expired cookies still stored (reusable)
+	Capacity int            // total number of cookie slots allocated
+	Boxes    map[string]int // number of stored cookies per box (boxed storage only)
+	Bytes    int64          // estimated heap usage in bytes, see Jar.ApproxMemoryBytes
+
+	// VetoedEvictions counts how often a limit was exceeded because
+	// Jar.EvictionVeto protected all candidates for eviction.
+	VetoedEvictions int
 }
 
 // -------------------------------------------------------------------------
@@ -28,17 +65,18 @@ type storage interface {
 // linearely any time we look for a cookie
 type flat []*Cookie
 
-// retrieve fetches the unsorted list of cookies to be sent
-func (f *flat) retrieve(https bool, host, path string) []*Cookie {
+// retrieve fetches the unsorted list of cookies to be sent which are not
+// expired at time now.  Only cookies expired by the current time are
+// cleaned up.
+func (f *flat) retrieve(https bool, host, path string, now time.Time) []*Cookie {
 	selection := make([]*Cookie, 0)
 	expired := 0
 	for _, cookie := range *f {
 		if cookie.Expired() {
 			expired++
-		} else {
-			if cookie.shouldSend(https, host, path) {
-				selection = append(selection, cookie)
-			}
+		}
+		if !cookie.expiredAt(now) && cookie.shouldSend(https, host, path) {
+			selection = append(selection, cookie)
 		}
 	}
 
@@ -49,15 +87,88 @@ func (f *flat) retrieve(https bool, host, path string) []*Cookie {
 	return selection
 }
 
-// find looks up the cookie <domain,path,name> or returns a "new" cookie
-// (which might be the reuse of an existing but expired one).
-func (f *flat) find(domain, path, name string) *Cookie {
+// contains reports whether at least one cookie would be retrieved.
+func (f *flat) contains(https bool, host, path string) bool {
+	for _, cookie := range *f {
+		if !cookie.Expired() && cookie.shouldSend(https, host, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// domain fetches all non-expired cookies whose Domain is exactly domain.
+func (f *flat) domain(domain string) []*Cookie {
+	selection := make([]*Cookie, 0)
+	for _, cookie := range *f {
+		if cookie.Domain == domain && !cookie.Expired() {
+			selection = append(selection, cookie)
+		}
+	}
+	return selection
+}
+
+// related fetches all non-expired cookies which may share the registrable
+// domain of domain.  Flat storage cannot tell and returns all cookies.
+func (f *flat) related(domain string) []*Cookie {
+	return f.all()
+}
+
+// all fetches all non-expired cookies.
+func (f *flat) all() []*Cookie {
+	selection := make([]*Cookie, 0, len(*f))
+	for _, cookie := range *f {
+		if !cookie.Expired() {
+			selection = append(selection, cookie)
+		}
+	}
+	return selection
+}
+
+// empty returns a new empty flat storage.
+func (f *flat) empty() storage {
+	tmp := make(flat, 0, 16)
+	return &tmp
+}
+
+// expired returns all cookies in f which are expired at time now.
+func (f *flat) expired(now time.Time) []*Cookie {
+	var selection []*Cookie
+	for _, cookie := range *f {
+		if cookie.expiredAt(now) {
+			selection = append(selection, cookie)
+		}
+	}
+	return selection
+}
+
+// peek looks up the cookie <domain,path,name,scheme> which is not expired
+// at time now.  Unlike find it never modifies f.
+func (f *flat) peek(domain, path, name, scheme string, now time.Time) (*Cookie, bool) {
+	for _, cookie := range *f {
+		if domain == cookie.Domain &&
+			path == cookie.Path &&
+			name == cookie.Name &&
+			scheme == cookie.Scheme {
+			if cookie.expiredAt(now) {
+				return nil, false
+			}
+			return cookie, true
+		}
+	}
+	return nil, false
+}
+
+// find looks up the cookie <domain,path,name,scheme> or returns a "new"
+// cookie (which might be the reuse of an existing but expired one).
+func (f *flat) find(domain, path, name, scheme string) *Cookie {
 	expiredIdx := -1
 	for i, cookie := range *f {
 		// see if the cookie is there
 		if domain == cookie.Domain &&
 			path == cookie.Path &&
-			name == cookie.Name {
+			name == cookie.Name &&
+			scheme == cookie.Scheme {
 			return cookie
 		}
 
@@ -81,9 +192,9 @@ func (f *flat) find(domain, path, name string) *Cookie {
 	return cookie
 }
 
-// delete the cookie <domain,path,name> from the storage. Returns true if the
-// cookie was present in the jar.
-func (f *flat) delete(domain, path, name string) bool {
+// delete the cookie <domain,path,name,scheme> from the storage. Returns true
+// if the cookie was present in the jar.
+func (f *flat) delete(domain, path, name, scheme string) bool {
 	n := len(*f)
 	if n == 0 {
 		return false
@@ -91,7 +202,8 @@ func (f *flat) delete(domain, path, name string) bool {
 	for i := range *f {
 		if domain == (*f)[i].Domain &&
 			path == (*f)[i].Path &&
-			name == (*f)[i].Name {
+			name == (*f)[i].Name &&
+			scheme == (*f)[i].Scheme {
 			if i < n-1 {
 				(*f)[i] = (*f)[n-1]
 			}
@@ -102,6 +214,79 @@ func (f *flat) delete(domain, path, name string) bool {
 	return false
 }
 
+// deleteFunc deletes all cookies for which match returns true and returns
+// the number of deleted cookies.
+func (f *flat) deleteFunc(match func(*Cookie) bool) int {
+	n := 0
+	for _, cookie := range *f {
+		if !match(cookie) {
+			(*f)[n] = cookie
+			n++
+		}
+	}
+	deleted := len(*f) - n
+	for i := n; i < len(*f); i++ {
+		(*f)[i] = nil
+	}
+	*f = (*f)[:n]
+	return deleted
+}
+
+// stats adds the statistics of f to s.
+func (f *flat) stats(s *StorageStats) {
+	for _, cookie := range *f {
+		if cookie.Expired() {
+			s.Expired++
+		} else {
+			s.Cookies++
+		}
+		s.Bytes += cookieBytes + int64(len(cookie.Name)+len(cookie.Value)+
+			len(cookie.Domain)+len(cookie.Path)+len(cookie.Source)+len(cookie.Scheme))
+	}
+	s.Capacity += cap(*f)
+	s.Bytes += flatBytes + int64(cap(*f))*pointerBytes
+}
+
+// Sizes used to estimate the memory usage of storage.  The overhead of a
+// map entry is a rough guess.
+const (
+	cookieBytes   = int64(unsafe.Sizeof(Cookie{}))
+	flatBytes     = int64(unsafe.Sizeof(flat{}))
+	pointerBytes  = int64(unsafe.Sizeof(&Cookie{}))
+	boxEntryBytes = 48
+)
+
+// compact drops expired cookies and shrinks f to the minimal size.
+func (f *flat) compact() {
+	live := make(flat, 0, len(*f))
+	for _, cookie := range *f {
+		if !cookie.Expired() {
+			live = append(live, cookie)
+		}
+	}
+	*f = append(make(flat, 0, len(live)), live...)
+}
+
+// check verifies that f contains neither nil nor live unnamed cookies and
+// no two cookies with the same domain, path, name and scheme.
+func (f *flat) check() error {
+	seen := make(map[cookieKey]bool, len(*f))
+	for _, cookie := range *f {
+		if cookie == nil {
+			return errNilCookie
+		}
+		if cookie.Name == "" && !cookie.Expired() {
+			return errEmptyName
+		}
+		key := keyOf(cookie)
+		if seen[key] {
+			return errDuplicateCookie
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
 // cleanup removes expired cookies from f
 func (f *flat) cleanup(num int) {
 	// corner cases
@@ -146,45 +331,269 @@ func (f *flat) cleanup(num int) {
 // boxed is a storage grouped by domain.
 type boxed map[string]*flat
 
+// boxKey returns the box a cookie for host is stored in: The effective
+// TLD plus one of host or host itself if there is none or host is an IP
+// address.
+func boxKey(host string) string {
+	if isIP(host) {
+		return host
+	}
+	if box := EffectiveTLDPlusOne(host); box != "" {
+		return box
+	}
+	return host
+}
+
 // return the proper flat for host or nil if non present
 func (b *boxed) flat(host string) *flat {
-	box := EffectiveTLDPlusOne(host)
-	if box == "" {
-		box = host
-	}
-	return (*b)[box]
+	return (*b)[boxKey(host)]
 }
 
 // retrieve fetches the unsorted list of cookies to be sent
-func (b *boxed) retrieve(https bool, host, path string) []*Cookie {
+func (b *boxed) retrieve(https bool, host, path string, now time.Time) []*Cookie {
 	if flat := b.flat(host); flat != nil {
-		return flat.retrieve(https, host, path)
+		return flat.retrieve(https, host, path, now)
 	}
 	return nil
 }
 
-// find looks up the cookie <domain,path,name> or returns a "new" cookie
-// (which might be the reuse of an existing but expired one).
-func (b *boxed) find(domain, path, name string) *Cookie {
+// contains reports whether at least one cookie would be retrieved.
+func (b *boxed) contains(https bool, host, path string) bool {
+	if flat := b.flat(host); flat != nil {
+		return flat.contains(https, host, path)
+	}
+	return false
+}
+
+// domain fetches all non-expired cookies whose Domain is exactly domain.
+func (b *boxed) domain(domain string) []*Cookie {
 	if flat := b.flat(domain); flat != nil {
-		return flat.find(domain, path, name)
+		return flat.domain(domain)
 	}
+	return nil
+}
 
-	f := make(flat, 1)
-	box := EffectiveTLDPlusOne(domain)
-	if box == "" {
-		box = domain
+// related fetches all non-expired cookies in the box of domain.
+func (b *boxed) related(domain string) []*Cookie {
+	if flat := b.flat(domain); flat != nil {
+		return flat.all()
 	}
+	return nil
+}
+
+// all fetches all non-expired cookies.
+func (b *boxed) all() []*Cookie {
+	selection := make([]*Cookie, 0, 32)
+	for _, flat := range *b {
+		selection = append(selection, flat.all()...)
+	}
+	return selection
+}
+
+// empty returns a new empty boxed storage.
+func (b *boxed) empty() storage {
+	tmp := make(boxed)
+	return &tmp
+}
+
+// expired returns all cookies in b which are expired at time now.
+func (b *boxed) expired(now time.Time) []*Cookie {
+	var selection []*Cookie
+	for _, flat := range *b {
+		selection = append(selection, flat.expired(now)...)
+	}
+	return selection
+}
+
+// peek looks up the cookie <domain,path,name,scheme> which is not expired
+// at time now.  Unlike find it never creates a box.
+func (b *boxed) peek(domain, path, name, scheme string, now time.Time) (*Cookie, bool) {
+	if flat := b.flat(domain); flat != nil {
+		return flat.peek(domain, path, name, scheme, now)
+	}
+	return nil, false
+}
+
+// find looks up the cookie <domain,path,name,scheme> or returns a "new"
+// cookie (which might be the reuse of an existing but expired one).
+func (b *boxed) find(domain, path, name, scheme string) *Cookie {
+	if flat := b.flat(domain); flat != nil {
+		return flat.find(domain, path, name, scheme)
+	}
+
+	f := make(flat, 1)
 	f[0] = &Cookie{}
-	(*b)[box] = &f
+	(*b)[boxKey(domain)] = &f
 	return f[0]
 }
 
-// delete the cookie <domain,path,name> from the storage. Returns true if the
-// cookie was present in the jar.
-func (b *boxed) delete(domain, path, name string) bool {
+// delete the cookie <domain,path,name,scheme> from the storage. Returns true
+// if the cookie was present in the jar.
+func (b *boxed) delete(domain, path, name, scheme string) bool {
 	if flat := b.flat(domain); flat != nil {
-		return flat.delete(domain, path, name)
+		return flat.delete(domain, path, name, scheme)
 	}
 	return false
 }
+
+// deleteFunc deletes all cookies for which match returns true and returns
+// the number of deleted cookies.  Empty boxes are removed.
+func (b *boxed) deleteFunc(match func(*Cookie) bool) int {
+	deleted := 0
+	for box, flat := range *b {
+		deleted += flat.deleteFunc(match)
+		if len(*flat) == 0 {
+			delete(*b, box)
+		}
+	}
+	return deleted
+}
+
+// stats adds the statistics of b to s.
+func (b *boxed) stats(s *StorageStats) {
+	if s.Boxes == nil {
+		s.Boxes = make(map[string]int, len(*b))
+	}
+	for box, flat := range *b {
+		flat.stats(s)
+		s.Boxes[box] = len(*flat)
+		s.Bytes += boxEntryBytes + int64(len(box))
+	}
+}
+
+// compact compacts all boxes and removes empty ones.
+func (b *boxed) compact() {
+	for box, flat := range *b {
+		flat.compact()
+		if len(*flat) == 0 {
+			delete(*b, box)
+		}
+	}
+}
+
+// check verifies that each cookie is in the box of its domain and checks
+// the boxes like flat.check.  Different boxes cannot contain the same
+// cookie if all cookies are in their box.
+func (b *boxed) check() error {
+	for box, flat := range *b {
+		if flat == nil {
+			return errNilCookie
+		}
+		if err := flat.check(); err != nil {
+			return err
+		}
+		for _, cookie := range *flat {
+			if boxKey(cookie.Domain) != box {
+				return errWrongBox
+			}
+		}
+	}
+	return nil
+}
+
+// -------------------------------------------------------------------------
+// Sharded
+
+// sharded is a boxed storage whose boxes are spread over several maps by
+// a hash of the box key.  It behaves exactly like boxed but keeps each
+// map small for jars with a huge number of domains.
+type sharded []boxed
+
+// newSharded sets up an empty sharded storage with n shards.
+func newSharded(n int) *sharded {
+	s := make(sharded, n)
+	for i := range s {
+		s[i] = make(boxed)
+	}
+	return &s
+}
+
+// shard returns the boxed storage holding the box for host.
+func (s *sharded) shard(host string) *boxed {
+	h := fnv.New32a()
+	h.Write([]byte(boxKey(host)))
+	return &(*s)[h.Sum32()%uint32(len(*s))]
+}
+
+func (s *sharded) retrieve(https bool, host, path string, now time.Time) []*Cookie {
+	return s.shard(host).retrieve(https, host, path, now)
+}
+
+func (s *sharded) contains(https bool, host, path string) bool {
+	return s.shard(host).contains(https, host, path)
+}
+
+func (s *sharded) domain(domain string) []*Cookie {
+	return s.shard(domain).domain(domain)
+}
+
+func (s *sharded) related(domain string) []*Cookie {
+	return s.shard(domain).related(domain)
+}
+
+func (s *sharded) all() []*Cookie {
+	selection := make([]*Cookie, 0, 32)
+	for i := range *s {
+		selection = append(selection, (*s)[i].all()...)
+	}
+	return selection
+}
+
+func (s *sharded) expired(now time.Time) []*Cookie {
+	var selection []*Cookie
+	for i := range *s {
+		selection = append(selection, (*s)[i].expired(now)...)
+	}
+	return selection
+}
+
+// empty returns a new empty sharded storage with as many shards as s.
+func (s *sharded) empty() storage {
+	return newSharded(len(*s))
+}
+
+func (s *sharded) peek(domain, path, name, scheme string, now time.Time) (*Cookie, bool) {
+	return s.shard(domain).peek(domain, path, name, scheme, now)
+}
+
+func (s *sharded) find(domain, path, name, scheme string) *Cookie {
+	return s.shard(domain).find(domain, path, name, scheme)
+}
+
+func (s *sharded) delete(domain, path, name, scheme string) bool {
+	return s.shard(domain).delete(domain, path, name, scheme)
+}
+
+func (s *sharded) deleteFunc(match func(*Cookie) bool) int {
+	deleted := 0
+	for i := range *s {
+		deleted += (*s)[i].deleteFunc(match)
+	}
+	return deleted
+}
+
+func (s *sharded) stats(st *StorageStats) {
+	for i := range *s {
+		(*s)[i].stats(st)
+	}
+}
+
+func (s *sharded) compact() {
+	for i := range *s {
+		(*s)[i].compact()
+	}
+}
+
+func (s *sharded) check() error {
+	for i := range *s {
+		for box := range (*s)[i] {
+			if s.shard(box) != &(*s)[i] {
+				return errWrongShard
+			}
+		}
+		if err := (*s)[i].check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+// Loading the public suffix list at runtime.
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// SuffixListInfo describes the public suffix list in use.
+type SuffixListInfo struct {
+	Rules   int    // number of rules
+	Version string // date of generation or VERSION of a loaded list
+	Builtin bool   // true for the list compiled into the package
+}
+
+// A suffixList is a public suffix list together with its description.
+// It is never modified once it is in use: LoadPublicSuffixList publishes
+// a new one instead.
+type suffixList struct {
+	table *suffixTable
+	info  SuffixListInfo
+}
+
+// builtinList is the list compiled into the package.
+var builtinList = &suffixList{
+	table: suffixes,
+	info: SuffixListInfo{
+		Rules:   suffixes.rules(),
+		Version: publicSuffixListDate,
+		Builtin: true,
+	},
+}
+
+// loadedList holds the *suffixList set by setPublicSuffixes, if any.
+var loadedList atomic.Value
+
+// publicSuffixList returns the public suffix list in use.  It is safe to
+// call concurrently with LoadPublicSuffixList.
+func publicSuffixList() *suffixList {
+	if list, ok := loadedList.Load().(*suffixList); ok {
+		return list
+	}
+	return builtinList
+}
+
+var errComplexWildcard = errors.New("Cannot handle complex wildcard rule")
+
+// PublicSuffixListInfo reports the number of rules and the version of the
+// public suffix list in use.  An outdated list may reject cookies for
+// recently introduced TLDs.
+func PublicSuffixListInfo() SuffixListInfo {
+	return publicSuffixList().info
+}
+
+// PublicSuffixListInfo reports the public suffix list used by jar.
+func (jar *Jar) PublicSuffixListInfo() SuffixListInfo {
+	return PublicSuffixListInfo()
+}
+
+// LoadPublicSuffixList replaces the compiled in public suffix list by the
+// list read from r in the format of http://publicsuffix.org/list/.
+// The version is taken from a "// VERSION: " comment if present.
+// The list is used by all jars; it may be replaced while jars are in use,
+// each lookup sees either the old or the new list.
+func LoadPublicSuffixList(r io.Reader) error {
+	root := Node{"", None, nil}
+	info := SuffixListInfo{Version: "unknown"}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "// VERSION: ") {
+			info.Version = strings.TrimSpace(line[len("// VERSION: "):])
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		// a rule is the first field of the line
+		if i := strings.IndexAny(line, " \t"); i != -1 {
+			line = line[:i]
+		}
+		if err := insertRule(&root, strings.ToLower(line)); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	sortNodes(&root)
+	table := newSuffixTable(&root)
+	info.Rules = table.rules()
+	setPublicSuffixes(&suffixList{table: table, info: info})
+	return nil
+}
+
+// setPublicSuffixes makes list the public suffix list in use.
+func setPublicSuffixes(list *suffixList) {
+	loadedList.Store(list)
+}
+
+// insertRule adds a rule like "co.uk", "*.kobe.jp" or "!city.kobe.jp" to
+// the tree rooted at root.  Like maketable.go a wildcard rule "*.a.b" is
+// stored as node "a" of kind Wildcard.
+func insertRule(root *Node, rule string) error {
+	kind := Normal
+	switch {
+	case strings.HasPrefix(rule, "!"):
+		kind, rule = Exception, rule[1:]
+	case strings.HasPrefix(rule, "*."):
+		kind, rule = Wildcard, rule[2:]
+	}
+	if rule == "" || strings.Contains(rule, "*") || strings.Contains(rule, "!") {
+		return errComplexWildcard
+	}
+
+	labels := strings.Split(rule, ".")
+	node := root
+	for i := len(labels) - 1; i >= 0; i-- {
+		label, err := punycodeToASCII(labels[i])
+		if err != nil {
+			return err
+		}
+		var sub *Node
+		for j := range node.Sub {
+			if node.Sub[j].Label == label {
+				sub = &node.Sub[j]
+				break
+			}
+		}
+		if sub == nil {
+			node.Sub = append(node.Sub, Node{label, None, nil})
+			sub = &node.Sub[len(node.Sub)-1]
+		}
+		node = sub
+	}
+	if node.Kind == None || kind == Wildcard {
+		node.Kind = kind
+	}
+	return nil
+}
+
+// nodeList sorts nodes by label.
+type nodeList []Node
+
+func (l nodeList) Len() int           { return len(l) }
+func (l nodeList) Less(i, j int) bool { return l[i].Label < l[j].Label }
+func (l nodeList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+// sortNodes sorts the sub nodes of n recursively.
+func sortNodes(n *Node) {
+	sort.Sort(nodeList(n.Sub))
+	for i := range n.Sub {
+		sortNodes(&n.Sub[i])
+	}
+}
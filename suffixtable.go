@@ -0,0 +1,163 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+// A compact representation of the public suffix tree.
+//
+// The tree of Node nodes is flattened in breadth first order into
+// parallel slices: The children of a node are stored contiguous and
+// sorted by label which allows a binary search.  All distinct labels
+// are interned into one string.  The compiled in list is flattened by
+// maketable.go, a list loaded at runtime by newSuffixTable.
+
+import (
+	"strings"
+)
+
+// suffixTable is the flattened public suffix tree.  Node 0 is the root.
+type suffixTable struct {
+	text   string   // all distinct labels concatenated
+	offset []uint32 // offset of the label of node i in text
+	length []uint8  // length of the label of node i
+	kind   []Rule   // kind of node i
+	first  []uint32 // index of the first child of node i
+	count  []uint32 // number of children of node i
+}
+
+// newSuffixTable flattens the tree rooted at root like maketable.go
+// does.
+func newSuffixTable(root *Node) *suffixTable {
+	t := &suffixTable{}
+	interned := make(map[string]uint32)
+	text := make([]byte, 0, 4096)
+	add := func(n *Node) {
+		off, ok := interned[n.Label]
+		if !ok {
+			off = uint32(len(text))
+			text = append(text, n.Label...)
+			interned[n.Label] = off
+		}
+		t.offset = append(t.offset, off)
+		t.length = append(t.length, uint8(len(n.Label)))
+		t.kind = append(t.kind, n.Kind)
+		t.first = append(t.first, 0)
+		t.count = append(t.count, 0)
+	}
+
+	queue := []*Node{root}
+	add(root)
+	for i := 0; i < len(queue); i++ {
+		n := queue[i]
+		t.first[i] = uint32(len(t.kind))
+		t.count[i] = uint32(len(n.Sub))
+		for j := range n.Sub {
+			add(&n.Sub[j])
+			queue = append(queue, &n.Sub[j])
+		}
+	}
+	t.text = string(text)
+	return t
+}
+
+// label returns the label of node i.
+func (t *suffixTable) label(i int) string {
+	off := t.offset[i]
+	return t.text[off : off+uint32(t.length[i])]
+}
+
+// find looks up the child of node parent with the given label and
+// returns its index or -1 if there is no such child.
+func (t *suffixTable) find(parent int, label string) int {
+	lo := int(t.first[parent])
+	hi := lo + int(t.count[parent])
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		l := t.label(mid)
+		if l == label {
+			return mid
+		}
+		if l < label {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return -1
+}
+
+// effectiveTLDPlusOne works like EffectiveTLDPlusOne but does not
+// allocate.  The walk stops at the first label without a matching rule,
+// so the number of lookups is bounded by the depth of the rule tree and
+// not by the number of labels in domain.
+func (t *suffixTable) effectiveTLDPlusOne(domain string) string {
+	// Walk down the tree from the rightmost label.  start is the
+	// index of the leftmost matched label in domain.
+	node, matched, start := 0, -1, len(domain)+1
+	for start > 0 {
+		end := start - 1
+		i := strings.LastIndex(domain[:end], ".") + 1
+		child := t.find(node, domain[i:end])
+		if child == -1 {
+			break
+		}
+		node, matched, start = child, child, i
+		if t.kind[child] == Exception {
+			break // an exception rule always prevails
+		}
+	}
+
+	if matched == -1 || t.kind[matched] == None {
+		// no rule found, default is "*"
+		j := strings.LastIndex(domain, ".")
+		if j == -1 {
+			return ""
+		}
+		return domain[strings.LastIndex(domain[:j], ".")+1:]
+	}
+
+	var extra int
+	switch t.kind[matched] {
+	case Normal:
+		extra = 1
+	case Wildcard:
+		extra = 2
+	}
+	for ; extra > 0; extra-- {
+		if start == 0 {
+			return ""
+		}
+		start = strings.LastIndex(domain[:start-1], ".") + 1
+	}
+	return domain[start:]
+}
+
+// isSecondLevelSuffix checks whether domain consists of a known TLD plus
+// one label and is a public suffix itself like "co.uk" or "uk.com".
+func (t *suffixTable) isSecondLevelSuffix(domain string) bool {
+	i := strings.Index(domain, ".")
+	if i == -1 || strings.Index(domain[i+1:], ".") != -1 {
+		return false
+	}
+	tld := t.find(0, domain[i+1:])
+	if tld == -1 {
+		return false
+	}
+	sld := t.find(tld, domain[:i])
+	if t.kind[tld] == Wildcard {
+		return sld == -1 || t.kind[sld] != Exception
+	}
+	return sld != -1 && (t.kind[sld] == Normal || t.kind[sld] == Wildcard)
+}
+
+// rules returns the number of rules in t.
+func (t *suffixTable) rules() int {
+	count := 0
+	for _, kind := range t.kind {
+		if kind != None {
+			count++
+		}
+	}
+	return count
+}
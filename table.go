@@ -9,6702 +9,2407 @@ package cookiejar
 // on  Thu, 25 Oct 2012 02:28:04 +0200
 // Do not modify.
 
+// the date of generation of this table
+const publicSuffixListDate = "Thu, 25 Oct 2012 02:28:04 +0200"
+
 // A 'public suffix' is one under which Internet users can directly register
 // names.  This list is maintained on http://publicsuffix.org/
 // See there for a description of the format and further details.
 
-var PublicSuffixes = Node{"", 0, []Node{
-	{"ac", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ad", 1, []Node{
-		{"nom", 1, nil},
-	},
-	},
-	{"ae", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"sch", 1, nil},
-	},
-	},
-	{"aero", 1, []Node{
-		{"accident-investigation", 1, nil},
-		{"accident-prevention", 1, nil},
-		{"aerobatic", 1, nil},
-		{"aeroclub", 1, nil},
-		{"aerodrome", 1, nil},
-		{"agents", 1, nil},
-		{"air-surveillance", 1, nil},
-		{"air-traffic-control", 1, nil},
-		{"aircraft", 1, nil},
-		{"airline", 1, nil},
-		{"airport", 1, nil},
-		{"airtraffic", 1, nil},
-		{"ambulance", 1, nil},
-		{"amusement", 1, nil},
-		{"association", 1, nil},
-		{"author", 1, nil},
-		{"ballooning", 1, nil},
-		{"broker", 1, nil},
-		{"caa", 1, nil},
-		{"cargo", 1, nil},
-		{"catering", 1, nil},
-		{"certification", 1, nil},
-		{"championship", 1, nil},
-		{"charter", 1, nil},
-		{"civilaviation", 1, nil},
-		{"club", 1, nil},
-		{"conference", 1, nil},
-		{"consultant", 1, nil},
-		{"consulting", 1, nil},
-		{"control", 1, nil},
-		{"council", 1, nil},
-		{"crew", 1, nil},
-		{"design", 1, nil},
-		{"dgca", 1, nil},
-		{"educator", 1, nil},
-		{"emergency", 1, nil},
-		{"engine", 1, nil},
-		{"engineer", 1, nil},
-		{"entertainment", 1, nil},
-		{"equipment", 1, nil},
-		{"exchange", 1, nil},
-		{"express", 1, nil},
-		{"federation", 1, nil},
-		{"flight", 1, nil},
-		{"freight", 1, nil},
-		{"fuel", 1, nil},
-		{"gliding", 1, nil},
-		{"government", 1, nil},
-		{"groundhandling", 1, nil},
-		{"group", 1, nil},
-		{"hanggliding", 1, nil},
-		{"homebuilt", 1, nil},
-		{"insurance", 1, nil},
-		{"journal", 1, nil},
-		{"journalist", 1, nil},
-		{"leasing", 1, nil},
-		{"logistics", 1, nil},
-		{"magazine", 1, nil},
-		{"maintenance", 1, nil},
-		{"marketplace", 1, nil},
-		{"media", 1, nil},
-		{"microlight", 1, nil},
-		{"modelling", 1, nil},
-		{"navigation", 1, nil},
-		{"parachuting", 1, nil},
-		{"paragliding", 1, nil},
-		{"passenger-association", 1, nil},
-		{"pilot", 1, nil},
-		{"press", 1, nil},
-		{"production", 1, nil},
-		{"recreation", 1, nil},
-		{"repbody", 1, nil},
-		{"res", 1, nil},
-		{"research", 1, nil},
-		{"rotorcraft", 1, nil},
-		{"safety", 1, nil},
-		{"scientist", 1, nil},
-		{"services", 1, nil},
-		{"show", 1, nil},
-		{"skydiving", 1, nil},
-		{"software", 1, nil},
-		{"student", 1, nil},
-		{"taxi", 1, nil},
-		{"trader", 1, nil},
-		{"trading", 1, nil},
-		{"trainer", 1, nil},
-		{"union", 1, nil},
-		{"workinggroup", 1, nil},
-		{"works", 1, nil},
-	},
-	},
-	{"af", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ag", 1, []Node{
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"net", 1, nil},
-		{"nom", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ai", 1, []Node{
-		{"com", 1, nil},
-		{"net", 1, nil},
-		{"off", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"al", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"am", 1, nil},
-	{"an", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ao", 1, []Node{
-		{"co", 1, nil},
-		{"ed", 1, nil},
-		{"gv", 1, nil},
-		{"it", 1, nil},
-		{"og", 1, nil},
-		{"pb", 1, nil},
-	},
-	},
-	{"aq", 1, nil},
-	{"ar", 3, []Node{
-		{"congresodelalengua3", 2, nil},
-		{"educ", 2, nil},
-		{"gobiernoelectronico", 2, nil},
-		{"mecon", 2, nil},
-		{"nacion", 2, nil},
-		{"nic", 2, nil},
-		{"promocion", 2, nil},
-		{"retina", 2, nil},
-		{"uba", 2, nil},
-	},
-	},
-	{"arpa", 0, []Node{
-		{"e164", 1, nil},
-		{"in-addr", 1, nil},
-		{"ip6", 1, nil},
-		{"iris", 1, nil},
-		{"uri", 1, nil},
-		{"urn", 1, nil},
-	},
-	},
-	{"as", 1, []Node{
-		{"gov", 1, nil},
-	},
-	},
-	{"asia", 1, nil},
-	{"at", 1, []Node{
-		{"ac", 1, nil},
-		{"biz", 1, nil},
-		{"co", 1, nil},
-		{"gv", 1, nil},
-		{"info", 1, nil},
-		{"or", 1, nil},
-		{"priv", 1, nil},
-	},
-	},
-	{"au", 0, []Node{
-		{"act", 1, nil},
-		{"asn", 1, nil},
-		{"com", 1, nil},
-		{"conf", 1, nil},
-		{"csiro", 1, nil},
-		{"edu", 1, []Node{
-			{"act", 1, nil},
-			{"nsw", 1, nil},
-			{"nt", 1, nil},
-			{"qld", 1, nil},
-			{"sa", 1, nil},
-			{"tas", 1, nil},
-			{"vic", 1, nil},
-			{"wa", 1, nil},
-		},
-		},
-		{"gov", 1, []Node{
-			{"act", 1, nil},
-			{"nt", 1, nil},
-			{"qld", 1, nil},
-			{"sa", 1, nil},
-			{"tas", 1, nil},
-			{"vic", 1, nil},
-			{"wa", 1, nil},
-		},
-		},
-		{"id", 1, nil},
-		{"info", 1, nil},
-		{"net", 1, nil},
-		{"nsw", 1, nil},
-		{"nt", 1, nil},
-		{"org", 1, nil},
-		{"oz", 1, nil},
-		{"qld", 1, nil},
-		{"sa", 1, nil},
-		{"tas", 1, nil},
-		{"vic", 1, nil},
-		{"wa", 1, nil},
-	},
-	},
-	{"aw", 1, []Node{
-		{"com", 1, nil},
-	},
-	},
-	{"ax", 1, nil},
-	{"az", 1, []Node{
-		{"biz", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"int", 1, nil},
-		{"mil", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"pp", 1, nil},
-		{"pro", 1, nil},
-	},
-	},
-	{"ba", 1, []Node{
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"rs", 1, nil},
-		{"unbi", 1, nil},
-		{"unsa", 1, nil},
-	},
-	},
-	{"bb", 1, []Node{
-		{"biz", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"store", 1, nil},
-	},
-	},
-	{"bd", 3, nil},
-	{"be", 1, []Node{
-		{"ac", 1, nil},
-	},
-	},
-	{"bf", 1, []Node{
-		{"gov", 1, nil},
-	},
-	},
-	{"bg", 1, []Node{
-		{"0", 1, nil},
-		{"1", 1, nil},
-		{"2", 1, nil},
-		{"3", 1, nil},
-		{"4", 1, nil},
-		{"5", 1, nil},
-		{"6", 1, nil},
-		{"7", 1, nil},
-		{"8", 1, nil},
-		{"9", 1, nil},
-		{"a", 1, nil},
-		{"b", 1, nil},
-		{"c", 1, nil},
-		{"d", 1, nil},
-		{"e", 1, nil},
-		{"f", 1, nil},
-		{"g", 1, nil},
-		{"h", 1, nil},
-		{"i", 1, nil},
-		{"j", 1, nil},
-		{"k", 1, nil},
-		{"l", 1, nil},
-		{"m", 1, nil},
-		{"n", 1, nil},
-		{"o", 1, nil},
-		{"p", 1, nil},
-		{"q", 1, nil},
-		{"r", 1, nil},
-		{"s", 1, nil},
-		{"t", 1, nil},
-		{"u", 1, nil},
-		{"v", 1, nil},
-		{"w", 1, nil},
-		{"x", 1, nil},
-		{"y", 1, nil},
-		{"z", 1, nil},
-	},
-	},
-	{"bh", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"bi", 1, []Node{
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"or", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"biz", 1, []Node{
-		{"dyndns", 1, nil},
-		{"for-better", 1, nil},
-		{"for-more", 1, nil},
-		{"for-some", 1, nil},
-		{"for-the", 1, nil},
-		{"selfip", 1, nil},
-		{"webhop", 1, nil},
-	},
-	},
-	{"bj", 1, []Node{
-		{"asso", 1, nil},
-		{"barreau", 1, nil},
-		{"gouv", 1, nil},
-	},
-	},
-	{"bm", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"bn", 3, nil},
-	{"bo", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gob", 1, nil},
-		{"gov", 1, nil},
-		{"int", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"tv", 1, nil},
-	},
-	},
-	{"br", 1, []Node{
-		{"adm", 1, nil},
-		{"adv", 1, nil},
-		{"agr", 1, nil},
-		{"am", 1, nil},
-		{"arq", 1, nil},
-		{"art", 1, nil},
-		{"ato", 1, nil},
-		{"b", 1, nil},
-		{"bio", 1, nil},
-		{"blog", 1, nil},
-		{"bmd", 1, nil},
-		{"cim", 1, nil},
-		{"cng", 1, nil},
-		{"cnt", 1, nil},
-		{"com", 1, nil},
-		{"coop", 1, nil},
-		{"ecn", 1, nil},
-		{"eco", 1, nil},
-		{"edu", 1, nil},
-		{"emp", 1, nil},
-		{"eng", 1, nil},
-		{"esp", 1, nil},
-		{"etc", 1, nil},
-		{"eti", 1, nil},
-		{"far", 1, nil},
-		{"flog", 1, nil},
-		{"fm", 1, nil},
-		{"fnd", 1, nil},
-		{"fot", 1, nil},
-		{"fst", 1, nil},
-		{"g12", 1, nil},
-		{"ggf", 1, nil},
-		{"gov", 1, nil},
-		{"imb", 1, nil},
-		{"ind", 1, nil},
-		{"inf", 1, nil},
-		{"jor", 1, nil},
-		{"jus", 1, nil},
-		{"leg", 1, nil},
-		{"lel", 1, nil},
-		{"mat", 1, nil},
-		{"med", 1, nil},
-		{"mil", 1, nil},
-		{"mus", 1, nil},
-		{"net", 1, nil},
-		{"nom", 1, nil},
-		{"not", 1, nil},
-		{"ntr", 1, nil},
-		{"odo", 1, nil},
-		{"org", 1, nil},
-		{"ppg", 1, nil},
-		{"pro", 1, nil},
-		{"psc", 1, nil},
-		{"psi", 1, nil},
-		{"qsl", 1, nil},
-		{"radio", 1, nil},
-		{"rec", 1, nil},
-		{"slg", 1, nil},
-		{"srv", 1, nil},
-		{"taxi", 1, nil},
-		{"teo", 1, nil},
-		{"tmp", 1, nil},
-		{"trd", 1, nil},
-		{"tur", 1, nil},
-		{"tv", 1, nil},
-		{"vet", 1, nil},
-		{"vlog", 1, nil},
-		{"wiki", 1, nil},
-		{"zlg", 1, nil},
-	},
-	},
-	{"bs", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"bt", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"bw", 1, []Node{
-		{"co", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"by", 1, []Node{
-		{"com", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"of", 1, nil},
-	},
-	},
-	{"bz", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ca", 1, []Node{
-		{"ab", 1, nil},
-		{"bc", 1, nil},
-		{"co", 1, nil},
-		{"gc", 1, nil},
-		{"mb", 1, nil},
-		{"nb", 1, nil},
-		{"nf", 1, nil},
-		{"nl", 1, nil},
-		{"ns", 1, nil},
-		{"nt", 1, nil},
-		{"nu", 1, nil},
-		{"on", 1, nil},
-		{"pe", 1, nil},
-		{"qc", 1, nil},
-		{"sk", 1, nil},
-		{"yk", 1, nil},
-	},
-	},
-	{"cat", 1, nil},
-	{"cc", 1, []Node{
-		{"ftpaccess", 1, nil},
-		{"game-server", 1, nil},
-		{"myphotos", 1, nil},
-		{"scrapping", 1, nil},
-	},
-	},
-	{"cd", 1, []Node{
-		{"gov", 1, nil},
-	},
-	},
-	{"cf", 1, nil},
-	{"cg", 1, nil},
-	{"ch", 1, nil},
-	{"ci", 1, []Node{
-		{"ac", 1, nil},
-		{"asso", 1, nil},
-		{"aéroport", 1, nil},
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"ed", 1, nil},
-		{"edu", 1, nil},
-		{"go", 1, nil},
-		{"gouv", 1, nil},
-		{"int", 1, nil},
-		{"md", 1, nil},
-		{"net", 1, nil},
-		{"or", 1, nil},
-		{"org", 1, nil},
-		{"presse", 1, nil},
-	},
-	},
-	{"ck", 3, []Node{
-		{"www", 2, nil},
-	},
-	},
-	{"cl", 1, []Node{
-		{"co", 1, nil},
-		{"gob", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-	},
-	},
-	{"cm", 1, []Node{
-		{"gov", 1, nil},
-	},
-	},
-	{"cn", 1, []Node{
-		{"ac", 1, nil},
-		{"ah", 1, nil},
-		{"bj", 1, nil},
-		{"com", 1, nil},
-		{"cq", 1, nil},
-		{"edu", 1, nil},
-		{"fj", 1, nil},
-		{"gd", 1, nil},
-		{"gov", 1, nil},
-		{"gs", 1, nil},
-		{"gx", 1, nil},
-		{"gz", 1, nil},
-		{"ha", 1, nil},
-		{"hb", 1, nil},
-		{"he", 1, nil},
-		{"hi", 1, nil},
-		{"hk", 1, nil},
-		{"hl", 1, nil},
-		{"hn", 1, nil},
-		{"jl", 1, nil},
-		{"js", 1, nil},
-		{"jx", 1, nil},
-		{"ln", 1, nil},
-		{"mil", 1, nil},
-		{"mo", 1, nil},
-		{"net", 1, nil},
-		{"nm", 1, nil},
-		{"nx", 1, nil},
-		{"org", 1, nil},
-		{"qh", 1, nil},
-		{"sc", 1, nil},
-		{"sd", 1, nil},
-		{"sh", 1, nil},
-		{"sn", 1, nil},
-		{"sx", 1, nil},
-		{"tj", 1, nil},
-		{"tw", 1, nil},
-		{"xj", 1, nil},
-		{"xz", 1, nil},
-		{"yn", 1, nil},
-		{"zj", 1, nil},
-		{"公司", 1, nil},
-		{"網絡", 1, nil},
-		{"网络", 1, nil},
-	},
-	},
-	{"co", 1, []Node{
-		{"arts", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"firm", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"int", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"nom", 1, nil},
-		{"org", 1, nil},
-		{"rec", 1, nil},
-		{"web", 1, nil},
-	},
-	},
-	{"com", 1, []Node{
-		{"appspot", 1, nil},
-		{"ar", 1, nil},
-		{"blogdns", 1, nil},
-		{"br", 1, nil},
-		{"cechire", 1, nil},
-		{"cn", 1, nil},
-		{"de", 1, nil},
-		{"dnsalias", 1, nil},
-		{"dnsdojo", 1, nil},
-		{"doesntexist", 1, nil},
-		{"dontexist", 1, nil},
-		{"doomdns", 1, nil},
-		{"dreamhosters", 1, nil},
-		{"dyn-o-saur", 1, nil},
-		{"dynalias", 1, nil},
-		{"dyndns-at-home", 1, nil},
-		{"dyndns-at-work", 1, nil},
-		{"dyndns-blog", 1, nil},
-		{"dyndns-free", 1, nil},
-		{"dyndns-home", 1, nil},
-		{"dyndns-ip", 1, nil},
-		{"dyndns-mail", 1, nil},
-		{"dyndns-office", 1, nil},
-		{"dyndns-pics", 1, nil},
-		{"dyndns-remote", 1, nil},
-		{"dyndns-server", 1, nil},
-		{"dyndns-web", 1, nil},
-		{"dyndns-wiki", 1, nil},
-		{"dyndns-work", 1, nil},
-		{"est-a-la-maison", 1, nil},
-		{"est-a-la-masion", 1, nil},
-		{"est-le-patron", 1, nil},
-		{"est-mon-blogueur", 1, nil},
-		{"eu", 1, nil},
-		{"from-ak", 1, nil},
-		{"from-al", 1, nil},
-		{"from-ar", 1, nil},
-		{"from-ca", 1, nil},
-		{"from-ct", 1, nil},
-		{"from-dc", 1, nil},
-		{"from-de", 1, nil},
-		{"from-fl", 1, nil},
-		{"from-ga", 1, nil},
-		{"from-hi", 1, nil},
-		{"from-ia", 1, nil},
-		{"from-id", 1, nil},
-		{"from-il", 1, nil},
-		{"from-in", 1, nil},
-		{"from-ks", 1, nil},
-		{"from-ky", 1, nil},
-		{"from-ma", 1, nil},
-		{"from-md", 1, nil},
-		{"from-mi", 1, nil},
-		{"from-mn", 1, nil},
-		{"from-mo", 1, nil},
-		{"from-ms", 1, nil},
-		{"from-mt", 1, nil},
-		{"from-nc", 1, nil},
-		{"from-nd", 1, nil},
-		{"from-ne", 1, nil},
-		{"from-nh", 1, nil},
-		{"from-nj", 1, nil},
-		{"from-nm", 1, nil},
-		{"from-nv", 1, nil},
-		{"from-oh", 1, nil},
-		{"from-ok", 1, nil},
-		{"from-or", 1, nil},
-		{"from-pa", 1, nil},
-		{"from-pr", 1, nil},
-		{"from-ri", 1, nil},
-		{"from-sc", 1, nil},
-		{"from-sd", 1, nil},
-		{"from-tn", 1, nil},
-		{"from-tx", 1, nil},
-		{"from-ut", 1, nil},
-		{"from-va", 1, nil},
-		{"from-vt", 1, nil},
-		{"from-wa", 1, nil},
-		{"from-wi", 1, nil},
-		{"from-wv", 1, nil},
-		{"from-wy", 1, nil},
-		{"gb", 1, nil},
-		{"getmyip", 1, nil},
-		{"gotdns", 1, nil},
-		{"gr", 1, nil},
-		{"hobby-site", 1, nil},
-		{"homelinux", 1, nil},
-		{"homeunix", 1, nil},
-		{"hu", 1, nil},
-		{"iamallama", 1, nil},
-		{"is-a-anarchist", 1, nil},
-		{"is-a-blogger", 1, nil},
-		{"is-a-bookkeeper", 1, nil},
-		{"is-a-bulls-fan", 1, nil},
-		{"is-a-caterer", 1, nil},
-		{"is-a-chef", 1, nil},
-		{"is-a-conservative", 1, nil},
-		{"is-a-cpa", 1, nil},
-		{"is-a-cubicle-slave", 1, nil},
-		{"is-a-democrat", 1, nil},
-		{"is-a-designer", 1, nil},
-		{"is-a-doctor", 1, nil},
-		{"is-a-financialadvisor", 1, nil},
-		{"is-a-geek", 1, nil},
-		{"is-a-green", 1, nil},
-		{"is-a-guru", 1, nil},
-		{"is-a-hard-worker", 1, nil},
-		{"is-a-hunter", 1, nil},
-		{"is-a-landscaper", 1, nil},
-		{"is-a-lawyer", 1, nil},
-		{"is-a-liberal", 1, nil},
-		{"is-a-libertarian", 1, nil},
-		{"is-a-llama", 1, nil},
-		{"is-a-musician", 1, nil},
-		{"is-a-nascarfan", 1, nil},
-		{"is-a-nurse", 1, nil},
-		{"is-a-painter", 1, nil},
-		{"is-a-personaltrainer", 1, nil},
-		{"is-a-photographer", 1, nil},
-		{"is-a-player", 1, nil},
-		{"is-a-republican", 1, nil},
-		{"is-a-rockstar", 1, nil},
-		{"is-a-socialist", 1, nil},
-		{"is-a-student", 1, nil},
-		{"is-a-teacher", 1, nil},
-		{"is-a-techie", 1, nil},
-		{"is-a-therapist", 1, nil},
-		{"is-an-accountant", 1, nil},
-		{"is-an-actor", 1, nil},
-		{"is-an-actress", 1, nil},
-		{"is-an-anarchist", 1, nil},
-		{"is-an-artist", 1, nil},
-		{"is-an-engineer", 1, nil},
-		{"is-an-entertainer", 1, nil},
-		{"is-certified", 1, nil},
-		{"is-gone", 1, nil},
-		{"is-into-anime", 1, nil},
-		{"is-into-cars", 1, nil},
-		{"is-into-cartoons", 1, nil},
-		{"is-into-games", 1, nil},
-		{"is-leet", 1, nil},
-		{"is-not-certified", 1, nil},
-		{"is-slick", 1, nil},
-		{"is-uberleet", 1, nil},
-		{"is-with-theband", 1, nil},
-		{"isa-geek", 1, nil},
-		{"isa-hockeynut", 1, nil},
-		{"issmarterthanyou", 1, nil},
-		{"jpn", 1, nil},
-		{"kr", 1, nil},
-		{"likes-pie", 1, nil},
-		{"likescandy", 1, nil},
-		{"neat-url", 1, nil},
-		{"no", 1, nil},
-		{"operaunite", 1, nil},
-		{"qc", 1, nil},
-		{"ru", 1, nil},
-		{"sa", 1, nil},
-		{"saves-the-whales", 1, nil},
-		{"se", 1, nil},
-		{"selfip", 1, nil},
-		{"sells-for-less", 1, nil},
-		{"sells-for-u", 1, nil},
-		{"servebbs", 1, nil},
-		{"simple-url", 1, nil},
-		{"space-to-rent", 1, nil},
-		{"teaches-yoga", 1, nil},
-		{"uk", 1, nil},
-		{"us", 1, nil},
-		{"uy", 1, nil},
-		{"writesthisblog", 1, nil},
-		{"za", 1, nil},
-	},
-	},
-	{"coop", 1, nil},
-	{"cr", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"ed", 1, nil},
-		{"fi", 1, nil},
-		{"go", 1, nil},
-		{"or", 1, nil},
-		{"sa", 1, nil},
-	},
-	},
-	{"cu", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"inf", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"cv", 1, nil},
-	{"cx", 1, []Node{
-		{"ath", 1, nil},
-		{"gov", 1, nil},
-	},
-	},
-	{"cy", 3, nil},
-	{"cz", 1, nil},
-	{"de", 1, []Node{
-		{"com", 1, nil},
-		{"fuettertdasnetz", 1, nil},
-		{"isteingeek", 1, nil},
-		{"istmein", 1, nil},
-		{"lebtimnetz", 1, nil},
-		{"leitungsen", 1, nil},
-		{"traeumtgerade", 1, nil},
-	},
-	},
-	{"dj", 1, nil},
-	{"dk", 1, nil},
-	{"dm", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"do", 1, []Node{
-		{"art", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gob", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"sld", 1, nil},
-		{"web", 1, nil},
-	},
-	},
-	{"dz", 1, []Node{
-		{"art", 1, nil},
-		{"asso", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"pol", 1, nil},
-	},
-	},
-	{"ec", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"fin", 1, nil},
-		{"gob", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"k12", 1, nil},
-		{"med", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"pro", 1, nil},
-	},
-	},
-	{"edu", 1, nil},
-	{"ee", 1, []Node{
-		{"aip", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"fie", 1, nil},
-		{"gov", 1, nil},
-		{"lib", 1, nil},
-		{"med", 1, nil},
-		{"org", 1, nil},
-		{"pri", 1, nil},
-		{"riik", 1, nil},
-	},
-	},
-	{"eg", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"eun", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"sci", 1, nil},
-	},
-	},
-	{"er", 3, nil},
-	{"es", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gob", 1, nil},
-		{"nom", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"et", 3, nil},
-	{"eu", 1, nil},
-	{"fi", 1, []Node{
-		{"aland", 1, nil},
-		{"iki", 1, nil},
-	},
-	},
-	{"fj", 3, nil},
-	{"fk", 3, nil},
-	{"fm", 1, nil},
-	{"fo", 1, nil},
-	{"fr", 1, []Node{
-		{"aeroport", 1, nil},
-		{"assedic", 1, nil},
-		{"asso", 1, nil},
-		{"avocat", 1, nil},
-		{"avoues", 1, nil},
-		{"cci", 1, nil},
-		{"chambagri", 1, nil},
-		{"chirurgiens-dentistes", 1, nil},
-		{"com", 1, nil},
-		{"experts-comptables", 1, nil},
-		{"geometre-expert", 1, nil},
-		{"gouv", 1, nil},
-		{"greta", 1, nil},
-		{"huissier-justice", 1, nil},
-		{"medecin", 1, nil},
-		{"nom", 1, nil},
-		{"notaires", 1, nil},
-		{"pharmacien", 1, nil},
-		{"port", 1, nil},
-		{"prd", 1, nil},
-		{"presse", 1, nil},
-		{"tm", 1, nil},
-		{"veterinaire", 1, nil},
-	},
-	},
-	{"ga", 1, nil},
-	{"gd", 1, nil},
-	{"ge", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"pvt", 1, nil},
-	},
-	},
-	{"gf", 1, nil},
-	{"gg", 1, []Node{
-		{"co", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"sch", 1, nil},
-	},
-	},
-	{"gh", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"gi", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"ltd", 1, nil},
-		{"mod", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"gl", 1, nil},
-	{"gm", 1, nil},
-	{"gn", 0, []Node{
-		{"ac", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"gov", 1, nil},
-	{"gp", 1, []Node{
-		{"asso", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"mobi", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"gq", 1, nil},
-	{"gr", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"gs", 1, nil},
-	{"gt", 3, []Node{
-		{"www", 2, nil},
-	},
-	},
-	{"gu", 3, nil},
-	{"gw", 1, nil},
-	{"gy", 1, []Node{
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"net", 1, nil},
-	},
-	},
-	{"hk", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"idv", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"个人", 1, nil},
-		{"個人", 1, nil},
-		{"公司", 1, nil},
-		{"政府", 1, nil},
-		{"敎育", 1, nil},
-		{"教育", 1, nil},
-		{"箇人", 1, nil},
-		{"組織", 1, nil},
-		{"組织", 1, nil},
-		{"網絡", 1, nil},
-		{"網络", 1, nil},
-		{"组織", 1, nil},
-		{"组织", 1, nil},
-		{"网絡", 1, nil},
-		{"网络", 1, nil},
-	},
-	},
-	{"hm", 1, nil},
-	{"hn", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gob", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"hr", 1, []Node{
-		{"com", 1, nil},
-		{"from", 1, nil},
-		{"iz", 1, nil},
-		{"name", 1, nil},
-	},
-	},
-	{"ht", 1, []Node{
-		{"adult", 1, nil},
-		{"art", 1, nil},
-		{"asso", 1, nil},
-		{"com", 1, nil},
-		{"coop", 1, nil},
-		{"edu", 1, nil},
-		{"firm", 1, nil},
-		{"gouv", 1, nil},
-		{"info", 1, nil},
-		{"med", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"perso", 1, nil},
-		{"pol", 1, nil},
-		{"pro", 1, nil},
-		{"rel", 1, nil},
-		{"shop", 1, nil},
-	},
-	},
-	{"hu", 1, []Node{
-		{"2000", 1, nil},
-		{"agrar", 1, nil},
-		{"bolt", 1, nil},
-		{"casino", 1, nil},
-		{"city", 1, nil},
-		{"co", 1, nil},
-		{"erotica", 1, nil},
-		{"erotika", 1, nil},
-		{"film", 1, nil},
-		{"forum", 1, nil},
-		{"games", 1, nil},
-		{"hotel", 1, nil},
-		{"info", 1, nil},
-		{"ingatlan", 1, nil},
-		{"jogasz", 1, nil},
-		{"konyvelo", 1, nil},
-		{"lakas", 1, nil},
-		{"media", 1, nil},
-		{"news", 1, nil},
-		{"org", 1, nil},
-		{"priv", 1, nil},
-		{"reklam", 1, nil},
-		{"sex", 1, nil},
-		{"shop", 1, nil},
-		{"sport", 1, nil},
-		{"suli", 1, nil},
-		{"szex", 1, nil},
-		{"tm", 1, nil},
-		{"tozsde", 1, nil},
-		{"utazas", 1, nil},
-		{"video", 1, nil},
-	},
-	},
-	{"id", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"go", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"or", 1, nil},
-		{"sch", 1, nil},
-		{"web", 1, nil},
-	},
-	},
-	{"ie", 1, []Node{
-		{"gov", 1, nil},
-	},
-	},
-	{"il", 3, nil},
-	{"im", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, []Node{
-			{"ltd", 1, nil},
-			{"plc", 1, nil},
-		},
-		},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"nic", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"in", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"edu", 1, nil},
-		{"firm", 1, nil},
-		{"gen", 1, nil},
-		{"gov", 1, nil},
-		{"ind", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"nic", 1, nil},
-		{"org", 1, nil},
-		{"res", 1, nil},
-	},
-	},
-	{"info", 1, []Node{
-		{"barrel-of-knowledge", 1, nil},
-		{"barrell-of-knowledge", 1, nil},
-		{"dyndns", 1, nil},
-		{"for-our", 1, nil},
-		{"groks-the", 1, nil},
-		{"groks-this", 1, nil},
-		{"here-for-more", 1, nil},
-		{"knowsitall", 1, nil},
-		{"selfip", 1, nil},
-		{"webhop", 1, nil},
-	},
-	},
-	{"int", 1, []Node{
-		{"eu", 1, nil},
-	},
-	},
-	{"io", 1, []Node{
-		{"com", 1, nil},
-	},
-	},
-	{"iq", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ir", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"gov", 1, nil},
-		{"id", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"sch", 1, nil},
-		{"ايران", 1, nil},
-		{"ایران", 1, nil},
-	},
-	},
-	{"is", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"int", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"it", 1, []Node{
-		{"ag", 1, nil},
-		{"agrigento", 1, nil},
-		{"al", 1, nil},
-		{"alessandria", 1, nil},
-		{"alto-adige", 1, nil},
-		{"altoadige", 1, nil},
-		{"an", 1, nil},
-		{"ancona", 1, nil},
-		{"andria-barletta-trani", 1, nil},
-		{"andria-trani-barletta", 1, nil},
-		{"andriabarlettatrani", 1, nil},
-		{"andriatranibarletta", 1, nil},
-		{"ao", 1, nil},
-		{"aosta", 1, nil},
-		{"aoste", 1, nil},
-		{"ap", 1, nil},
-		{"aq", 1, nil},
-		{"aquila", 1, nil},
-		{"ar", 1, nil},
-		{"arezzo", 1, nil},
-		{"ascoli-piceno", 1, nil},
-		{"ascolipiceno", 1, nil},
-		{"asti", 1, nil},
-		{"at", 1, nil},
-		{"av", 1, nil},
-		{"avellino", 1, nil},
-		{"ba", 1, nil},
-		{"balsan", 1, nil},
-		{"bari", 1, nil},
-		{"barletta-trani-andria", 1, nil},
-		{"barlettatraniandria", 1, nil},
-		{"belluno", 1, nil},
-		{"benevento", 1, nil},
-		{"bergamo", 1, nil},
-		{"bg", 1, nil},
-		{"bi", 1, nil},
-		{"biella", 1, nil},
-		{"bl", 1, nil},
-		{"bn", 1, nil},
-		{"bo", 1, nil},
-		{"bologna", 1, nil},
-		{"bolzano", 1, nil},
-		{"bozen", 1, nil},
-		{"br", 1, nil},
-		{"brescia", 1, nil},
-		{"brindisi", 1, nil},
-		{"bs", 1, nil},
-		{"bt", 1, nil},
-		{"bz", 1, nil},
-		{"ca", 1, nil},
-		{"cagliari", 1, nil},
-		{"caltanissetta", 1, nil},
-		{"campidano-medio", 1, nil},
-		{"campidanomedio", 1, nil},
-		{"campobasso", 1, nil},
-		{"carbonia-iglesias", 1, nil},
-		{"carboniaiglesias", 1, nil},
-		{"carrara-massa", 1, nil},
-		{"carraramassa", 1, nil},
-		{"caserta", 1, nil},
-		{"catania", 1, nil},
-		{"catanzaro", 1, nil},
-		{"cb", 1, nil},
-		{"ce", 1, nil},
-		{"cesena-forli", 1, nil},
-		{"cesenaforli", 1, nil},
-		{"ch", 1, nil},
-		{"chieti", 1, nil},
-		{"ci", 1, nil},
-		{"cl", 1, nil},
-		{"cn", 1, nil},
-		{"co", 1, nil},
-		{"como", 1, nil},
-		{"cosenza", 1, nil},
-		{"cr", 1, nil},
-		{"cremona", 1, nil},
-		{"crotone", 1, nil},
-		{"cs", 1, nil},
-		{"ct", 1, nil},
-		{"cuneo", 1, nil},
-		{"cz", 1, nil},
-		{"dell-ogliastra", 1, nil},
-		{"dellogliastra", 1, nil},
-		{"edu", 1, nil},
-		{"en", 1, nil},
-		{"enna", 1, nil},
-		{"fc", 1, nil},
-		{"fe", 1, nil},
-		{"fermo", 1, nil},
-		{"ferrara", 1, nil},
-		{"fg", 1, nil},
-		{"fi", 1, nil},
-		{"firenze", 1, nil},
-		{"florence", 1, nil},
-		{"fm", 1, nil},
-		{"foggia", 1, nil},
-		{"forli-cesena", 1, nil},
-		{"forlicesena", 1, nil},
-		{"fr", 1, nil},
-		{"frosinone", 1, nil},
-		{"ge", 1, nil},
-		{"genoa", 1, nil},
-		{"genova", 1, nil},
-		{"go", 1, nil},
-		{"gorizia", 1, nil},
-		{"gov", 1, nil},
-		{"gr", 1, nil},
-		{"grosseto", 1, nil},
-		{"iglesias-carbonia", 1, nil},
-		{"iglesiascarbonia", 1, nil},
-		{"im", 1, nil},
-		{"imperia", 1, nil},
-		{"is", 1, nil},
-		{"isernia", 1, nil},
-		{"kr", 1, nil},
-		{"la-spezia", 1, nil},
-		{"laquila", 1, nil},
-		{"laspezia", 1, nil},
-		{"latina", 1, nil},
-		{"lc", 1, nil},
-		{"le", 1, nil},
-		{"lecce", 1, nil},
-		{"lecco", 1, nil},
-		{"li", 1, nil},
-		{"livorno", 1, nil},
-		{"lo", 1, nil},
-		{"lodi", 1, nil},
-		{"lt", 1, nil},
-		{"lu", 1, nil},
-		{"lucca", 1, nil},
-		{"macerata", 1, nil},
-		{"mantova", 1, nil},
-		{"massa-carrara", 1, nil},
-		{"massacarrara", 1, nil},
-		{"matera", 1, nil},
-		{"mb", 1, nil},
-		{"mc", 1, nil},
-		{"me", 1, nil},
-		{"medio-campidano", 1, nil},
-		{"mediocampidano", 1, nil},
-		{"messina", 1, nil},
-		{"mi", 1, nil},
-		{"milan", 1, nil},
-		{"milano", 1, nil},
-		{"mn", 1, nil},
-		{"mo", 1, nil},
-		{"modena", 1, nil},
-		{"monza", 1, nil},
-		{"monza-brianza", 1, nil},
-		{"monza-e-della-brianza", 1, nil},
-		{"monzabrianza", 1, nil},
-		{"monzaebrianza", 1, nil},
-		{"monzaedellabrianza", 1, nil},
-		{"ms", 1, nil},
-		{"mt", 1, nil},
-		{"na", 1, nil},
-		{"naples", 1, nil},
-		{"napoli", 1, nil},
-		{"no", 1, nil},
-		{"novara", 1, nil},
-		{"nu", 1, nil},
-		{"nuoro", 1, nil},
-		{"og", 1, nil},
-		{"ogliastra", 1, nil},
-		{"olbia-tempio", 1, nil},
-		{"olbiatempio", 1, nil},
-		{"or", 1, nil},
-		{"oristano", 1, nil},
-		{"ot", 1, nil},
-		{"pa", 1, nil},
-		{"padova", 1, nil},
-		{"padua", 1, nil},
-		{"palermo", 1, nil},
-		{"parma", 1, nil},
-		{"pavia", 1, nil},
-		{"pc", 1, nil},
-		{"pd", 1, nil},
-		{"pe", 1, nil},
-		{"perugia", 1, nil},
-		{"pesaro-urbino", 1, nil},
-		{"pesarourbino", 1, nil},
-		{"pescara", 1, nil},
-		{"pg", 1, nil},
-		{"pi", 1, nil},
-		{"piacenza", 1, nil},
-		{"pisa", 1, nil},
-		{"pistoia", 1, nil},
-		{"pn", 1, nil},
-		{"po", 1, nil},
-		{"pordenone", 1, nil},
-		{"potenza", 1, nil},
-		{"pr", 1, nil},
-		{"prato", 1, nil},
-		{"pt", 1, nil},
-		{"pu", 1, nil},
-		{"pv", 1, nil},
-		{"pz", 1, nil},
-		{"ra", 1, nil},
-		{"ragusa", 1, nil},
-		{"ravenna", 1, nil},
-		{"rc", 1, nil},
-		{"re", 1, nil},
-		{"reggio-calabria", 1, nil},
-		{"reggio-emilia", 1, nil},
-		{"reggiocalabria", 1, nil},
-		{"reggioemilia", 1, nil},
-		{"rg", 1, nil},
-		{"ri", 1, nil},
-		{"rieti", 1, nil},
-		{"rimini", 1, nil},
-		{"rm", 1, nil},
-		{"rn", 1, nil},
-		{"ro", 1, nil},
-		{"roma", 1, nil},
-		{"rome", 1, nil},
-		{"rovigo", 1, nil},
-		{"sa", 1, nil},
-		{"salerno", 1, nil},
-		{"sassari", 1, nil},
-		{"savona", 1, nil},
-		{"si", 1, nil},
-		{"siena", 1, nil},
-		{"siracusa", 1, nil},
-		{"so", 1, nil},
-		{"sondrio", 1, nil},
-		{"sp", 1, nil},
-		{"sr", 1, nil},
-		{"ss", 1, nil},
-		{"suedtirol", 1, nil},
-		{"sv", 1, nil},
-		{"ta", 1, nil},
-		{"taranto", 1, nil},
-		{"te", 1, nil},
-		{"tempio-olbia", 1, nil},
-		{"tempioolbia", 1, nil},
-		{"teramo", 1, nil},
-		{"terni", 1, nil},
-		{"tn", 1, nil},
-		{"to", 1, nil},
-		{"torino", 1, nil},
-		{"tp", 1, nil},
-		{"tr", 1, nil},
-		{"trani-andria-barletta", 1, nil},
-		{"trani-barletta-andria", 1, nil},
-		{"traniandriabarletta", 1, nil},
-		{"tranibarlettaandria", 1, nil},
-		{"trapani", 1, nil},
-		{"trentino", 1, nil},
-		{"trento", 1, nil},
-		{"treviso", 1, nil},
-		{"trieste", 1, nil},
-		{"ts", 1, nil},
-		{"turin", 1, nil},
-		{"tv", 1, nil},
-		{"ud", 1, nil},
-		{"udine", 1, nil},
-		{"urbino-pesaro", 1, nil},
-		{"urbinopesaro", 1, nil},
-		{"va", 1, nil},
-		{"varese", 1, nil},
-		{"vb", 1, nil},
-		{"vc", 1, nil},
-		{"ve", 1, nil},
-		{"venezia", 1, nil},
-		{"venice", 1, nil},
-		{"verbania", 1, nil},
-		{"vercelli", 1, nil},
-		{"verona", 1, nil},
-		{"vi", 1, nil},
-		{"vibo-valentia", 1, nil},
-		{"vibovalentia", 1, nil},
-		{"vicenza", 1, nil},
-		{"viterbo", 1, nil},
-		{"vr", 1, nil},
-		{"vs", 1, nil},
-		{"vt", 1, nil},
-		{"vv", 1, nil},
-	},
-	},
-	{"je", 1, []Node{
-		{"co", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"sch", 1, nil},
-	},
-	},
-	{"jm", 3, nil},
-	{"jo", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"sch", 1, nil},
-	},
-	},
-	{"jobs", 1, nil},
-	{"jp", 1, []Node{
-		{"ac", 1, nil},
-		{"ad", 1, nil},
-		{"aichi", 1, []Node{
-			{"aisai", 1, nil},
-			{"ama", 1, nil},
-			{"anjo", 1, nil},
-			{"asuke", 1, nil},
-			{"chiryu", 1, nil},
-			{"chita", 1, nil},
-			{"fuso", 1, nil},
-			{"gamagori", 1, nil},
-			{"handa", 1, nil},
-			{"hazu", 1, nil},
-			{"hekinan", 1, nil},
-			{"higashiura", 1, nil},
-			{"ichinomiya", 1, nil},
-			{"inazawa", 1, nil},
-			{"inuyama", 1, nil},
-			{"isshiki", 1, nil},
-			{"iwakura", 1, nil},
-			{"kanie", 1, nil},
-			{"kariya", 1, nil},
-			{"kasugai", 1, nil},
-			{"kira", 1, nil},
-			{"kiyosu", 1, nil},
-			{"komaki", 1, nil},
-			{"konan", 1, nil},
-			{"kota", 1, nil},
-			{"mihama", 1, nil},
-			{"miyoshi", 1, nil},
-			{"nagakute", 1, nil},
-			{"nishio", 1, nil},
-			{"nisshin", 1, nil},
-			{"obu", 1, nil},
-			{"oguchi", 1, nil},
-			{"oharu", 1, nil},
-			{"okazaki", 1, nil},
-			{"owariasahi", 1, nil},
-			{"seto", 1, nil},
-			{"shikatsu", 1, nil},
-			{"shinshiro", 1, nil},
-			{"shitara", 1, nil},
-			{"tahara", 1, nil},
-			{"takahama", 1, nil},
-			{"tobishima", 1, nil},
-			{"toei", 1, nil},
-			{"togo", 1, nil},
-			{"tokai", 1, nil},
-			{"tokoname", 1, nil},
-			{"toyoake", 1, nil},
-			{"toyohashi", 1, nil},
-			{"toyokawa", 1, nil},
-			{"toyone", 1, nil},
-			{"toyota", 1, nil},
-			{"tsushima", 1, nil},
-			{"yatomi", 1, nil},
-		},
-		},
-		{"akita", 1, []Node{
-			{"akita", 1, nil},
-			{"daisen", 1, nil},
-			{"fujisato", 1, nil},
-			{"gojome", 1, nil},
-			{"hachirogata", 1, nil},
-			{"happou", 1, nil},
-			{"higashinaruse", 1, nil},
-			{"honjo", 1, nil},
-			{"honjyo", 1, nil},
-			{"ikawa", 1, nil},
-			{"kamikoani", 1, nil},
-			{"kamioka", 1, nil},
-			{"katagami", 1, nil},
-			{"kazuno", 1, nil},
-			{"kitaakita", 1, nil},
-			{"kosaka", 1, nil},
-			{"kyowa", 1, nil},
-			{"misato", 1, nil},
-			{"mitane", 1, nil},
-			{"moriyoshi", 1, nil},
-			{"nikaho", 1, nil},
-			{"noshiro", 1, nil},
-			{"odate", 1, nil},
-			{"oga", 1, nil},
-			{"ogata", 1, nil},
-			{"semboku", 1, nil},
-			{"yokote", 1, nil},
-			{"yurihonjo", 1, nil},
-		},
-		},
-		{"aomori", 1, []Node{
-			{"aomori", 1, nil},
-			{"gonohe", 1, nil},
-			{"hachinohe", 1, nil},
-			{"hashikami", 1, nil},
-			{"hiranai", 1, nil},
-			{"hirosaki", 1, nil},
-			{"itayanagi", 1, nil},
-			{"kuroishi", 1, nil},
-			{"misawa", 1, nil},
-			{"mutsu", 1, nil},
-			{"nakadomari", 1, nil},
-			{"noheji", 1, nil},
-			{"oirase", 1, nil},
-			{"owani", 1, nil},
-			{"rokunohe", 1, nil},
-			{"sannohe", 1, nil},
-			{"shichinohe", 1, nil},
-			{"shingo", 1, nil},
-			{"takko", 1, nil},
-			{"towada", 1, nil},
-			{"tsugaru", 1, nil},
-			{"tsuruta", 1, nil},
-		},
-		},
-		{"chiba", 1, []Node{
-			{"abiko", 1, nil},
-			{"asahi", 1, nil},
-			{"chonan", 1, nil},
-			{"chosei", 1, nil},
-			{"choshi", 1, nil},
-			{"chuo", 1, nil},
-			{"funabashi", 1, nil},
-			{"futtsu", 1, nil},
-			{"hanamigawa", 1, nil},
-			{"ichihara", 1, nil},
-			{"ichikawa", 1, nil},
-			{"ichinomiya", 1, nil},
-			{"inzai", 1, nil},
-			{"isumi", 1, nil},
-			{"kamagaya", 1, nil},
-			{"kamogawa", 1, nil},
-			{"kashiwa", 1, nil},
-			{"katori", 1, nil},
-			{"katsuura", 1, nil},
-			{"kimitsu", 1, nil},
-			{"kisarazu", 1, nil},
-			{"kozaki", 1, nil},
-			{"kujukuri", 1, nil},
-			{"kyonan", 1, nil},
-			{"matsudo", 1, nil},
-			{"midori", 1, nil},
-			{"mihama", 1, nil},
-			{"minamiboso", 1, nil},
-			{"mobara", 1, nil},
-			{"mutsuzawa", 1, nil},
-			{"nagara", 1, nil},
-			{"nagareyama", 1, nil},
-			{"narashino", 1, nil},
-			{"narita", 1, nil},
-			{"noda", 1, nil},
-			{"oamishirasato", 1, nil},
-			{"omigawa", 1, nil},
-			{"onjuku", 1, nil},
-			{"otaki", 1, nil},
-			{"sakae", 1, nil},
-			{"sakura", 1, nil},
-			{"shimofusa", 1, nil},
-			{"shirako", 1, nil},
-			{"shiroi", 1, nil},
-			{"shisui", 1, nil},
-			{"sodegaura", 1, nil},
-			{"sosa", 1, nil},
-			{"tako", 1, nil},
-			{"tateyama", 1, nil},
-			{"togane", 1, nil},
-			{"tohnosho", 1, nil},
-			{"tomisato", 1, nil},
-			{"urayasu", 1, nil},
-			{"yachimata", 1, nil},
-			{"yachiyo", 1, nil},
-			{"yokaichiba", 1, nil},
-			{"yokoshibahikari", 1, nil},
-			{"yotsukaido", 1, nil},
-		},
-		},
-		{"co", 1, nil},
-		{"ed", 1, nil},
-		{"ehime", 1, []Node{
-			{"ainan", 1, nil},
-			{"honai", 1, nil},
-			{"ikata", 1, nil},
-			{"imabari", 1, nil},
-			{"iyo", 1, nil},
-			{"kamijima", 1, nil},
-			{"kihoku", 1, nil},
-			{"kumakogen", 1, nil},
-			{"masaki", 1, nil},
-			{"matsuno", 1, nil},
-			{"matsuyama", 1, nil},
-			{"namikata", 1, nil},
-			{"niihama", 1, nil},
-			{"ozu", 1, nil},
-			{"saijo", 1, nil},
-			{"seiyo", 1, nil},
-			{"shikokuchuo", 1, nil},
-			{"tobe", 1, nil},
-			{"toon", 1, nil},
-			{"uchiko", 1, nil},
-			{"uwajima", 1, nil},
-			{"yawatahama", 1, nil},
-		},
-		},
-		{"fukui", 1, []Node{
-			{"echizen", 1, nil},
-			{"eiheiji", 1, nil},
-			{"fukui", 1, nil},
-			{"ikeda", 1, nil},
-			{"katsuyama", 1, nil},
-			{"mihama", 1, nil},
-			{"minamiechizen", 1, nil},
-			{"obama", 1, nil},
-			{"ohi", 1, nil},
-			{"ono", 1, nil},
-			{"sabae", 1, nil},
-			{"sakai", 1, nil},
-			{"takahama", 1, nil},
-			{"tsuruga", 1, nil},
-			{"wakasa", 1, nil},
-		},
-		},
-		{"fukuoka", 1, []Node{
-			{"ashiya", 1, nil},
-			{"buzen", 1, nil},
-			{"chikugo", 1, nil},
-			{"chikuho", 1, nil},
-			{"chikujo", 1, nil},
-			{"chikushino", 1, nil},
-			{"chikuzen", 1, nil},
-			{"chuo", 1, nil},
-			{"dazaifu", 1, nil},
-			{"fukuchi", 1, nil},
-			{"hakata", 1, nil},
-			{"higashi", 1, nil},
-			{"hirokawa", 1, nil},
-			{"hisayama", 1, nil},
-			{"iizuka", 1, nil},
-			{"inatsuki", 1, nil},
-			{"kaho", 1, nil},
-			{"kasuga", 1, nil},
-			{"kasuya", 1, nil},
-			{"kawara", 1, nil},
-			{"keisen", 1, nil},
-			{"koga", 1, nil},
-			{"kurate", 1, nil},
-			{"kurogi", 1, nil},
-			{"kurume", 1, nil},
-			{"minami", 1, nil},
-			{"miyako", 1, nil},
-			{"miyama", 1, nil},
-			{"miyawaka", 1, nil},
-			{"mizumaki", 1, nil},
-			{"munakata", 1, nil},
-			{"nakagawa", 1, nil},
-			{"nakama", 1, nil},
-			{"nishi", 1, nil},
-			{"nogata", 1, nil},
-			{"ogori", 1, nil},
-			{"okagaki", 1, nil},
-			{"okawa", 1, nil},
-			{"oki", 1, nil},
-			{"omuta", 1, nil},
-			{"onga", 1, nil},
-			{"onojo", 1, nil},
-			{"oto", 1, nil},
-			{"saigawa", 1, nil},
-			{"sasaguri", 1, nil},
-			{"shingu", 1, nil},
-			{"shinyoshitomi", 1, nil},
-			{"shonai", 1, nil},
-			{"soeda", 1, nil},
-			{"sue", 1, nil},
-			{"tachiarai", 1, nil},
-			{"tagawa", 1, nil},
-			{"takata", 1, nil},
-			{"toho", 1, nil},
-			{"toyotsu", 1, nil},
-			{"tsuiki", 1, nil},
-			{"ukiha", 1, nil},
-			{"umi", 1, nil},
-			{"usui", 1, nil},
-			{"yamada", 1, nil},
-			{"yame", 1, nil},
-			{"yanagawa", 1, nil},
-			{"yukuhashi", 1, nil},
-		},
-		},
-		{"fukushima", 1, []Node{
-			{"aizubange", 1, nil},
-			{"aizumisato", 1, nil},
-			{"aizuwakamatsu", 1, nil},
-			{"asakawa", 1, nil},
-			{"bandai", 1, nil},
-			{"date", 1, nil},
-			{"fukushima", 1, nil},
-			{"furudono", 1, nil},
-			{"futaba", 1, nil},
-			{"hanawa", 1, nil},
-			{"higashi", 1, nil},
-			{"hirata", 1, nil},
-			{"hirono", 1, nil},
-			{"iitate", 1, nil},
-			{"inawashiro", 1, nil},
-			{"ishikawa", 1, nil},
-			{"iwaki", 1, nil},
-			{"izumizaki", 1, nil},
-			{"kagamiishi", 1, nil},
-			{"kaneyama", 1, nil},
-			{"kawamata", 1, nil},
-			{"kitakata", 1, nil},
-			{"kitashiobara", 1, nil},
-			{"koori", 1, nil},
-			{"koriyama", 1, nil},
-			{"kunimi", 1, nil},
-			{"miharu", 1, nil},
-			{"mishima", 1, nil},
-			{"namie", 1, nil},
-			{"nango", 1, nil},
-			{"nishiaizu", 1, nil},
-			{"nishigo", 1, nil},
-			{"okuma", 1, nil},
-			{"omotego", 1, nil},
-			{"ono", 1, nil},
-			{"otama", 1, nil},
-			{"samegawa", 1, nil},
-			{"shimogo", 1, nil},
-			{"shirakawa", 1, nil},
-			{"showa", 1, nil},
-			{"soma", 1, nil},
-			{"sukagawa", 1, nil},
-			{"taishin", 1, nil},
-			{"tamakawa", 1, nil},
-			{"tanagura", 1, nil},
-			{"tenei", 1, nil},
-			{"yabuki", 1, nil},
-			{"yamato", 1, nil},
-			{"yamatsuri", 1, nil},
-			{"yanaizu", 1, nil},
-			{"yugawa", 1, nil},
-		},
-		},
-		{"gifu", 1, []Node{
-			{"anpachi", 1, nil},
-			{"ena", 1, nil},
-			{"gifu", 1, nil},
-			{"ginan", 1, nil},
-			{"godo", 1, nil},
-			{"gujo", 1, nil},
-			{"hashima", 1, nil},
-			{"hichiso", 1, nil},
-			{"hida", 1, nil},
-			{"higashishirakawa", 1, nil},
-			{"ibigawa", 1, nil},
-			{"ikeda", 1, nil},
-			{"kakamigahara", 1, nil},
-			{"kani", 1, nil},
-			{"kasahara", 1, nil},
-			{"kasamatsu", 1, nil},
-			{"kawaue", 1, nil},
-			{"kitagata", 1, nil},
-			{"mino", 1, nil},
-			{"minokamo", 1, nil},
-			{"mitake", 1, nil},
-			{"mizunami", 1, nil},
-			{"motosu", 1, nil},
-			{"nakatsugawa", 1, nil},
-			{"ogaki", 1, nil},
-			{"sakahogi", 1, nil},
-			{"seki", 1, nil},
-			{"sekigahara", 1, nil},
-			{"shirakawa", 1, nil},
-			{"tajimi", 1, nil},
-			{"takayama", 1, nil},
-			{"tarui", 1, nil},
-			{"toki", 1, nil},
-			{"tomika", 1, nil},
-			{"wanouchi", 1, nil},
-			{"yamagata", 1, nil},
-			{"yaotsu", 1, nil},
-			{"yoro", 1, nil},
-		},
-		},
-		{"go", 1, nil},
-		{"gr", 1, nil},
-		{"gunma", 1, []Node{
-			{"annaka", 1, nil},
-			{"chiyoda", 1, nil},
-			{"fujioka", 1, nil},
-			{"higashiagatsuma", 1, nil},
-			{"isesaki", 1, nil},
-			{"itakura", 1, nil},
-			{"kanna", 1, nil},
-			{"kanra", 1, nil},
-			{"katashina", 1, nil},
-			{"kawaba", 1, nil},
-			{"kiryu", 1, nil},
-			{"kusatsu", 1, nil},
-			{"maebashi", 1, nil},
-			{"meiwa", 1, nil},
-			{"midori", 1, nil},
-			{"minakami", 1, nil},
-			{"naganohara", 1, nil},
-			{"nakanojo", 1, nil},
-			{"nanmoku", 1, nil},
-			{"numata", 1, nil},
-			{"oizumi", 1, nil},
-			{"ora", 1, nil},
-			{"ota", 1, nil},
-			{"shibukawa", 1, nil},
-			{"shimonita", 1, nil},
-			{"shinto", 1, nil},
-			{"showa", 1, nil},
-			{"takasaki", 1, nil},
-			{"takayama", 1, nil},
-			{"tamamura", 1, nil},
-			{"tatebayashi", 1, nil},
-			{"tomioka", 1, nil},
-			{"tsukiyono", 1, nil},
-			{"tsumagoi", 1, nil},
-			{"ueno", 1, nil},
-			{"yoshioka", 1, nil},
-		},
-		},
-		{"hiroshima", 1, []Node{
-			{"asaminami", 1, nil},
-			{"daiwa", 1, nil},
-			{"etajima", 1, nil},
-			{"fuchu", 1, nil},
-			{"fukuyama", 1, nil},
-			{"hatsukaichi", 1, nil},
-			{"higashihiroshima", 1, nil},
-			{"hongo", 1, nil},
-			{"jinsekikogen", 1, nil},
-			{"kaita", 1, nil},
-			{"kui", 1, nil},
-			{"kumano", 1, nil},
-			{"kure", 1, nil},
-			{"mihara", 1, nil},
-			{"miyoshi", 1, nil},
-			{"naka", 1, nil},
-			{"onomichi", 1, nil},
-			{"osakikamijima", 1, nil},
-			{"otake", 1, nil},
-			{"saka", 1, nil},
-			{"sera", 1, nil},
-			{"seranishi", 1, nil},
-			{"shinichi", 1, nil},
-			{"shobara", 1, nil},
-			{"takehara", 1, nil},
-		},
-		},
-		{"hokkaido", 1, []Node{
-			{"abashiri", 1, nil},
-			{"abira", 1, nil},
-			{"aibetsu", 1, nil},
-			{"akabira", 1, nil},
-			{"akkeshi", 1, nil},
-			{"asahikawa", 1, nil},
-			{"ashibetsu", 1, nil},
-			{"ashoro", 1, nil},
-			{"assabu", 1, nil},
-			{"atsuma", 1, nil},
-			{"bibai", 1, nil},
-			{"biei", 1, nil},
-			{"bifuka", 1, nil},
-			{"bihoro", 1, nil},
-			{"biratori", 1, nil},
-			{"chippubetsu", 1, nil},
-			{"chitose", 1, nil},
-			{"date", 1, nil},
-			{"ebetsu", 1, nil},
-			{"embetsu", 1, nil},
-			{"eniwa", 1, nil},
-			{"erimo", 1, nil},
-			{"esan", 1, nil},
-			{"esashi", 1, nil},
-			{"fukagawa", 1, nil},
-			{"fukushima", 1, nil},
-			{"furano", 1, nil},
-			{"furubira", 1, nil},
-			{"haboro", 1, nil},
-			{"hakodate", 1, nil},
-			{"hamatonbetsu", 1, nil},
-			{"hidaka", 1, nil},
-			{"higashikagura", 1, nil},
-			{"higashikawa", 1, nil},
-			{"hiroo", 1, nil},
-			{"hokuryu", 1, nil},
-			{"hokuto", 1, nil},
-			{"honbetsu", 1, nil},
-			{"horokanai", 1, nil},
-			{"horonobe", 1, nil},
-			{"ikeda", 1, nil},
-			{"imakane", 1, nil},
-			{"ishikari", 1, nil},
-			{"iwamizawa", 1, nil},
-			{"iwanai", 1, nil},
-			{"kamifurano", 1, nil},
-			{"kamikawa", 1, nil},
-			{"kamishihoro", 1, nil},
-			{"kamisunagawa", 1, nil},
-			{"kamoenai", 1, nil},
-			{"kayabe", 1, nil},
-			{"kembuchi", 1, nil},
-			{"kikonai", 1, nil},
-			{"kimobetsu", 1, nil},
-			{"kitahiroshima", 1, nil},
-			{"kitami", 1, nil},
-			{"kiyosato", 1, nil},
-			{"koshimizu", 1, nil},
-			{"kunneppu", 1, nil},
-			{"kuriyama", 1, nil},
-			{"kuromatsunai", 1, nil},
-			{"kushiro", 1, nil},
-			{"kutchan", 1, nil},
-			{"kyowa", 1, nil},
-			{"mashike", 1, nil},
-			{"matsumae", 1, nil},
-			{"mikasa", 1, nil},
-			{"minamifurano", 1, nil},
-			{"mombetsu", 1, nil},
-			{"moseushi", 1, nil},
-			{"mukawa", 1, nil},
-			{"muroran", 1, nil},
-			{"naie", 1, nil},
-			{"nakagawa", 1, nil},
-			{"nakasatsunai", 1, nil},
-			{"nakatombetsu", 1, nil},
-			{"nanae", 1, nil},
-			{"nanporo", 1, nil},
-			{"nayoro", 1, nil},
-			{"nemuro", 1, nil},
-			{"niikappu", 1, nil},
-			{"niki", 1, nil},
-			{"nishiokoppe", 1, nil},
-			{"noboribetsu", 1, nil},
-			{"numata", 1, nil},
-			{"obihiro", 1, nil},
-			{"obira", 1, nil},
-			{"oketo", 1, nil},
-			{"okoppe", 1, nil},
-			{"otaru", 1, nil},
-			{"otobe", 1, nil},
-			{"otofuke", 1, nil},
-			{"otoineppu", 1, nil},
-			{"oumu", 1, nil},
-			{"ozora", 1, nil},
-			{"pippu", 1, nil},
-			{"rankoshi", 1, nil},
-			{"rebun", 1, nil},
-			{"rikubetsu", 1, nil},
-			{"rishiri", 1, nil},
-			{"rishirifuji", 1, nil},
-			{"saroma", 1, nil},
-			{"sarufutsu", 1, nil},
-			{"shakotan", 1, nil},
-			{"shari", 1, nil},
-			{"shibecha", 1, nil},
-			{"shibetsu", 1, nil},
-			{"shikabe", 1, nil},
-			{"shikaoi", 1, nil},
-			{"shimamaki", 1, nil},
-			{"shimizu", 1, nil},
-			{"shimokawa", 1, nil},
-			{"shinshinotsu", 1, nil},
-			{"shintoku", 1, nil},
-			{"shiranuka", 1, nil},
-			{"shiraoi", 1, nil},
-			{"shiriuchi", 1, nil},
-			{"sobetsu", 1, nil},
-			{"sunagawa", 1, nil},
-			{"taiki", 1, nil},
-			{"takasu", 1, nil},
-			{"takikawa", 1, nil},
-			{"takinoue", 1, nil},
-			{"teshikaga", 1, nil},
-			{"tobetsu", 1, nil},
-			{"tohma", 1, nil},
-			{"tomakomai", 1, nil},
-			{"tomari", 1, nil},
-			{"toya", 1, nil},
-			{"toyako", 1, nil},
-			{"toyotomi", 1, nil},
-			{"toyoura", 1, nil},
-			{"tsubetsu", 1, nil},
-			{"tsukigata", 1, nil},
-			{"urakawa", 1, nil},
-			{"urausu", 1, nil},
-			{"uryu", 1, nil},
-			{"utashinai", 1, nil},
-			{"wakkanai", 1, nil},
-			{"wassamu", 1, nil},
-			{"yakumo", 1, nil},
-			{"yoichi", 1, nil},
-		},
-		},
-		{"hyogo", 1, []Node{
-			{"aioi", 1, nil},
-			{"akashi", 1, nil},
-			{"ako", 1, nil},
-			{"amagasaki", 1, nil},
-			{"aogaki", 1, nil},
-			{"asago", 1, nil},
-			{"ashiya", 1, nil},
-			{"awaji", 1, nil},
-			{"fukusaki", 1, nil},
-			{"goshiki", 1, nil},
-			{"harima", 1, nil},
-			{"himeji", 1, nil},
-			{"ichikawa", 1, nil},
-			{"inagawa", 1, nil},
-			{"itami", 1, nil},
-			{"kakogawa", 1, nil},
-			{"kamigori", 1, nil},
-			{"kamikawa", 1, nil},
-			{"kasai", 1, nil},
-			{"kasuga", 1, nil},
-			{"kawanishi", 1, nil},
-			{"miki", 1, nil},
-			{"minamiawaji", 1, nil},
-			{"nishinomiya", 1, nil},
-			{"nishiwaki", 1, nil},
-			{"ono", 1, nil},
-			{"sanda", 1, nil},
-			{"sannan", 1, nil},
-			{"sasayama", 1, nil},
-			{"sayo", 1, nil},
-			{"shingu", 1, nil},
-			{"shinonsen", 1, nil},
-			{"shiso", 1, nil},
-			{"sumoto", 1, nil},
-			{"taishi", 1, nil},
-			{"taka", 1, nil},
-			{"takarazuka", 1, nil},
-			{"takasago", 1, nil},
-			{"takino", 1, nil},
-			{"tamba", 1, nil},
-			{"tatsuno", 1, nil},
-			{"toyooka", 1, nil},
-			{"yabu", 1, nil},
-			{"yashiro", 1, nil},
-			{"yoka", 1, nil},
-			{"yokawa", 1, nil},
-		},
-		},
-		{"ibaraki", 1, []Node{
-			{"ami", 1, nil},
-			{"asahi", 1, nil},
-			{"bando", 1, nil},
-			{"chikusei", 1, nil},
-			{"daigo", 1, nil},
-			{"fujishiro", 1, nil},
-			{"hitachi", 1, nil},
-			{"hitachinaka", 1, nil},
-			{"hitachiomiya", 1, nil},
-			{"hitachiota", 1, nil},
-			{"ibaraki", 1, nil},
-			{"ina", 1, nil},
-			{"inashiki", 1, nil},
-			{"itako", 1, nil},
-			{"iwama", 1, nil},
-			{"joso", 1, nil},
-			{"kamisu", 1, nil},
-			{"kasama", 1, nil},
-			{"kashima", 1, nil},
-			{"kasumigaura", 1, nil},
-			{"koga", 1, nil},
-			{"miho", 1, nil},
-			{"mito", 1, nil},
-			{"moriya", 1, nil},
-			{"naka", 1, nil},
-			{"namegata", 1, nil},
-			{"oarai", 1, nil},
-			{"ogawa", 1, nil},
-			{"omitama", 1, nil},
-			{"ryugasaki", 1, nil},
-			{"sakai", 1, nil},
-			{"sakuragawa", 1, nil},
-			{"shimodate", 1, nil},
-			{"shimotsuma", 1, nil},
-			{"shirosato", 1, nil},
-			{"sowa", 1, nil},
-			{"suifu", 1, nil},
-			{"takahagi", 1, nil},
-			{"tamatsukuri", 1, nil},
-			{"tokai", 1, nil},
-			{"tomobe", 1, nil},
-			{"tone", 1, nil},
-			{"toride", 1, nil},
-			{"tsuchiura", 1, nil},
-			{"tsukuba", 1, nil},
-			{"uchihara", 1, nil},
-			{"ushiku", 1, nil},
-			{"yachiyo", 1, nil},
-			{"yamagata", 1, nil},
-			{"yawara", 1, nil},
-			{"yuki", 1, nil},
-		},
-		},
-		{"ishikawa", 1, []Node{
-			{"anamizu", 1, nil},
-			{"hakui", 1, nil},
-			{"hakusan", 1, nil},
-			{"kaga", 1, nil},
-			{"kahoku", 1, nil},
-			{"kanazawa", 1, nil},
-			{"kawakita", 1, nil},
-			{"komatsu", 1, nil},
-			{"nakanoto", 1, nil},
-			{"nanao", 1, nil},
-			{"nomi", 1, nil},
-			{"nonoichi", 1, nil},
-			{"noto", 1, nil},
-			{"shika", 1, nil},
-			{"suzu", 1, nil},
-			{"tsubata", 1, nil},
-			{"tsurugi", 1, nil},
-			{"uchinada", 1, nil},
-			{"wajima", 1, nil},
-		},
-		},
-		{"iwate", 1, []Node{
-			{"fudai", 1, nil},
-			{"fujisawa", 1, nil},
-			{"hanamaki", 1, nil},
-			{"hiraizumi", 1, nil},
-			{"hirono", 1, nil},
-			{"ichinohe", 1, nil},
-			{"ichinoseki", 1, nil},
-			{"iwaizumi", 1, nil},
-			{"iwate", 1, nil},
-			{"joboji", 1, nil},
-			{"kamaishi", 1, nil},
-			{"kanegasaki", 1, nil},
-			{"karumai", 1, nil},
-			{"kawai", 1, nil},
-			{"kitakami", 1, nil},
-			{"kuji", 1, nil},
-			{"kunohe", 1, nil},
-			{"kuzumaki", 1, nil},
-			{"miyako", 1, nil},
-			{"mizusawa", 1, nil},
-			{"morioka", 1, nil},
-			{"ninohe", 1, nil},
-			{"noda", 1, nil},
-			{"ofunato", 1, nil},
-			{"oshu", 1, nil},
-			{"otsuchi", 1, nil},
-			{"rikuzentakata", 1, nil},
-			{"shiwa", 1, nil},
-			{"shizukuishi", 1, nil},
-			{"sumita", 1, nil},
-			{"takizawa", 1, nil},
-			{"tanohata", 1, nil},
-			{"tono", 1, nil},
-			{"yahaba", 1, nil},
-			{"yamada", 1, nil},
-		},
-		},
-		{"kagawa", 1, []Node{
-			{"ayagawa", 1, nil},
-			{"higashikagawa", 1, nil},
-			{"kanonji", 1, nil},
-			{"kotohira", 1, nil},
-			{"manno", 1, nil},
-			{"marugame", 1, nil},
-			{"mitoyo", 1, nil},
-			{"naoshima", 1, nil},
-			{"sanuki", 1, nil},
-			{"tadotsu", 1, nil},
-			{"takamatsu", 1, nil},
-			{"tonosho", 1, nil},
-			{"uchinomi", 1, nil},
-			{"utazu", 1, nil},
-			{"zentsuji", 1, nil},
-		},
-		},
-		{"kagoshima", 1, []Node{
-			{"akune", 1, nil},
-			{"amami", 1, nil},
-			{"hioki", 1, nil},
-			{"isa", 1, nil},
-			{"isen", 1, nil},
-			{"izumi", 1, nil},
-			{"kagoshima", 1, nil},
-			{"kanoya", 1, nil},
-			{"kawanabe", 1, nil},
-			{"kinko", 1, nil},
-			{"kouyama", 1, nil},
-			{"makurazaki", 1, nil},
-			{"matsumoto", 1, nil},
-			{"minamitane", 1, nil},
-			{"nakatane", 1, nil},
-			{"nishinoomote", 1, nil},
-			{"satsumasendai", 1, nil},
-			{"soo", 1, nil},
-			{"tarumizu", 1, nil},
-			{"yusui", 1, nil},
-		},
-		},
-		{"kanagawa", 1, []Node{
-			{"aikawa", 1, nil},
-			{"atsugi", 1, nil},
-			{"ayase", 1, nil},
-			{"chigasaki", 1, nil},
-			{"ebina", 1, nil},
-			{"fujisawa", 1, nil},
-			{"hadano", 1, nil},
-			{"hakone", 1, nil},
-			{"hiratsuka", 1, nil},
-			{"isehara", 1, nil},
-			{"kaisei", 1, nil},
-			{"kamakura", 1, nil},
-			{"kiyokawa", 1, nil},
-			{"matsuda", 1, nil},
-			{"minamiashigara", 1, nil},
-			{"miura", 1, nil},
-			{"nakai", 1, nil},
-			{"ninomiya", 1, nil},
-			{"odawara", 1, nil},
-			{"oi", 1, nil},
-			{"oiso", 1, nil},
-			{"sagamihara", 1, nil},
-			{"samukawa", 1, nil},
-			{"tsukui", 1, nil},
-			{"yamakita", 1, nil},
-			{"yamato", 1, nil},
-			{"yokosuka", 1, nil},
-			{"yugawara", 1, nil},
-			{"zama", 1, nil},
-			{"zushi", 1, nil},
-		},
-		},
-		{"kawasaki", 3, []Node{
-			{"city", 2, nil},
-		},
-		},
-		{"kitakyushu", 3, []Node{
-			{"city", 2, nil},
-		},
-		},
-		{"kobe", 3, []Node{
-			{"city", 2, nil},
-		},
-		},
-		{"kochi", 1, []Node{
-			{"aki", 1, nil},
-			{"geisei", 1, nil},
-			{"hidaka", 1, nil},
-			{"higashitsuno", 1, nil},
-			{"ino", 1, nil},
-			{"kagami", 1, nil},
-			{"kami", 1, nil},
-			{"kitagawa", 1, nil},
-			{"kochi", 1, nil},
-			{"mihara", 1, nil},
-			{"motoyama", 1, nil},
-			{"muroto", 1, nil},
-			{"nahari", 1, nil},
-			{"nakamura", 1, nil},
-			{"nankoku", 1, nil},
-			{"nishitosa", 1, nil},
-			{"niyodogawa", 1, nil},
-			{"ochi", 1, nil},
-			{"okawa", 1, nil},
-			{"otoyo", 1, nil},
-			{"otsuki", 1, nil},
-			{"sakawa", 1, nil},
-			{"sukumo", 1, nil},
-			{"susaki", 1, nil},
-			{"tosa", 1, nil},
-			{"tosashimizu", 1, nil},
-			{"toyo", 1, nil},
-			{"tsuno", 1, nil},
-			{"umaji", 1, nil},
-			{"yasuda", 1, nil},
-			{"yusuhara", 1, nil},
-		},
-		},
-		{"kumamoto", 1, []Node{
-			{"amakusa", 1, nil},
-			{"arao", 1, nil},
-			{"aso", 1, nil},
-			{"choyo", 1, nil},
-			{"gyokuto", 1, nil},
-			{"hitoyoshi", 1, nil},
-			{"kamiamakusa", 1, nil},
-			{"kashima", 1, nil},
-			{"kikuchi", 1, nil},
-			{"kosa", 1, nil},
-			{"kumamoto", 1, nil},
-			{"mashiki", 1, nil},
-			{"mifune", 1, nil},
-			{"minamata", 1, nil},
-			{"minamioguni", 1, nil},
-			{"nagasu", 1, nil},
-			{"nishihara", 1, nil},
-			{"oguni", 1, nil},
-			{"ozu", 1, nil},
-			{"sumoto", 1, nil},
-			{"takamori", 1, nil},
-			{"uki", 1, nil},
-			{"uto", 1, nil},
-			{"yamaga", 1, nil},
-			{"yamato", 1, nil},
-			{"yatsushiro", 1, nil},
-		},
-		},
-		{"kyoto", 1, []Node{
-			{"ayabe", 1, nil},
-			{"fukuchiyama", 1, nil},
-			{"higashiyama", 1, nil},
-			{"ide", 1, nil},
-			{"ine", 1, nil},
-			{"joyo", 1, nil},
-			{"kameoka", 1, nil},
-			{"kamo", 1, nil},
-			{"kita", 1, nil},
-			{"kizu", 1, nil},
-			{"kumiyama", 1, nil},
-			{"kyotamba", 1, nil},
-			{"kyotanabe", 1, nil},
-			{"kyotango", 1, nil},
-			{"maizuru", 1, nil},
-			{"minami", 1, nil},
-			{"minamiyamashiro", 1, nil},
-			{"miyazu", 1, nil},
-			{"muko", 1, nil},
-			{"nagaokakyo", 1, nil},
-			{"nakagyo", 1, nil},
-			{"nantan", 1, nil},
-			{"oyamazaki", 1, nil},
-			{"sakyo", 1, nil},
-			{"seika", 1, nil},
-			{"tanabe", 1, nil},
-			{"uji", 1, nil},
-			{"ujitawara", 1, nil},
-			{"wazuka", 1, nil},
-			{"yamashina", 1, nil},
-			{"yawata", 1, nil},
-		},
-		},
-		{"lg", 1, nil},
-		{"mie", 1, []Node{
-			{"asahi", 1, nil},
-			{"inabe", 1, nil},
-			{"ise", 1, nil},
-			{"kameyama", 1, nil},
-			{"kawagoe", 1, nil},
-			{"kiho", 1, nil},
-			{"kisosaki", 1, nil},
-			{"kiwa", 1, nil},
-			{"komono", 1, nil},
-			{"kumano", 1, nil},
-			{"kuwana", 1, nil},
-			{"matsusaka", 1, nil},
-			{"meiwa", 1, nil},
-			{"mihama", 1, nil},
-			{"minamiise", 1, nil},
-			{"misugi", 1, nil},
-			{"miyama", 1, nil},
-			{"nabari", 1, nil},
-			{"shima", 1, nil},
-			{"suzuka", 1, nil},
-			{"tado", 1, nil},
-			{"taiki", 1, nil},
-			{"taki", 1, nil},
-			{"tamaki", 1, nil},
-			{"toba", 1, nil},
-			{"tsu", 1, nil},
-			{"udono", 1, nil},
-			{"ureshino", 1, nil},
-			{"watarai", 1, nil},
-			{"yokkaichi", 1, nil},
-		},
-		},
-		{"miyagi", 1, []Node{
-			{"furukawa", 1, nil},
-			{"higashimatsushima", 1, nil},
-			{"ishinomaki", 1, nil},
-			{"iwanuma", 1, nil},
-			{"kakuda", 1, nil},
-			{"kami", 1, nil},
-			{"kawasaki", 1, nil},
-			{"kesennuma", 1, nil},
-			{"marumori", 1, nil},
-			{"matsushima", 1, nil},
-			{"minamisanriku", 1, nil},
-			{"misato", 1, nil},
-			{"murata", 1, nil},
-			{"natori", 1, nil},
-			{"ogawara", 1, nil},
-			{"ohira", 1, nil},
-			{"onagawa", 1, nil},
-			{"osaki", 1, nil},
-			{"rifu", 1, nil},
-			{"semine", 1, nil},
-			{"shibata", 1, nil},
-			{"shichikashuku", 1, nil},
-			{"shikama", 1, nil},
-			{"shiogama", 1, nil},
-			{"shiroishi", 1, nil},
-			{"tagajo", 1, nil},
-			{"taiwa", 1, nil},
-			{"tome", 1, nil},
-			{"tomiya", 1, nil},
-			{"wakuya", 1, nil},
-			{"watari", 1, nil},
-			{"yamamoto", 1, nil},
-			{"zao", 1, nil},
-		},
-		},
-		{"miyazaki", 1, []Node{
-			{"aya", 1, nil},
-			{"ebino", 1, nil},
-			{"gokase", 1, nil},
-			{"hyuga", 1, nil},
-			{"kadogawa", 1, nil},
-			{"kawaminami", 1, nil},
-			{"kijo", 1, nil},
-			{"kitagawa", 1, nil},
-			{"kitakata", 1, nil},
-			{"kitaura", 1, nil},
-			{"kobayashi", 1, nil},
-			{"kunitomi", 1, nil},
-			{"kushima", 1, nil},
-			{"mimata", 1, nil},
-			{"miyakonojo", 1, nil},
-			{"miyazaki", 1, nil},
-			{"morotsuka", 1, nil},
-			{"nichinan", 1, nil},
-			{"nishimera", 1, nil},
-			{"nobeoka", 1, nil},
-			{"saito", 1, nil},
-			{"shiiba", 1, nil},
-			{"shintomi", 1, nil},
-			{"takaharu", 1, nil},
-			{"takanabe", 1, nil},
-			{"takazaki", 1, nil},
-			{"tsuno", 1, nil},
-		},
-		},
-		{"nagano", 1, []Node{
-			{"achi", 1, nil},
-			{"agematsu", 1, nil},
-			{"anan", 1, nil},
-			{"aoki", 1, nil},
-			{"asahi", 1, nil},
-			{"azumino", 1, nil},
-			{"chikuhoku", 1, nil},
-			{"chikuma", 1, nil},
-			{"chino", 1, nil},
-			{"fujimi", 1, nil},
-			{"hakuba", 1, nil},
-			{"hara", 1, nil},
-			{"hiraya", 1, nil},
-			{"iida", 1, nil},
-			{"iijima", 1, nil},
-			{"iiyama", 1, nil},
-			{"iizuna", 1, nil},
-			{"ikeda", 1, nil},
-			{"ikusaka", 1, nil},
-			{"ina", 1, nil},
-			{"karuizawa", 1, nil},
-			{"kawakami", 1, nil},
-			{"kiso", 1, nil},
-			{"kisofukushima", 1, nil},
-			{"kitaaiki", 1, nil},
-			{"komagane", 1, nil},
-			{"komoro", 1, nil},
-			{"matsukawa", 1, nil},
-			{"matsumoto", 1, nil},
-			{"miasa", 1, nil},
-			{"minamiaiki", 1, nil},
-			{"minamimaki", 1, nil},
-			{"minamiminowa", 1, nil},
-			{"minowa", 1, nil},
-			{"miyada", 1, nil},
-			{"miyota", 1, nil},
-			{"mochizuki", 1, nil},
-			{"nagano", 1, nil},
-			{"nagawa", 1, nil},
-			{"nagiso", 1, nil},
-			{"nakagawa", 1, nil},
-			{"nakano", 1, nil},
-			{"nozawaonsen", 1, nil},
-			{"obuse", 1, nil},
-			{"ogawa", 1, nil},
-			{"okaya", 1, nil},
-			{"omachi", 1, nil},
-			{"omi", 1, nil},
-			{"ookuwa", 1, nil},
-			{"ooshika", 1, nil},
-			{"otaki", 1, nil},
-			{"otari", 1, nil},
-			{"sakae", 1, nil},
-			{"sakaki", 1, nil},
-			{"saku", 1, nil},
-			{"sakuho", 1, nil},
-			{"shimosuwa", 1, nil},
-			{"shinanomachi", 1, nil},
-			{"shiojiri", 1, nil},
-			{"suwa", 1, nil},
-			{"suzaka", 1, nil},
-			{"takagi", 1, nil},
-			{"takamori", 1, nil},
-			{"takayama", 1, nil},
-			{"tateshina", 1, nil},
-			{"tatsuno", 1, nil},
-			{"togakushi", 1, nil},
-			{"togura", 1, nil},
-			{"tomi", 1, nil},
-			{"ueda", 1, nil},
-			{"wada", 1, nil},
-			{"yamagata", 1, nil},
-			{"yamanouchi", 1, nil},
-			{"yasaka", 1, nil},
-			{"yasuoka", 1, nil},
-		},
-		},
-		{"nagasaki", 1, []Node{
-			{"chijiwa", 1, nil},
-			{"futsu", 1, nil},
-			{"goto", 1, nil},
-			{"hasami", 1, nil},
-			{"hirado", 1, nil},
-			{"iki", 1, nil},
-			{"isahaya", 1, nil},
-			{"kawatana", 1, nil},
-			{"kuchinotsu", 1, nil},
-			{"matsuura", 1, nil},
-			{"nagasaki", 1, nil},
-			{"obama", 1, nil},
-			{"omura", 1, nil},
-			{"oseto", 1, nil},
-			{"saikai", 1, nil},
-			{"sasebo", 1, nil},
-			{"seihi", 1, nil},
-			{"shimabara", 1, nil},
-			{"shinkamigoto", 1, nil},
-			{"togitsu", 1, nil},
-			{"tsushima", 1, nil},
-			{"unzen", 1, nil},
-		},
-		},
-		{"nagoya", 3, []Node{
-			{"city", 2, nil},
-		},
-		},
-		{"nara", 1, []Node{
-			{"ando", 1, nil},
-			{"gose", 1, nil},
-			{"heguri", 1, nil},
-			{"higashiyoshino", 1, nil},
-			{"ikaruga", 1, nil},
-			{"ikoma", 1, nil},
-			{"kamikitayama", 1, nil},
-			{"kanmaki", 1, nil},
-			{"kashiba", 1, nil},
-			{"kashihara", 1, nil},
-			{"katsuragi", 1, nil},
-			{"kawai", 1, nil},
-			{"kawakami", 1, nil},
-			{"kawanishi", 1, nil},
-			{"koryo", 1, nil},
-			{"kurotaki", 1, nil},
-			{"mitsue", 1, nil},
-			{"miyake", 1, nil},
-			{"nara", 1, nil},
-			{"nosegawa", 1, nil},
-			{"oji", 1, nil},
-			{"ouda", 1, nil},
-			{"oyodo", 1, nil},
-			{"sakurai", 1, nil},
-			{"sango", 1, nil},
-			{"shimoichi", 1, nil},
-			{"shimokitayama", 1, nil},
-			{"shinjo", 1, nil},
-			{"soni", 1, nil},
-			{"takatori", 1, nil},
-			{"tawaramoto", 1, nil},
-			{"tenkawa", 1, nil},
-			{"tenri", 1, nil},
-			{"uda", 1, nil},
-			{"yamatokoriyama", 1, nil},
-			{"yamatotakada", 1, nil},
-			{"yamazoe", 1, nil},
-			{"yoshino", 1, nil},
-		},
-		},
-		{"ne", 1, nil},
-		{"niigata", 1, []Node{
-			{"aga", 1, nil},
-			{"agano", 1, nil},
-			{"gosen", 1, nil},
-			{"itoigawa", 1, nil},
-			{"izumozaki", 1, nil},
-			{"joetsu", 1, nil},
-			{"kamo", 1, nil},
-			{"kariwa", 1, nil},
-			{"kashiwazaki", 1, nil},
-			{"minamiuonuma", 1, nil},
-			{"mitsuke", 1, nil},
-			{"muika", 1, nil},
-			{"murakami", 1, nil},
-			{"myoko", 1, nil},
-			{"nagaoka", 1, nil},
-			{"niigata", 1, nil},
-			{"ojiya", 1, nil},
-			{"omi", 1, nil},
-			{"sado", 1, nil},
-			{"sanjo", 1, nil},
-			{"seiro", 1, nil},
-			{"seirou", 1, nil},
-			{"sekikawa", 1, nil},
-			{"shibata", 1, nil},
-			{"tagami", 1, nil},
-			{"tainai", 1, nil},
-			{"tochio", 1, nil},
-			{"tokamachi", 1, nil},
-			{"tsubame", 1, nil},
-			{"tsunan", 1, nil},
-			{"uonuma", 1, nil},
-			{"yahiko", 1, nil},
-			{"yoita", 1, nil},
-			{"yuzawa", 1, nil},
-		},
-		},
-		{"oita", 1, []Node{
-			{"beppu", 1, nil},
-			{"bungoono", 1, nil},
-			{"bungotakada", 1, nil},
-			{"hasama", 1, nil},
-			{"hiji", 1, nil},
-			{"himeshima", 1, nil},
-			{"hita", 1, nil},
-			{"kamitsue", 1, nil},
-			{"kokonoe", 1, nil},
-			{"kuju", 1, nil},
-			{"kunisaki", 1, nil},
-			{"kusu", 1, nil},
-			{"oita", 1, nil},
-			{"saiki", 1, nil},
-			{"taketa", 1, nil},
-			{"tsukumi", 1, nil},
-			{"usa", 1, nil},
-			{"usuki", 1, nil},
-			{"yufu", 1, nil},
-		},
-		},
-		{"okayama", 1, []Node{
-			{"akaiwa", 1, nil},
-			{"asakuchi", 1, nil},
-			{"bizen", 1, nil},
-			{"hayashima", 1, nil},
-			{"ibara", 1, nil},
-			{"kagamino", 1, nil},
-			{"kasaoka", 1, nil},
-			{"kibichuo", 1, nil},
-			{"kumenan", 1, nil},
-			{"kurashiki", 1, nil},
-			{"maniwa", 1, nil},
-			{"misaki", 1, nil},
-			{"nagi", 1, nil},
-			{"niimi", 1, nil},
-			{"nishiawakura", 1, nil},
-			{"okayama", 1, nil},
-			{"satosho", 1, nil},
-			{"setouchi", 1, nil},
-			{"shinjo", 1, nil},
-			{"shoo", 1, nil},
-			{"soja", 1, nil},
-			{"takahashi", 1, nil},
-			{"tamano", 1, nil},
-			{"tsuyama", 1, nil},
-			{"wake", 1, nil},
-			{"yakage", 1, nil},
-		},
-		},
-		{"okinawa", 1, []Node{
-			{"aguni", 1, nil},
-			{"ginowan", 1, nil},
-			{"ginoza", 1, nil},
-			{"gushikami", 1, nil},
-			{"haebaru", 1, nil},
-			{"higashi", 1, nil},
-			{"hirara", 1, nil},
-			{"iheya", 1, nil},
-			{"ishigaki", 1, nil},
-			{"ishikawa", 1, nil},
-			{"itoman", 1, nil},
-			{"izena", 1, nil},
-			{"kadena", 1, nil},
-			{"kin", 1, nil},
-			{"kitadaito", 1, nil},
-			{"kitanakagusuku", 1, nil},
-			{"kumejima", 1, nil},
-			{"kunigami", 1, nil},
-			{"minamidaito", 1, nil},
-			{"motobu", 1, nil},
-			{"nago", 1, nil},
-			{"naha", 1, nil},
-			{"nakagusuku", 1, nil},
-			{"nakijin", 1, nil},
-			{"nanjo", 1, nil},
-			{"nishihara", 1, nil},
-			{"ogimi", 1, nil},
-			{"okinawa", 1, nil},
-			{"onna", 1, nil},
-			{"shimoji", 1, nil},
-			{"taketomi", 1, nil},
-			{"tarama", 1, nil},
-			{"tokashiki", 1, nil},
-			{"tomigusuku", 1, nil},
-			{"tonaki", 1, nil},
-			{"urasoe", 1, nil},
-			{"uruma", 1, nil},
-			{"yaese", 1, nil},
-			{"yomitan", 1, nil},
-			{"yonabaru", 1, nil},
-			{"yonaguni", 1, nil},
-			{"zamami", 1, nil},
-		},
-		},
-		{"or", 1, nil},
-		{"osaka", 1, []Node{
-			{"abeno", 1, nil},
-			{"chihayaakasaka", 1, nil},
-			{"chuo", 1, nil},
-			{"daito", 1, nil},
-			{"fujiidera", 1, nil},
-			{"habikino", 1, nil},
-			{"hannan", 1, nil},
-			{"higashiosaka", 1, nil},
-			{"higashisumiyoshi", 1, nil},
-			{"higashiyodogawa", 1, nil},
-			{"hirakata", 1, nil},
-			{"ibaraki", 1, nil},
-			{"ikeda", 1, nil},
-			{"izumi", 1, nil},
-			{"izumiotsu", 1, nil},
-			{"izumisano", 1, nil},
-			{"kadoma", 1, nil},
-			{"kaizuka", 1, nil},
-			{"kanan", 1, nil},
-			{"kashiwara", 1, nil},
-			{"katano", 1, nil},
-			{"kawachinagano", 1, nil},
-			{"kishiwada", 1, nil},
-			{"kita", 1, nil},
-			{"kumatori", 1, nil},
-			{"matsubara", 1, nil},
-			{"minato", 1, nil},
-			{"minoh", 1, nil},
-			{"misaki", 1, nil},
-			{"moriguchi", 1, nil},
-			{"neyagawa", 1, nil},
-			{"nishi", 1, nil},
-			{"nose", 1, nil},
-			{"osakasayama", 1, nil},
-			{"sakai", 1, nil},
-			{"sayama", 1, nil},
-			{"sennan", 1, nil},
-			{"settsu", 1, nil},
-			{"shijonawate", 1, nil},
-			{"shimamoto", 1, nil},
-			{"suita", 1, nil},
-			{"tadaoka", 1, nil},
-			{"taishi", 1, nil},
-			{"tajiri", 1, nil},
-			{"takaishi", 1, nil},
-			{"takatsuki", 1, nil},
-			{"tondabayashi", 1, nil},
-			{"toyonaka", 1, nil},
-			{"toyono", 1, nil},
-			{"yao", 1, nil},
-		},
-		},
-		{"saga", 1, []Node{
-			{"ariake", 1, nil},
-			{"arita", 1, nil},
-			{"fukudomi", 1, nil},
-			{"genkai", 1, nil},
-			{"hamatama", 1, nil},
-			{"hizen", 1, nil},
-			{"imari", 1, nil},
-			{"kamimine", 1, nil},
-			{"kanzaki", 1, nil},
-			{"karatsu", 1, nil},
-			{"kashima", 1, nil},
-			{"kitagata", 1, nil},
-			{"kitahata", 1, nil},
-			{"kiyama", 1, nil},
-			{"kouhoku", 1, nil},
-			{"kyuragi", 1, nil},
-			{"nishiarita", 1, nil},
-			{"ogi", 1, nil},
-			{"omachi", 1, nil},
-			{"ouchi", 1, nil},
-			{"saga", 1, nil},
-			{"shiroishi", 1, nil},
-			{"taku", 1, nil},
-			{"tara", 1, nil},
-			{"tosu", 1, nil},
-			{"yoshinogari", 1, nil},
-		},
-		},
-		{"saitama", 1, []Node{
-			{"arakawa", 1, nil},
-			{"asaka", 1, nil},
-			{"chichibu", 1, nil},
-			{"fujimi", 1, nil},
-			{"fujimino", 1, nil},
-			{"fukaya", 1, nil},
-			{"hanno", 1, nil},
-			{"hanyu", 1, nil},
-			{"hasuda", 1, nil},
-			{"hatogaya", 1, nil},
-			{"hatoyama", 1, nil},
-			{"hidaka", 1, nil},
-			{"higashichichibu", 1, nil},
-			{"higashimatsuyama", 1, nil},
-			{"honjo", 1, nil},
-			{"ina", 1, nil},
-			{"iruma", 1, nil},
-			{"iwatsuki", 1, nil},
-			{"kamiizumi", 1, nil},
-			{"kamikawa", 1, nil},
-			{"kamisato", 1, nil},
-			{"kasukabe", 1, nil},
-			{"kawagoe", 1, nil},
-			{"kawaguchi", 1, nil},
-			{"kawajima", 1, nil},
-			{"kazo", 1, nil},
-			{"kitamoto", 1, nil},
-			{"koshigaya", 1, nil},
-			{"kounosu", 1, nil},
-			{"kuki", 1, nil},
-			{"kumagaya", 1, nil},
-			{"matsubushi", 1, nil},
-			{"minano", 1, nil},
-			{"misato", 1, nil},
-			{"miyashiro", 1, nil},
-			{"miyoshi", 1, nil},
-			{"moroyama", 1, nil},
-			{"nagatoro", 1, nil},
-			{"namegawa", 1, nil},
-			{"niiza", 1, nil},
-			{"ogano", 1, nil},
-			{"ogawa", 1, nil},
-			{"ogose", 1, nil},
-			{"okegawa", 1, nil},
-			{"omiya", 1, nil},
-			{"otaki", 1, nil},
-			{"ranzan", 1, nil},
-			{"ryokami", 1, nil},
-			{"saitama", 1, nil},
-			{"sakado", 1, nil},
-			{"satte", 1, nil},
-			{"sayama", 1, nil},
-			{"shiki", 1, nil},
-			{"shiraoka", 1, nil},
-			{"soka", 1, nil},
-			{"sugito", 1, nil},
-			{"toda", 1, nil},
-			{"tokigawa", 1, nil},
-			{"tokorozawa", 1, nil},
-			{"tsurugashima", 1, nil},
-			{"urawa", 1, nil},
-			{"warabi", 1, nil},
-			{"yashio", 1, nil},
-			{"yokoze", 1, nil},
-			{"yono", 1, nil},
-			{"yorii", 1, nil},
-			{"yoshida", 1, nil},
-			{"yoshikawa", 1, nil},
-			{"yoshimi", 1, nil},
-		},
-		},
-		{"sapporo", 3, []Node{
-			{"city", 2, nil},
-		},
-		},
-		{"sendai", 3, []Node{
-			{"city", 2, nil},
-		},
-		},
-		{"shiga", 1, []Node{
-			{"aisho", 1, nil},
-			{"gamo", 1, nil},
-			{"higashiomi", 1, nil},
-			{"hikone", 1, nil},
-			{"koka", 1, nil},
-			{"konan", 1, nil},
-			{"kosei", 1, nil},
-			{"koto", 1, nil},
-			{"kusatsu", 1, nil},
-			{"maibara", 1, nil},
-			{"moriyama", 1, nil},
-			{"nagahama", 1, nil},
-			{"nishiazai", 1, nil},
-			{"notogawa", 1, nil},
-			{"omihachiman", 1, nil},
-			{"otsu", 1, nil},
-			{"ritto", 1, nil},
-			{"ryuoh", 1, nil},
-			{"takashima", 1, nil},
-			{"takatsuki", 1, nil},
-			{"torahime", 1, nil},
-			{"toyosato", 1, nil},
-			{"yasu", 1, nil},
-		},
-		},
-		{"shimane", 1, []Node{
-			{"akagi", 1, nil},
-			{"ama", 1, nil},
-			{"gotsu", 1, nil},
-			{"hamada", 1, nil},
-			{"higashiizumo", 1, nil},
-			{"hikawa", 1, nil},
-			{"hikimi", 1, nil},
-			{"izumo", 1, nil},
-			{"kakinoki", 1, nil},
-			{"masuda", 1, nil},
-			{"matsue", 1, nil},
-			{"misato", 1, nil},
-			{"nishinoshima", 1, nil},
-			{"ohda", 1, nil},
-			{"okinoshima", 1, nil},
-			{"okuizumo", 1, nil},
-			{"shimane", 1, nil},
-			{"tamayu", 1, nil},
-			{"tsuwano", 1, nil},
-			{"unnan", 1, nil},
-			{"yakumo", 1, nil},
-			{"yasugi", 1, nil},
-			{"yatsuka", 1, nil},
-		},
-		},
-		{"shizuoka", 1, []Node{
-			{"arai", 1, nil},
-			{"atami", 1, nil},
-			{"fuji", 1, nil},
-			{"fujieda", 1, nil},
-			{"fujikawa", 1, nil},
-			{"fujinomiya", 1, nil},
-			{"fukuroi", 1, nil},
-			{"gotemba", 1, nil},
-			{"haibara", 1, nil},
-			{"hamamatsu", 1, nil},
-			{"higashiizu", 1, nil},
-			{"ito", 1, nil},
-			{"iwata", 1, nil},
-			{"izu", 1, nil},
-			{"izunokuni", 1, nil},
-			{"kakegawa", 1, nil},
-			{"kannami", 1, nil},
-			{"kawanehon", 1, nil},
-			{"kawazu", 1, nil},
-			{"kikugawa", 1, nil},
-			{"kosai", 1, nil},
-			{"makinohara", 1, nil},
-			{"matsuzaki", 1, nil},
-			{"minamiizu", 1, nil},
-			{"mishima", 1, nil},
-			{"morimachi", 1, nil},
-			{"nishiizu", 1, nil},
-			{"numazu", 1, nil},
-			{"omaezaki", 1, nil},
-			{"shimada", 1, nil},
-			{"shimizu", 1, nil},
-			{"shimoda", 1, nil},
-			{"shizuoka", 1, nil},
-			{"susono", 1, nil},
-			{"yaizu", 1, nil},
-			{"yoshida", 1, nil},
-		},
-		},
-		{"tochigi", 1, []Node{
-			{"ashikaga", 1, nil},
-			{"bato", 1, nil},
-			{"haga", 1, nil},
-			{"ichikai", 1, nil},
-			{"iwafune", 1, nil},
-			{"kaminokawa", 1, nil},
-			{"kanuma", 1, nil},
-			{"karasuyama", 1, nil},
-			{"kuroiso", 1, nil},
-			{"mashiko", 1, nil},
-			{"mibu", 1, nil},
-			{"moka", 1, nil},
-			{"motegi", 1, nil},
-			{"nasu", 1, nil},
-			{"nasushiobara", 1, nil},
-			{"nikko", 1, nil},
-			{"nishikata", 1, nil},
-			{"nogi", 1, nil},
-			{"ohira", 1, nil},
-			{"ohtawara", 1, nil},
-			{"oyama", 1, nil},
-			{"sakura", 1, nil},
-			{"sano", 1, nil},
-			{"shimotsuke", 1, nil},
-			{"shioya", 1, nil},
-			{"takanezawa", 1, nil},
-			{"tochigi", 1, nil},
-			{"tsuga", 1, nil},
-			{"ujiie", 1, nil},
-			{"utsunomiya", 1, nil},
-			{"yaita", 1, nil},
-		},
-		},
-		{"tokushima", 1, []Node{
-			{"aizumi", 1, nil},
-			{"anan", 1, nil},
-			{"ichiba", 1, nil},
-			{"itano", 1, nil},
-			{"kainan", 1, nil},
-			{"komatsushima", 1, nil},
-			{"matsushige", 1, nil},
-			{"mima", 1, nil},
-			{"minami", 1, nil},
-			{"miyoshi", 1, nil},
-			{"mugi", 1, nil},
-			{"nakagawa", 1, nil},
-			{"naruto", 1, nil},
-			{"sanagochi", 1, nil},
-			{"shishikui", 1, nil},
-			{"tokushima", 1, nil},
-			{"wajiki", 1, nil},
-		},
-		},
-		{"tokyo", 1, []Node{
-			{"adachi", 1, nil},
-			{"akiruno", 1, nil},
-			{"akishima", 1, nil},
-			{"aogashima", 1, nil},
-			{"arakawa", 1, nil},
-			{"bunkyo", 1, nil},
-			{"chiyoda", 1, nil},
-			{"chofu", 1, nil},
-			{"chuo", 1, nil},
-			{"edogawa", 1, nil},
-			{"fuchu", 1, nil},
-			{"fussa", 1, nil},
-			{"hachijo", 1, nil},
-			{"hachioji", 1, nil},
-			{"hamura", 1, nil},
-			{"higashikurume", 1, nil},
-			{"higashimurayama", 1, nil},
-			{"higashiyamato", 1, nil},
-			{"hino", 1, nil},
-			{"hinode", 1, nil},
-			{"hinohara", 1, nil},
-			{"inagi", 1, nil},
-			{"itabashi", 1, nil},
-			{"katsushika", 1, nil},
-			{"kita", 1, nil},
-			{"kiyose", 1, nil},
-			{"kodaira", 1, nil},
-			{"koganei", 1, nil},
-			{"kokubunji", 1, nil},
-			{"komae", 1, nil},
-			{"koto", 1, nil},
-			{"kouzushima", 1, nil},
-			{"kunitachi", 1, nil},
-			{"machida", 1, nil},
-			{"meguro", 1, nil},
-			{"minato", 1, nil},
-			{"mitaka", 1, nil},
-			{"mizuho", 1, nil},
-			{"musashimurayama", 1, nil},
-			{"musashino", 1, nil},
-			{"nakano", 1, nil},
-			{"nerima", 1, nil},
-			{"ogasawara", 1, nil},
-			{"okutama", 1, nil},
-			{"ome", 1, nil},
-			{"oshima", 1, nil},
-			{"ota", 1, nil},
-			{"setagaya", 1, nil},
-			{"shibuya", 1, nil},
-			{"shinagawa", 1, nil},
-			{"shinjuku", 1, nil},
-			{"suginami", 1, nil},
-			{"sumida", 1, nil},
-			{"tachikawa", 1, nil},
-			{"taito", 1, nil},
-			{"tama", 1, nil},
-			{"toshima", 1, nil},
-		},
-		},
-		{"tottori", 1, []Node{
-			{"chizu", 1, nil},
-			{"hino", 1, nil},
-			{"kawahara", 1, nil},
-			{"koge", 1, nil},
-			{"kotoura", 1, nil},
-			{"misasa", 1, nil},
-			{"nanbu", 1, nil},
-			{"nichinan", 1, nil},
-			{"sakaiminato", 1, nil},
-			{"tottori", 1, nil},
-			{"wakasa", 1, nil},
-			{"yazu", 1, nil},
-			{"yonago", 1, nil},
-		},
-		},
-		{"toyama", 1, []Node{
-			{"asahi", 1, nil},
-			{"fuchu", 1, nil},
-			{"fukumitsu", 1, nil},
-			{"funahashi", 1, nil},
-			{"himi", 1, nil},
-			{"imizu", 1, nil},
-			{"inami", 1, nil},
-			{"johana", 1, nil},
-			{"kamiichi", 1, nil},
-			{"kurobe", 1, nil},
-			{"nakaniikawa", 1, nil},
-			{"namerikawa", 1, nil},
-			{"nanto", 1, nil},
-			{"nyuzen", 1, nil},
-			{"oyabe", 1, nil},
-			{"taira", 1, nil},
-			{"takaoka", 1, nil},
-			{"tateyama", 1, nil},
-			{"toga", 1, nil},
-			{"tonami", 1, nil},
-			{"toyama", 1, nil},
-			{"unazuki", 1, nil},
-			{"uozu", 1, nil},
-			{"yamada", 1, nil},
-		},
-		},
-		{"wakayama", 1, []Node{
-			{"arida", 1, nil},
-			{"aridagawa", 1, nil},
-			{"gobo", 1, nil},
-			{"hashimoto", 1, nil},
-			{"hidaka", 1, nil},
-			{"hirogawa", 1, nil},
-			{"inami", 1, nil},
-			{"iwade", 1, nil},
-			{"kainan", 1, nil},
-			{"kamitonda", 1, nil},
-			{"katsuragi", 1, nil},
-			{"kimino", 1, nil},
-			{"kinokawa", 1, nil},
-			{"kitayama", 1, nil},
-			{"koya", 1, nil},
-			{"koza", 1, nil},
-			{"kozagawa", 1, nil},
-			{"kudoyama", 1, nil},
-			{"kushimoto", 1, nil},
-			{"mihama", 1, nil},
-			{"misato", 1, nil},
-			{"nachikatsuura", 1, nil},
-			{"shingu", 1, nil},
-			{"shirahama", 1, nil},
-			{"taiji", 1, nil},
-			{"tanabe", 1, nil},
-			{"wakayama", 1, nil},
-			{"yuasa", 1, nil},
-			{"yura", 1, nil},
-		},
-		},
-		{"yamagata", 1, []Node{
-			{"asahi", 1, nil},
-			{"funagata", 1, nil},
-			{"higashine", 1, nil},
-			{"iide", 1, nil},
-			{"kahoku", 1, nil},
-			{"kaminoyama", 1, nil},
-			{"kaneyama", 1, nil},
-			{"kawanishi", 1, nil},
-			{"mamurogawa", 1, nil},
-			{"mikawa", 1, nil},
-			{"murayama", 1, nil},
-			{"nagai", 1, nil},
-			{"nakayama", 1, nil},
-			{"nanyo", 1, nil},
-			{"nishikawa", 1, nil},
-			{"obanazawa", 1, nil},
-			{"oe", 1, nil},
-			{"oguni", 1, nil},
-			{"ohkura", 1, nil},
-			{"oishida", 1, nil},
-			{"sagae", 1, nil},
-			{"sakata", 1, nil},
-			{"sakegawa", 1, nil},
-			{"shinjo", 1, nil},
-			{"shirataka", 1, nil},
-			{"shonai", 1, nil},
-			{"takahata", 1, nil},
-			{"tendo", 1, nil},
-			{"tozawa", 1, nil},
-			{"tsuruoka", 1, nil},
-			{"yamagata", 1, nil},
-			{"yamanobe", 1, nil},
-			{"yonezawa", 1, nil},
-			{"yuza", 1, nil},
-		},
-		},
-		{"yamaguchi", 1, []Node{
-			{"abu", 1, nil},
-			{"hagi", 1, nil},
-			{"hikari", 1, nil},
-			{"hofu", 1, nil},
-			{"iwakuni", 1, nil},
-			{"kudamatsu", 1, nil},
-			{"mitou", 1, nil},
-			{"nagato", 1, nil},
-			{"oshima", 1, nil},
-			{"shimonoseki", 1, nil},
-			{"shunan", 1, nil},
-			{"tabuse", 1, nil},
-			{"tokuyama", 1, nil},
-			{"toyota", 1, nil},
-			{"ube", 1, nil},
-			{"yuu", 1, nil},
-		},
-		},
-		{"yamanashi", 1, []Node{
-			{"chuo", 1, nil},
-			{"doshi", 1, nil},
-			{"fuefuki", 1, nil},
-			{"fujikawa", 1, nil},
-			{"fujikawaguchiko", 1, nil},
-			{"fujiyoshida", 1, nil},
-			{"hayakawa", 1, nil},
-			{"hokuto", 1, nil},
-			{"ichikawamisato", 1, nil},
-			{"kai", 1, nil},
-			{"kofu", 1, nil},
-			{"koshu", 1, nil},
-			{"kosuge", 1, nil},
-			{"minami-alps", 1, nil},
-			{"minobu", 1, nil},
-			{"nakamichi", 1, nil},
-			{"nanbu", 1, nil},
-			{"narusawa", 1, nil},
-			{"nirasaki", 1, nil},
-			{"nishikatsura", 1, nil},
-			{"oshino", 1, nil},
-			{"otsuki", 1, nil},
-			{"showa", 1, nil},
-			{"tabayama", 1, nil},
-			{"tsuru", 1, nil},
-			{"uenohara", 1, nil},
-			{"yamanakako", 1, nil},
-			{"yamanashi", 1, nil},
-		},
-		},
-		{"yokohama", 3, []Node{
-			{"city", 2, nil},
-		},
-		},
-	},
-	},
-	{"ke", 3, nil},
-	{"kg", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"kh", 3, nil},
-	{"ki", 1, []Node{
-		{"biz", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"km", 1, []Node{
-		{"ass", 1, nil},
-		{"asso", 1, nil},
-		{"com", 1, nil},
-		{"coop", 1, nil},
-		{"edu", 1, nil},
-		{"gouv", 1, nil},
-		{"gov", 1, nil},
-		{"medecin", 1, nil},
-		{"mil", 1, nil},
-		{"nom", 1, nil},
-		{"notaires", 1, nil},
-		{"org", 1, nil},
-		{"pharmaciens", 1, nil},
-		{"prd", 1, nil},
-		{"presse", 1, nil},
-		{"tm", 1, nil},
-		{"veterinaire", 1, nil},
-	},
-	},
-	{"kn", 1, []Node{
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"kp", 0, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"org", 1, nil},
-		{"rep", 1, nil},
-		{"tra", 1, nil},
-	},
-	},
-	{"kr", 1, []Node{
-		{"ac", 1, nil},
-		{"busan", 1, nil},
-		{"chungbuk", 1, nil},
-		{"chungnam", 1, nil},
-		{"co", 1, nil},
-		{"daegu", 1, nil},
-		{"daejeon", 1, nil},
-		{"es", 1, nil},
-		{"gangwon", 1, nil},
-		{"go", 1, nil},
-		{"gwangju", 1, nil},
-		{"gyeongbuk", 1, nil},
-		{"gyeonggi", 1, nil},
-		{"gyeongnam", 1, nil},
-		{"hs", 1, nil},
-		{"incheon", 1, nil},
-		{"jeju", 1, nil},
-		{"jeonbuk", 1, nil},
-		{"jeonnam", 1, nil},
-		{"kg", 1, nil},
-		{"mil", 1, nil},
-		{"ms", 1, nil},
-		{"ne", 1, nil},
-		{"or", 1, nil},
-		{"pe", 1, nil},
-		{"re", 1, nil},
-		{"sc", 1, nil},
-		{"seoul", 1, nil},
-		{"ulsan", 1, nil},
-	},
-	},
-	{"kw", 3, nil},
-	{"ky", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"kz", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"la", 1, []Node{
-		{"c", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"int", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"per", 1, nil},
-	},
-	},
-	{"lb", 0, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"lc", 1, []Node{
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"li", 1, nil},
-	{"lk", 1, []Node{
-		{"assn", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"grp", 1, nil},
-		{"hotel", 1, nil},
-		{"int", 1, nil},
-		{"ltd", 1, nil},
-		{"net", 1, nil},
-		{"ngo", 1, nil},
-		{"org", 1, nil},
-		{"sch", 1, nil},
-		{"soc", 1, nil},
-		{"web", 1, nil},
-	},
-	},
-	{"lr", 0, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ls", 1, []Node{
-		{"co", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"lt", 1, []Node{
-		{"gov", 1, nil},
-	},
-	},
-	{"lu", 1, nil},
-	{"lv", 1, []Node{
-		{"asn", 1, nil},
-		{"com", 1, nil},
-		{"conf", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"id", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ly", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"id", 1, nil},
-		{"med", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"plc", 1, nil},
-		{"sch", 1, nil},
-	},
-	},
-	{"ma", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"press", 1, nil},
-	},
-	},
-	{"mc", 1, []Node{
-		{"asso", 1, nil},
-		{"tm", 1, nil},
-	},
-	},
-	{"md", 1, nil},
-	{"me", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"its", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"priv", 1, nil},
-	},
-	},
-	{"mg", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"nom", 1, nil},
-		{"org", 1, nil},
-		{"prd", 1, nil},
-		{"tm", 1, nil},
-	},
-	},
-	{"mh", 1, nil},
-	{"mil", 1, nil},
-	{"mk", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"inf", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ml", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gouv", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"presse", 1, nil},
-	},
-	},
-	{"mm", 3, nil},
-	{"mn", 1, []Node{
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"mo", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"mobi", 1, nil},
-	{"mp", 1, nil},
-	{"mq", 1, nil},
-	{"mr", 1, []Node{
-		{"gov", 1, nil},
-	},
-	},
-	{"ms", 1, nil},
-	{"mt", 3, nil},
-	{"mu", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"or", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"museum", 1, []Node{
-		{"academy", 1, nil},
-		{"agriculture", 1, nil},
-		{"air", 1, nil},
-		{"airguard", 1, nil},
-		{"alabama", 1, nil},
-		{"alaska", 1, nil},
-		{"amber", 1, nil},
-		{"ambulance", 1, nil},
-		{"american", 1, nil},
-		{"americana", 1, nil},
-		{"americanantiques", 1, nil},
-		{"americanart", 1, nil},
-		{"amsterdam", 1, nil},
-		{"and", 1, nil},
-		{"annefrank", 1, nil},
-		{"anthro", 1, nil},
-		{"anthropology", 1, nil},
-		{"antiques", 1, nil},
-		{"aquarium", 1, nil},
-		{"arboretum", 1, nil},
-		{"archaeological", 1, nil},
-		{"archaeology", 1, nil},
-		{"architecture", 1, nil},
-		{"art", 1, nil},
-		{"artanddesign", 1, nil},
-		{"artcenter", 1, nil},
-		{"artdeco", 1, nil},
-		{"arteducation", 1, nil},
-		{"artgallery", 1, nil},
-		{"arts", 1, nil},
-		{"artsandcrafts", 1, nil},
-		{"asmatart", 1, nil},
-		{"assassination", 1, nil},
-		{"assisi", 1, nil},
-		{"association", 1, nil},
-		{"astronomy", 1, nil},
-		{"atlanta", 1, nil},
-		{"austin", 1, nil},
-		{"australia", 1, nil},
-		{"automotive", 1, nil},
-		{"aviation", 1, nil},
-		{"axis", 1, nil},
-		{"badajoz", 1, nil},
-		{"baghdad", 1, nil},
-		{"bahn", 1, nil},
-		{"bale", 1, nil},
-		{"baltimore", 1, nil},
-		{"barcelona", 1, nil},
-		{"baseball", 1, nil},
-		{"basel", 1, nil},
-		{"baths", 1, nil},
-		{"bauern", 1, nil},
-		{"beauxarts", 1, nil},
-		{"beeldengeluid", 1, nil},
-		{"bellevue", 1, nil},
-		{"bergbau", 1, nil},
-		{"berkeley", 1, nil},
-		{"berlin", 1, nil},
-		{"bern", 1, nil},
-		{"bible", 1, nil},
-		{"bilbao", 1, nil},
-		{"bill", 1, nil},
-		{"birdart", 1, nil},
-		{"birthplace", 1, nil},
-		{"bonn", 1, nil},
-		{"boston", 1, nil},
-		{"botanical", 1, nil},
-		{"botanicalgarden", 1, nil},
-		{"botanicgarden", 1, nil},
-		{"botany", 1, nil},
-		{"brandywinevalley", 1, nil},
-		{"brasil", 1, nil},
-		{"bristol", 1, nil},
-		{"british", 1, nil},
-		{"britishcolumbia", 1, nil},
-		{"broadcast", 1, nil},
-		{"brunel", 1, nil},
-		{"brussel", 1, nil},
-		{"brussels", 1, nil},
-		{"bruxelles", 1, nil},
-		{"building", 1, nil},
-		{"burghof", 1, nil},
-		{"bus", 1, nil},
-		{"bushey", 1, nil},
-		{"cadaques", 1, nil},
-		{"california", 1, nil},
-		{"cambridge", 1, nil},
-		{"can", 1, nil},
-		{"canada", 1, nil},
-		{"capebreton", 1, nil},
-		{"carrier", 1, nil},
-		{"cartoonart", 1, nil},
-		{"casadelamoneda", 1, nil},
-		{"castle", 1, nil},
-		{"castres", 1, nil},
-		{"celtic", 1, nil},
-		{"center", 1, nil},
-		{"chattanooga", 1, nil},
-		{"cheltenham", 1, nil},
-		{"chesapeakebay", 1, nil},
-		{"chicago", 1, nil},
-		{"children", 1, nil},
-		{"childrens", 1, nil},
-		{"childrensgarden", 1, nil},
-		{"chiropractic", 1, nil},
-		{"chocolate", 1, nil},
-		{"christiansburg", 1, nil},
-		{"cincinnati", 1, nil},
-		{"cinema", 1, nil},
-		{"circus", 1, nil},
-		{"civilisation", 1, nil},
-		{"civilization", 1, nil},
-		{"civilwar", 1, nil},
-		{"clinton", 1, nil},
-		{"clock", 1, nil},
-		{"coal", 1, nil},
-		{"coastaldefence", 1, nil},
-		{"cody", 1, nil},
-		{"coldwar", 1, nil},
-		{"collection", 1, nil},
-		{"colonialwilliamsburg", 1, nil},
-		{"coloradoplateau", 1, nil},
-		{"columbia", 1, nil},
-		{"columbus", 1, nil},
-		{"communication", 1, nil},
-		{"communications", 1, nil},
-		{"community", 1, nil},
-		{"computer", 1, nil},
-		{"computerhistory", 1, nil},
-		{"comunicações", 1, nil},
-		{"contemporary", 1, nil},
-		{"contemporaryart", 1, nil},
-		{"convent", 1, nil},
-		{"copenhagen", 1, nil},
-		{"corporation", 1, nil},
-		{"correios-e-telecomunicações", 1, nil},
-		{"corvette", 1, nil},
-		{"costume", 1, nil},
-		{"countryestate", 1, nil},
-		{"county", 1, nil},
-		{"crafts", 1, nil},
-		{"cranbrook", 1, nil},
-		{"creation", 1, nil},
-		{"cultural", 1, nil},
-		{"culturalcenter", 1, nil},
-		{"culture", 1, nil},
-		{"cyber", 1, nil},
-		{"cymru", 1, nil},
-		{"dali", 1, nil},
-		{"dallas", 1, nil},
-		{"database", 1, nil},
-		{"ddr", 1, nil},
-		{"decorativearts", 1, nil},
-		{"delaware", 1, nil},
-		{"delmenhorst", 1, nil},
-		{"denmark", 1, nil},
-		{"depot", 1, nil},
-		{"design", 1, nil},
-		{"detroit", 1, nil},
-		{"dinosaur", 1, nil},
-		{"discovery", 1, nil},
-		{"dolls", 1, nil},
-		{"donostia", 1, nil},
-		{"durham", 1, nil},
-		{"eastafrica", 1, nil},
-		{"eastcoast", 1, nil},
-		{"education", 1, nil},
-		{"educational", 1, nil},
-		{"egyptian", 1, nil},
-		{"eisenbahn", 1, nil},
-		{"elburg", 1, nil},
-		{"elvendrell", 1, nil},
-		{"embroidery", 1, nil},
-		{"encyclopedic", 1, nil},
-		{"england", 1, nil},
-		{"entomology", 1, nil},
-		{"environment", 1, nil},
-		{"environmentalconservation", 1, nil},
-		{"epilepsy", 1, nil},
-		{"essex", 1, nil},
-		{"estate", 1, nil},
-		{"ethnology", 1, nil},
-		{"exeter", 1, nil},
-		{"exhibition", 1, nil},
-		{"family", 1, nil},
-		{"farm", 1, nil},
-		{"farmequipment", 1, nil},
-		{"farmers", 1, nil},
-		{"farmstead", 1, nil},
-		{"field", 1, nil},
-		{"figueres", 1, nil},
-		{"filatelia", 1, nil},
-		{"film", 1, nil},
-		{"fineart", 1, nil},
-		{"finearts", 1, nil},
-		{"finland", 1, nil},
-		{"flanders", 1, nil},
-		{"florida", 1, nil},
-		{"force", 1, nil},
-		{"fortmissoula", 1, nil},
-		{"fortworth", 1, nil},
-		{"foundation", 1, nil},
-		{"francaise", 1, nil},
-		{"frankfurt", 1, nil},
-		{"franziskaner", 1, nil},
-		{"freemasonry", 1, nil},
-		{"freiburg", 1, nil},
-		{"fribourg", 1, nil},
-		{"frog", 1, nil},
-		{"fundacio", 1, nil},
-		{"furniture", 1, nil},
-		{"gallery", 1, nil},
-		{"garden", 1, nil},
-		{"gateway", 1, nil},
-		{"geelvinck", 1, nil},
-		{"gemological", 1, nil},
-		{"geology", 1, nil},
-		{"georgia", 1, nil},
-		{"giessen", 1, nil},
-		{"glas", 1, nil},
-		{"glass", 1, nil},
-		{"gorge", 1, nil},
-		{"grandrapids", 1, nil},
-		{"graz", 1, nil},
-		{"guernsey", 1, nil},
-		{"halloffame", 1, nil},
-		{"hamburg", 1, nil},
-		{"handson", 1, nil},
-		{"harvestcelebration", 1, nil},
-		{"hawaii", 1, nil},
-		{"health", 1, nil},
-		{"heimatunduhren", 1, nil},
-		{"hellas", 1, nil},
-		{"helsinki", 1, nil},
-		{"hembygdsforbund", 1, nil},
-		{"heritage", 1, nil},
-		{"histoire", 1, nil},
-		{"historical", 1, nil},
-		{"historicalsociety", 1, nil},
-		{"historichouses", 1, nil},
-		{"historisch", 1, nil},
-		{"historisches", 1, nil},
-		{"history", 1, nil},
-		{"historyofscience", 1, nil},
-		{"horology", 1, nil},
-		{"house", 1, nil},
-		{"humanities", 1, nil},
-		{"illustration", 1, nil},
-		{"imageandsound", 1, nil},
-		{"indian", 1, nil},
-		{"indiana", 1, nil},
-		{"indianapolis", 1, nil},
-		{"indianmarket", 1, nil},
-		{"intelligence", 1, nil},
-		{"interactive", 1, nil},
-		{"iraq", 1, nil},
-		{"iron", 1, nil},
-		{"isleofman", 1, nil},
-		{"jamison", 1, nil},
-		{"jefferson", 1, nil},
-		{"jerusalem", 1, nil},
-		{"jewelry", 1, nil},
-		{"jewish", 1, nil},
-		{"jewishart", 1, nil},
-		{"jfk", 1, nil},
-		{"journalism", 1, nil},
-		{"judaica", 1, nil},
-		{"judygarland", 1, nil},
-		{"juedisches", 1, nil},
-		{"juif", 1, nil},
-		{"karate", 1, nil},
-		{"karikatur", 1, nil},
-		{"kids", 1, nil},
-		{"koebenhavn", 1, nil},
-		{"koeln", 1, nil},
-		{"kunst", 1, nil},
-		{"kunstsammlung", 1, nil},
-		{"kunstunddesign", 1, nil},
-		{"labor", 1, nil},
-		{"labour", 1, nil},
-		{"lajolla", 1, nil},
-		{"lancashire", 1, nil},
-		{"landes", 1, nil},
-		{"lans", 1, nil},
-		{"larsson", 1, nil},
-		{"lewismiller", 1, nil},
-		{"lincoln", 1, nil},
-		{"linz", 1, nil},
-		{"living", 1, nil},
-		{"livinghistory", 1, nil},
-		{"localhistory", 1, nil},
-		{"london", 1, nil},
-		{"losangeles", 1, nil},
-		{"louvre", 1, nil},
-		{"loyalist", 1, nil},
-		{"lucerne", 1, nil},
-		{"luxembourg", 1, nil},
-		{"luzern", 1, nil},
-		{"läns", 1, nil},
-		{"mad", 1, nil},
-		{"madrid", 1, nil},
-		{"mallorca", 1, nil},
-		{"manchester", 1, nil},
-		{"mansion", 1, nil},
-		{"mansions", 1, nil},
-		{"manx", 1, nil},
-		{"marburg", 1, nil},
-		{"maritime", 1, nil},
-		{"maritimo", 1, nil},
-		{"maryland", 1, nil},
-		{"marylhurst", 1, nil},
-		{"media", 1, nil},
-		{"medical", 1, nil},
-		{"medizinhistorisches", 1, nil},
-		{"meeres", 1, nil},
-		{"memorial", 1, nil},
-		{"mesaverde", 1, nil},
-		{"michigan", 1, nil},
-		{"midatlantic", 1, nil},
-		{"military", 1, nil},
-		{"mill", 1, nil},
-		{"miners", 1, nil},
-		{"mining", 1, nil},
-		{"minnesota", 1, nil},
-		{"missile", 1, nil},
-		{"missoula", 1, nil},
-		{"modern", 1, nil},
-		{"moma", 1, nil},
-		{"money", 1, nil},
-		{"monmouth", 1, nil},
-		{"monticello", 1, nil},
-		{"montreal", 1, nil},
-		{"moscow", 1, nil},
-		{"motorcycle", 1, nil},
-		{"muenchen", 1, nil},
-		{"muenster", 1, nil},
-		{"mulhouse", 1, nil},
-		{"muncie", 1, nil},
-		{"museet", 1, nil},
-		{"museumcenter", 1, nil},
-		{"museumvereniging", 1, nil},
-		{"music", 1, nil},
-		{"national", 1, nil},
-		{"nationalfirearms", 1, nil},
-		{"nationalheritage", 1, nil},
-		{"nativeamerican", 1, nil},
-		{"naturalhistory", 1, nil},
-		{"naturalhistorymuseum", 1, nil},
-		{"naturalsciences", 1, nil},
-		{"nature", 1, nil},
-		{"naturhistorisches", 1, nil},
-		{"natuurwetenschappen", 1, nil},
-		{"naumburg", 1, nil},
-		{"naval", 1, nil},
-		{"nebraska", 1, nil},
-		{"neues", 1, nil},
-		{"newhampshire", 1, nil},
-		{"newjersey", 1, nil},
-		{"newmexico", 1, nil},
-		{"newport", 1, nil},
-		{"newspaper", 1, nil},
-		{"newyork", 1, nil},
-		{"niepce", 1, nil},
-		{"norfolk", 1, nil},
-		{"north", 1, nil},
-		{"nrw", 1, nil},
-		{"nuernberg", 1, nil},
-		{"nuremberg", 1, nil},
-		{"nyc", 1, nil},
-		{"nyny", 1, nil},
-		{"oceanographic", 1, nil},
-		{"oceanographique", 1, nil},
-		{"omaha", 1, nil},
-		{"online", 1, nil},
-		{"ontario", 1, nil},
-		{"openair", 1, nil},
-		{"oregon", 1, nil},
-		{"oregontrail", 1, nil},
-		{"otago", 1, nil},
-		{"oxford", 1, nil},
-		{"pacific", 1, nil},
-		{"paderborn", 1, nil},
-		{"palace", 1, nil},
-		{"paleo", 1, nil},
-		{"palmsprings", 1, nil},
-		{"panama", 1, nil},
-		{"paris", 1, nil},
-		{"pasadena", 1, nil},
-		{"pharmacy", 1, nil},
-		{"philadelphia", 1, nil},
-		{"philadelphiaarea", 1, nil},
-		{"philately", 1, nil},
-		{"phoenix", 1, nil},
-		{"photography", 1, nil},
-		{"pilots", 1, nil},
-		{"pittsburgh", 1, nil},
-		{"planetarium", 1, nil},
-		{"plantation", 1, nil},
-		{"plants", 1, nil},
-		{"plaza", 1, nil},
-		{"portal", 1, nil},
-		{"portland", 1, nil},
-		{"portlligat", 1, nil},
-		{"posts-and-telecommunications", 1, nil},
-		{"preservation", 1, nil},
-		{"presidio", 1, nil},
-		{"press", 1, nil},
-		{"project", 1, nil},
-		{"public", 1, nil},
-		{"pubol", 1, nil},
-		{"quebec", 1, nil},
-		{"railroad", 1, nil},
-		{"railway", 1, nil},
-		{"research", 1, nil},
-		{"resistance", 1, nil},
-		{"riodejaneiro", 1, nil},
-		{"rochester", 1, nil},
-		{"rockart", 1, nil},
-		{"roma", 1, nil},
-		{"russia", 1, nil},
-		{"saintlouis", 1, nil},
-		{"salem", 1, nil},
-		{"salvadordali", 1, nil},
-		{"salzburg", 1, nil},
-		{"sandiego", 1, nil},
-		{"sanfrancisco", 1, nil},
-		{"santabarbara", 1, nil},
-		{"santacruz", 1, nil},
-		{"santafe", 1, nil},
-		{"saskatchewan", 1, nil},
-		{"satx", 1, nil},
-		{"savannahga", 1, nil},
-		{"schlesisches", 1, nil},
-		{"schoenbrunn", 1, nil},
-		{"schokoladen", 1, nil},
-		{"school", 1, nil},
-		{"schweiz", 1, nil},
-		{"science", 1, nil},
-		{"science-fiction", 1, nil},
-		{"scienceandhistory", 1, nil},
-		{"scienceandindustry", 1, nil},
-		{"sciencecenter", 1, nil},
-		{"sciencecenters", 1, nil},
-		{"sciencehistory", 1, nil},
-		{"sciences", 1, nil},
-		{"sciencesnaturelles", 1, nil},
-		{"scotland", 1, nil},
-		{"seaport", 1, nil},
-		{"settlement", 1, nil},
-		{"settlers", 1, nil},
-		{"shell", 1, nil},
-		{"sherbrooke", 1, nil},
-		{"sibenik", 1, nil},
-		{"silk", 1, nil},
-		{"ski", 1, nil},
-		{"skole", 1, nil},
-		{"society", 1, nil},
-		{"sologne", 1, nil},
-		{"soundandvision", 1, nil},
-		{"southcarolina", 1, nil},
-		{"southwest", 1, nil},
-		{"space", 1, nil},
-		{"spy", 1, nil},
-		{"square", 1, nil},
-		{"stadt", 1, nil},
-		{"stalbans", 1, nil},
-		{"starnberg", 1, nil},
-		{"state", 1, nil},
-		{"stateofdelaware", 1, nil},
-		{"station", 1, nil},
-		{"steam", 1, nil},
-		{"steiermark", 1, nil},
-		{"stjohn", 1, nil},
-		{"stockholm", 1, nil},
-		{"stpetersburg", 1, nil},
-		{"stuttgart", 1, nil},
-		{"suisse", 1, nil},
-		{"surgeonshall", 1, nil},
-		{"surrey", 1, nil},
-		{"svizzera", 1, nil},
-		{"sweden", 1, nil},
-		{"sydney", 1, nil},
-		{"tank", 1, nil},
-		{"tcm", 1, nil},
-		{"technology", 1, nil},
-		{"telekommunikation", 1, nil},
-		{"television", 1, nil},
-		{"texas", 1, nil},
-		{"textile", 1, nil},
-		{"theater", 1, nil},
-		{"time", 1, nil},
-		{"timekeeping", 1, nil},
-		{"topology", 1, nil},
-		{"torino", 1, nil},
-		{"touch", 1, nil},
-		{"town", 1, nil},
-		{"transport", 1, nil},
-		{"tree", 1, nil},
-		{"trolley", 1, nil},
-		{"trust", 1, nil},
-		{"trustee", 1, nil},
-		{"uhren", 1, nil},
-		{"ulm", 1, nil},
-		{"undersea", 1, nil},
-		{"university", 1, nil},
-		{"usa", 1, nil},
-		{"usantiques", 1, nil},
-		{"usarts", 1, nil},
-		{"uscountryestate", 1, nil},
-		{"usculture", 1, nil},
-		{"usdecorativearts", 1, nil},
-		{"usgarden", 1, nil},
-		{"ushistory", 1, nil},
-		{"ushuaia", 1, nil},
-		{"uslivinghistory", 1, nil},
-		{"utah", 1, nil},
-		{"uvic", 1, nil},
-		{"valley", 1, nil},
-		{"vantaa", 1, nil},
-		{"versailles", 1, nil},
-		{"viking", 1, nil},
-		{"village", 1, nil},
-		{"virginia", 1, nil},
-		{"virtual", 1, nil},
-		{"virtuel", 1, nil},
-		{"vlaanderen", 1, nil},
-		{"volkenkunde", 1, nil},
-		{"wales", 1, nil},
-		{"wallonie", 1, nil},
-		{"war", 1, nil},
-		{"washingtondc", 1, nil},
-		{"watch-and-clock", 1, nil},
-		{"watchandclock", 1, nil},
-		{"western", 1, nil},
-		{"westfalen", 1, nil},
-		{"whaling", 1, nil},
-		{"wildlife", 1, nil},
-		{"williamsburg", 1, nil},
-		{"windmill", 1, nil},
-		{"workshop", 1, nil},
-		{"york", 1, nil},
-		{"yorkshire", 1, nil},
-		{"yosemite", 1, nil},
-		{"youth", 1, nil},
-		{"zoological", 1, nil},
-		{"zoology", 1, nil},
-		{"иком", 1, nil},
-		{"ירושלים", 1, nil},
-	},
-	},
-	{"mv", 1, []Node{
-		{"aero", 1, nil},
-		{"biz", 1, nil},
-		{"com", 1, nil},
-		{"coop", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"int", 1, nil},
-		{"mil", 1, nil},
-		{"museum", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"pro", 1, nil},
-	},
-	},
-	{"mw", 1, []Node{
-		{"ac", 1, nil},
-		{"biz", 1, nil},
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"coop", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"int", 1, nil},
-		{"museum", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"mx", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gob", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"my", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"mz", 3, nil},
-	{"na", 1, []Node{
-		{"ca", 1, nil},
-		{"cc", 1, nil},
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"dr", 1, nil},
-		{"in", 1, nil},
-		{"info", 1, nil},
-		{"mobi", 1, nil},
-		{"mx", 1, nil},
-		{"name", 1, nil},
-		{"or", 1, nil},
-		{"org", 1, nil},
-		{"pro", 1, nil},
-		{"school", 1, nil},
-		{"tv", 1, nil},
-		{"us", 1, nil},
-		{"ws", 1, nil},
-	},
-	},
-	{"name", 1, []Node{
-		{"her", 0, []Node{
-			{"forgot", 1, nil},
-		},
-		},
-		{"his", 0, []Node{
-			{"forgot", 1, nil},
-		},
-		},
-	},
-	},
-	{"nc", 1, []Node{
-		{"asso", 1, nil},
-	},
-	},
-	{"ne", 1, nil},
-	{"net", 1, []Node{
-		{"at-band-camp", 1, nil},
-		{"blogdns", 1, nil},
-		{"broke-it", 1, nil},
-		{"buyshouses", 1, nil},
-		{"dnsalias", 1, nil},
-		{"dnsdojo", 1, nil},
-		{"does-it", 1, nil},
-		{"dontexist", 1, nil},
-		{"dynalias", 1, nil},
-		{"dynathome", 1, nil},
-		{"endofinternet", 1, nil},
-		{"from-az", 1, nil},
-		{"from-co", 1, nil},
-		{"from-la", 1, nil},
-		{"from-ny", 1, nil},
-		{"gb", 1, nil},
-		{"gets-it", 1, nil},
-		{"ham-radio-op", 1, nil},
-		{"homeftp", 1, nil},
-		{"homeip", 1, nil},
-		{"homelinux", 1, nil},
-		{"homeunix", 1, nil},
-		{"hu", 1, nil},
-		{"in-the-band", 1, nil},
-		{"is-a-chef", 1, nil},
-		{"is-a-geek", 1, nil},
-		{"isa-geek", 1, nil},
-		{"jp", 1, nil},
-		{"kicks-ass", 1, nil},
-		{"office-on-the", 1, nil},
-		{"podzone", 1, nil},
-		{"scrapper-site", 1, nil},
-		{"se", 1, nil},
-		{"selfip", 1, nil},
-		{"sells-it", 1, nil},
-		{"servebbs", 1, nil},
-		{"serveftp", 1, nil},
-		{"thruhere", 1, nil},
-		{"uk", 1, nil},
-		{"webhop", 1, nil},
-		{"za", 1, nil},
-	},
-	},
-	{"nf", 1, []Node{
-		{"arts", 1, nil},
-		{"com", 1, nil},
-		{"firm", 1, nil},
-		{"info", 1, nil},
-		{"net", 1, nil},
-		{"other", 1, nil},
-		{"per", 1, nil},
-		{"rec", 1, nil},
-		{"store", 1, nil},
-		{"web", 1, nil},
-	},
-	},
-	{"ng", 0, []Node{
-		{"ac", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ni", 3, nil},
-	{"nl", 1, []Node{
-		{"bv", 1, nil},
-		{"co", 1, nil},
-	},
-	},
-	{"no", 1, []Node{
-		{"aa", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"aarborte", 1, nil},
-		{"aejrie", 1, nil},
-		{"afjord", 1, nil},
-		{"agdenes", 1, nil},
-		{"ah", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"akershus", 0, []Node{
-			{"nes", 1, nil},
-		},
-		},
-		{"aknoluokta", 1, nil},
-		{"akrehamn", 1, nil},
-		{"al", 1, nil},
-		{"alaheadju", 1, nil},
-		{"alesund", 1, nil},
-		{"algard", 1, nil},
-		{"alstahaug", 1, nil},
-		{"alta", 1, nil},
-		{"alvdal", 1, nil},
-		{"amli", 1, nil},
-		{"amot", 1, nil},
-		{"andasuolo", 1, nil},
-		{"andebu", 1, nil},
-		{"andoy", 1, nil},
-		{"andøy", 1, nil},
-		{"ardal", 1, nil},
-		{"aremark", 1, nil},
-		{"arendal", 1, nil},
-		{"arna", 1, nil},
-		{"aseral", 1, nil},
-		{"asker", 1, nil},
-		{"askim", 1, nil},
-		{"askoy", 1, nil},
-		{"askvoll", 1, nil},
-		{"askøy", 1, nil},
-		{"asnes", 1, nil},
-		{"audnedaln", 1, nil},
-		{"aukra", 1, nil},
-		{"aure", 1, nil},
-		{"aurland", 1, nil},
-		{"aurskog-holand", 1, nil},
-		{"aurskog-høland", 1, nil},
-		{"austevoll", 1, nil},
-		{"austrheim", 1, nil},
-		{"averoy", 1, nil},
-		{"averøy", 1, nil},
-		{"badaddja", 1, nil},
-		{"bahcavuotna", 1, nil},
-		{"bahccavuotna", 1, nil},
-		{"baidar", 1, nil},
-		{"bajddar", 1, nil},
-		{"balat", 1, nil},
-		{"balestrand", 1, nil},
-		{"ballangen", 1, nil},
-		{"balsfjord", 1, nil},
-		{"bamble", 1, nil},
-		{"bardu", 1, nil},
-		{"barum", 1, nil},
-		{"batsfjord", 1, nil},
-		{"bearalvahki", 1, nil},
-		{"bearalváhki", 1, nil},
-		{"beardu", 1, nil},
-		{"beiarn", 1, nil},
-		{"berg", 1, nil},
-		{"bergen", 1, nil},
-		{"berlevag", 1, nil},
-		{"berlevåg", 1, nil},
-		{"bievat", 1, nil},
-		{"bievát", 1, nil},
-		{"bindal", 1, nil},
-		{"birkenes", 1, nil},
-		{"bjarkoy", 1, nil},
-		{"bjarkøy", 1, nil},
-		{"bjerkreim", 1, nil},
-		{"bjugn", 1, nil},
-		{"bodo", 1, nil},
-		{"bodø", 1, nil},
-		{"bokn", 1, nil},
-		{"bomlo", 1, nil},
-		{"bremanger", 1, nil},
-		{"bronnoy", 1, nil},
-		{"bronnoysund", 1, nil},
-		{"brumunddal", 1, nil},
-		{"bryne", 1, nil},
-		{"brønnøy", 1, nil},
-		{"brønnøysund", 1, nil},
-		{"bu", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"budejju", 1, nil},
-		{"buskerud", 0, []Node{
-			{"nes", 1, nil},
-		},
-		},
-		{"bygland", 1, nil},
-		{"bykle", 1, nil},
-		{"báhcavuotna", 1, nil},
-		{"báhccavuotna", 1, nil},
-		{"báidár", 1, nil},
-		{"bájddar", 1, nil},
-		{"bálát", 1, nil},
-		{"bådåddjå", 1, nil},
-		{"båtsfjord", 1, nil},
-		{"bærum", 1, nil},
-		{"bømlo", 1, nil},
-		{"cahcesuolo", 1, nil},
-		{"co", 1, nil},
-		{"davvenjarga", 1, nil},
-		{"davvenjárga", 1, nil},
-		{"davvesiida", 1, nil},
-		{"deatnu", 1, nil},
-		{"dep", 1, nil},
-		{"dielddanuorri", 1, nil},
-		{"divtasvuodna", 1, nil},
-		{"divttasvuotna", 1, nil},
-		{"donna", 1, nil},
-		{"dovre", 1, nil},
-		{"drammen", 1, nil},
-		{"drangedal", 1, nil},
-		{"drobak", 1, nil},
-		{"drøbak", 1, nil},
-		{"dyroy", 1, nil},
-		{"dyrøy", 1, nil},
-		{"dønna", 1, nil},
-		{"egersund", 1, nil},
-		{"eid", 1, nil},
-		{"eidfjord", 1, nil},
-		{"eidsberg", 1, nil},
-		{"eidskog", 1, nil},
-		{"eidsvoll", 1, nil},
-		{"eigersund", 1, nil},
-		{"elverum", 1, nil},
-		{"enebakk", 1, nil},
-		{"engerdal", 1, nil},
-		{"etne", 1, nil},
-		{"etnedal", 1, nil},
-		{"evenassi", 1, nil},
-		{"evenes", 1, nil},
-		{"evenášši", 1, nil},
-		{"evje-og-hornnes", 1, nil},
-		{"farsund", 1, nil},
-		{"fauske", 1, nil},
-		{"fedje", 1, nil},
-		{"fet", 1, nil},
-		{"fetsund", 1, nil},
-		{"fhs", 1, nil},
-		{"finnoy", 1, nil},
-		{"finnøy", 1, nil},
-		{"fitjar", 1, nil},
-		{"fjaler", 1, nil},
-		{"fjell", 1, nil},
-		{"fla", 1, nil},
-		{"flakstad", 1, nil},
-		{"flatanger", 1, nil},
-		{"flekkefjord", 1, nil},
-		{"flesberg", 1, nil},
-		{"flora", 1, nil},
-		{"floro", 1, nil},
-		{"florø", 1, nil},
-		{"flå", 1, nil},
-		{"fm", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"folkebibl", 1, nil},
-		{"folldal", 1, nil},
-		{"forde", 1, nil},
-		{"forsand", 1, nil},
-		{"fosnes", 1, nil},
-		{"frana", 1, nil},
-		{"fredrikstad", 1, nil},
-		{"frei", 1, nil},
-		{"frogn", 1, nil},
-		{"froland", 1, nil},
-		{"frosta", 1, nil},
-		{"froya", 1, nil},
-		{"fræna", 1, nil},
-		{"frøya", 1, nil},
-		{"fuoisku", 1, nil},
-		{"fuossko", 1, nil},
-		{"fusa", 1, nil},
-		{"fylkesbibl", 1, nil},
-		{"fyresdal", 1, nil},
-		{"førde", 1, nil},
-		{"gaivuotna", 1, nil},
-		{"galsa", 1, nil},
-		{"gamvik", 1, nil},
-		{"gangaviika", 1, nil},
-		{"gaular", 1, nil},
-		{"gausdal", 1, nil},
-		{"giehtavuoatna", 1, nil},
-		{"gildeskal", 1, nil},
-		{"gildeskål", 1, nil},
-		{"giske", 1, nil},
-		{"gjemnes", 1, nil},
-		{"gjerdrum", 1, nil},
-		{"gjerstad", 1, nil},
-		{"gjesdal", 1, nil},
-		{"gjovik", 1, nil},
-		{"gjøvik", 1, nil},
-		{"gloppen", 1, nil},
-		{"gol", 1, nil},
-		{"gran", 1, nil},
-		{"grane", 1, nil},
-		{"granvin", 1, nil},
-		{"gratangen", 1, nil},
-		{"grimstad", 1, nil},
-		{"grong", 1, nil},
-		{"grue", 1, nil},
-		{"gulen", 1, nil},
-		{"guovdageaidnu", 1, nil},
-		{"gáivuotna", 1, nil},
-		{"gálsá", 1, nil},
-		{"gáŋgaviika", 1, nil},
-		{"ha", 1, nil},
-		{"habmer", 1, nil},
-		{"hadsel", 1, nil},
-		{"hagebostad", 1, nil},
-		{"halden", 1, nil},
-		{"halsa", 1, nil},
-		{"hamar", 1, nil},
-		{"hamaroy", 1, nil},
-		{"hammarfeasta", 1, nil},
-		{"hammerfest", 1, nil},
-		{"hapmir", 1, nil},
-		{"haram", 1, nil},
-		{"hareid", 1, nil},
-		{"harstad", 1, nil},
-		{"hasvik", 1, nil},
-		{"hattfjelldal", 1, nil},
-		{"haugesund", 1, nil},
-		{"hedmark", 0, []Node{
-			{"os", 1, nil},
-			{"valer", 1, nil},
-			{"våler", 1, nil},
-		},
-		},
-		{"hemne", 1, nil},
-		{"hemnes", 1, nil},
-		{"hemsedal", 1, nil},
-		{"herad", 1, nil},
-		{"hitra", 1, nil},
-		{"hjartdal", 1, nil},
-		{"hjelmeland", 1, nil},
-		{"hl", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"hm", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"hobol", 1, nil},
-		{"hobøl", 1, nil},
-		{"hof", 1, nil},
-		{"hokksund", 1, nil},
-		{"hol", 1, nil},
-		{"hole", 1, nil},
-		{"holmestrand", 1, nil},
-		{"holtalen", 1, nil},
-		{"holtålen", 1, nil},
-		{"honefoss", 1, nil},
-		{"hordaland", 0, []Node{
-			{"os", 1, nil},
-		},
-		},
-		{"hornindal", 1, nil},
-		{"horten", 1, nil},
-		{"hoyanger", 1, nil},
-		{"hoylandet", 1, nil},
-		{"hurdal", 1, nil},
-		{"hurum", 1, nil},
-		{"hvaler", 1, nil},
-		{"hyllestad", 1, nil},
-		{"hábmer", 1, nil},
-		{"hámmárfeasta", 1, nil},
-		{"hápmir", 1, nil},
-		{"hå", 1, nil},
-		{"hægebostad", 1, nil},
-		{"hønefoss", 1, nil},
-		{"høyanger", 1, nil},
-		{"høylandet", 1, nil},
-		{"ibestad", 1, nil},
-		{"idrett", 1, nil},
-		{"inderoy", 1, nil},
-		{"inderøy", 1, nil},
-		{"iveland", 1, nil},
-		{"ivgu", 1, nil},
-		{"jan-mayen", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"jessheim", 1, nil},
-		{"jevnaker", 1, nil},
-		{"jolster", 1, nil},
-		{"jondal", 1, nil},
-		{"jorpeland", 1, nil},
-		{"jølster", 1, nil},
-		{"jørpeland", 1, nil},
-		{"kafjord", 1, nil},
-		{"karasjohka", 1, nil},
-		{"karasjok", 1, nil},
-		{"karlsoy", 1, nil},
-		{"karmoy", 1, nil},
-		{"karmøy", 1, nil},
-		{"kautokeino", 1, nil},
-		{"kirkenes", 1, nil},
-		{"klabu", 1, nil},
-		{"klepp", 1, nil},
-		{"klæbu", 1, nil},
-		{"kommune", 1, nil},
-		{"kongsberg", 1, nil},
-		{"kongsvinger", 1, nil},
-		{"kopervik", 1, nil},
-		{"kraanghke", 1, nil},
-		{"kragero", 1, nil},
-		{"kragerø", 1, nil},
-		{"kristiansand", 1, nil},
-		{"kristiansund", 1, nil},
-		{"krodsherad", 1, nil},
-		{"krokstadelva", 1, nil},
-		{"kråanghke", 1, nil},
-		{"krødsherad", 1, nil},
-		{"kvafjord", 1, nil},
-		{"kvalsund", 1, nil},
-		{"kvam", 1, nil},
-		{"kvanangen", 1, nil},
-		{"kvinesdal", 1, nil},
-		{"kvinnherad", 1, nil},
-		{"kviteseid", 1, nil},
-		{"kvitsoy", 1, nil},
-		{"kvitsøy", 1, nil},
-		{"kvæfjord", 1, nil},
-		{"kvænangen", 1, nil},
-		{"kárášjohka", 1, nil},
-		{"kåfjord", 1, nil},
-		{"laakesvuemie", 1, nil},
-		{"lahppi", 1, nil},
-		{"langevag", 1, nil},
-		{"langevåg", 1, nil},
-		{"lardal", 1, nil},
-		{"larvik", 1, nil},
-		{"lavagis", 1, nil},
-		{"lavangen", 1, nil},
-		{"leangaviika", 1, nil},
-		{"leaŋgaviika", 1, nil},
-		{"lebesby", 1, nil},
-		{"leikanger", 1, nil},
-		{"leirfjord", 1, nil},
-		{"leirvik", 1, nil},
-		{"leka", 1, nil},
-		{"leksvik", 1, nil},
-		{"lenvik", 1, nil},
-		{"lerdal", 1, nil},
-		{"lesja", 1, nil},
-		{"levanger", 1, nil},
-		{"lier", 1, nil},
-		{"lierne", 1, nil},
-		{"lillehammer", 1, nil},
-		{"lillesand", 1, nil},
-		{"lindas", 1, nil},
-		{"lindesnes", 1, nil},
-		{"lindås", 1, nil},
-		{"loabat", 1, nil},
-		{"loabát", 1, nil},
-		{"lodingen", 1, nil},
-		{"lom", 1, nil},
-		{"loppa", 1, nil},
-		{"lorenskog", 1, nil},
-		{"loten", 1, nil},
-		{"lund", 1, nil},
-		{"lunner", 1, nil},
-		{"luroy", 1, nil},
-		{"lurøy", 1, nil},
-		{"luster", 1, nil},
-		{"lyngdal", 1, nil},
-		{"lyngen", 1, nil},
-		{"láhppi", 1, nil},
-		{"lærdal", 1, nil},
-		{"lødingen", 1, nil},
-		{"lørenskog", 1, nil},
-		{"løten", 1, nil},
-		{"malatvuopmi", 1, nil},
-		{"malselv", 1, nil},
-		{"malvik", 1, nil},
-		{"mandal", 1, nil},
-		{"marker", 1, nil},
-		{"marnardal", 1, nil},
-		{"masfjorden", 1, nil},
-		{"masoy", 1, nil},
-		{"matta-varjjat", 1, nil},
-		{"meland", 1, nil},
-		{"meldal", 1, nil},
-		{"melhus", 1, nil},
-		{"meloy", 1, nil},
-		{"meløy", 1, nil},
-		{"meraker", 1, nil},
-		{"meråker", 1, nil},
-		{"midsund", 1, nil},
-		{"midtre-gauldal", 1, nil},
-		{"mil", 1, nil},
-		{"mjondalen", 1, nil},
-		{"mjøndalen", 1, nil},
-		{"mo-i-rana", 1, nil},
-		{"moareke", 1, nil},
-		{"modalen", 1, nil},
-		{"modum", 1, nil},
-		{"molde", 1, nil},
-		{"more-og-romsdal", 0, []Node{
-			{"heroy", 1, nil},
-			{"sande", 1, nil},
-		},
-		},
-		{"mosjoen", 1, nil},
-		{"mosjøen", 1, nil},
-		{"moskenes", 1, nil},
-		{"moss", 1, nil},
-		{"mosvik", 1, nil},
-		{"moåreke", 1, nil},
-		{"mr", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"muosat", 1, nil},
-		{"muosát", 1, nil},
-		{"museum", 1, nil},
-		{"málatvuopmi", 1, nil},
-		{"mátta-várjjat", 1, nil},
-		{"målselv", 1, nil},
-		{"måsøy", 1, nil},
-		{"møre-og-romsdal", 0, []Node{
-			{"herøy", 1, nil},
-			{"sande", 1, nil},
-		},
-		},
-		{"naamesjevuemie", 1, nil},
-		{"namdalseid", 1, nil},
-		{"namsos", 1, nil},
-		{"namsskogan", 1, nil},
-		{"nannestad", 1, nil},
-		{"naroy", 1, nil},
-		{"narviika", 1, nil},
-		{"narvik", 1, nil},
-		{"naustdal", 1, nil},
-		{"navuotna", 1, nil},
-		{"nedre-eiker", 1, nil},
-		{"nesna", 1, nil},
-		{"nesodden", 1, nil},
-		{"nesoddtangen", 1, nil},
-		{"nesseby", 1, nil},
-		{"nesset", 1, nil},
-		{"nissedal", 1, nil},
-		{"nittedal", 1, nil},
-		{"nl", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"nord-aurdal", 1, nil},
-		{"nord-fron", 1, nil},
-		{"nord-odal", 1, nil},
-		{"norddal", 1, nil},
-		{"nordkapp", 1, nil},
-		{"nordland", 0, []Node{
-			{"bo", 1, nil},
-			{"bø", 1, nil},
-			{"heroy", 1, nil},
-			{"herøy", 1, nil},
-		},
-		},
-		{"nordre-land", 1, nil},
-		{"nordreisa", 1, nil},
-		{"nore-og-uvdal", 1, nil},
-		{"notodden", 1, nil},
-		{"notteroy", 1, nil},
-		{"nt", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"návuotna", 1, nil},
-		{"nååmesjevuemie", 1, nil},
-		{"nærøy", 1, nil},
-		{"nøtterøy", 1, nil},
-		{"odda", 1, nil},
-		{"of", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"oksnes", 1, nil},
-		{"ol", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"omasvuotna", 1, nil},
-		{"oppdal", 1, nil},
-		{"oppegard", 1, nil},
-		{"oppegård", 1, nil},
-		{"orkanger", 1, nil},
-		{"orkdal", 1, nil},
-		{"orland", 1, nil},
-		{"orskog", 1, nil},
-		{"orsta", 1, nil},
-		{"osen", 1, nil},
-		{"oslo", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"osoyro", 1, nil},
-		{"osteroy", 1, nil},
-		{"osterøy", 1, nil},
-		{"ostfold", 0, []Node{
-			{"valer", 1, nil},
-		},
-		},
-		{"ostre-toten", 1, nil},
-		{"osøyro", 1, nil},
-		{"overhalla", 1, nil},
-		{"ovre-eiker", 1, nil},
-		{"oyer", 1, nil},
-		{"oygarden", 1, nil},
-		{"oystre-slidre", 1, nil},
-		{"porsanger", 1, nil},
-		{"porsangu", 1, nil},
-		{"porsgrunn", 1, nil},
-		{"porsáŋgu", 1, nil},
-		{"priv", 1, nil},
-		{"rade", 1, nil},
-		{"radoy", 1, nil},
-		{"radøy", 1, nil},
-		{"rahkkeravju", 1, nil},
-		{"raholt", 1, nil},
-		{"raisa", 1, nil},
-		{"rakkestad", 1, nil},
-		{"ralingen", 1, nil},
-		{"rana", 1, nil},
-		{"randaberg", 1, nil},
-		{"rauma", 1, nil},
-		{"rendalen", 1, nil},
-		{"rennebu", 1, nil},
-		{"rennesoy", 1, nil},
-		{"rennesøy", 1, nil},
-		{"rindal", 1, nil},
-		{"ringebu", 1, nil},
-		{"ringerike", 1, nil},
-		{"ringsaker", 1, nil},
-		{"risor", 1, nil},
-		{"rissa", 1, nil},
-		{"risør", 1, nil},
-		{"rl", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"roan", 1, nil},
-		{"rodoy", 1, nil},
-		{"rollag", 1, nil},
-		{"romsa", 1, nil},
-		{"romskog", 1, nil},
-		{"roros", 1, nil},
-		{"rost", 1, nil},
-		{"royken", 1, nil},
-		{"royrvik", 1, nil},
-		{"ruovat", 1, nil},
-		{"rygge", 1, nil},
-		{"ráhkkerávju", 1, nil},
-		{"ráisa", 1, nil},
-		{"råde", 1, nil},
-		{"råholt", 1, nil},
-		{"rælingen", 1, nil},
-		{"rødøy", 1, nil},
-		{"rømskog", 1, nil},
-		{"røros", 1, nil},
-		{"røst", 1, nil},
-		{"røyken", 1, nil},
-		{"røyrvik", 1, nil},
-		{"salangen", 1, nil},
-		{"salat", 1, nil},
-		{"saltdal", 1, nil},
-		{"samnanger", 1, nil},
-		{"sandefjord", 1, nil},
-		{"sandnes", 1, nil},
-		{"sandnessjoen", 1, nil},
-		{"sandnessjøen", 1, nil},
-		{"sandoy", 1, nil},
-		{"sandøy", 1, nil},
-		{"sarpsborg", 1, nil},
-		{"sauda", 1, nil},
-		{"sauherad", 1, nil},
-		{"sel", 1, nil},
-		{"selbu", 1, nil},
-		{"selje", 1, nil},
-		{"seljord", 1, nil},
-		{"sf", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"siellak", 1, nil},
-		{"sigdal", 1, nil},
-		{"siljan", 1, nil},
-		{"sirdal", 1, nil},
-		{"skanit", 1, nil},
-		{"skanland", 1, nil},
-		{"skaun", 1, nil},
-		{"skedsmo", 1, nil},
-		{"skedsmokorset", 1, nil},
-		{"ski", 1, nil},
-		{"skien", 1, nil},
-		{"skierva", 1, nil},
-		{"skiervá", 1, nil},
-		{"skiptvet", 1, nil},
-		{"skjak", 1, nil},
-		{"skjervoy", 1, nil},
-		{"skjervøy", 1, nil},
-		{"skjåk", 1, nil},
-		{"skodje", 1, nil},
-		{"skánit", 1, nil},
-		{"skånland", 1, nil},
-		{"slattum", 1, nil},
-		{"smola", 1, nil},
-		{"smøla", 1, nil},
-		{"snaase", 1, nil},
-		{"snasa", 1, nil},
-		{"snillfjord", 1, nil},
-		{"snoasa", 1, nil},
-		{"snåase", 1, nil},
-		{"snåsa", 1, nil},
-		{"sogndal", 1, nil},
-		{"sogne", 1, nil},
-		{"sokndal", 1, nil},
-		{"sola", 1, nil},
-		{"solund", 1, nil},
-		{"somna", 1, nil},
-		{"sondre-land", 1, nil},
-		{"songdalen", 1, nil},
-		{"sor-aurdal", 1, nil},
-		{"sor-fron", 1, nil},
-		{"sor-odal", 1, nil},
-		{"sor-varanger", 1, nil},
-		{"sorfold", 1, nil},
-		{"sorreisa", 1, nil},
-		{"sortland", 1, nil},
-		{"sorum", 1, nil},
-		{"spjelkavik", 1, nil},
-		{"spydeberg", 1, nil},
-		{"st", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"stange", 1, nil},
-		{"stat", 1, nil},
-		{"stathelle", 1, nil},
-		{"stavanger", 1, nil},
-		{"stavern", 1, nil},
-		{"steigen", 1, nil},
-		{"steinkjer", 1, nil},
-		{"stjordal", 1, nil},
-		{"stjordalshalsen", 1, nil},
-		{"stjørdal", 1, nil},
-		{"stjørdalshalsen", 1, nil},
-		{"stokke", 1, nil},
-		{"stor-elvdal", 1, nil},
-		{"stord", 1, nil},
-		{"stordal", 1, nil},
-		{"storfjord", 1, nil},
-		{"strand", 1, nil},
-		{"stranda", 1, nil},
-		{"stryn", 1, nil},
-		{"sula", 1, nil},
-		{"suldal", 1, nil},
-		{"sund", 1, nil},
-		{"sunndal", 1, nil},
-		{"surnadal", 1, nil},
-		{"svalbard", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"sveio", 1, nil},
-		{"svelvik", 1, nil},
-		{"sykkylven", 1, nil},
-		{"sálat", 1, nil},
-		{"sálát", 1, nil},
-		{"søgne", 1, nil},
-		{"sømna", 1, nil},
-		{"søndre-land", 1, nil},
-		{"sør-aurdal", 1, nil},
-		{"sør-fron", 1, nil},
-		{"sør-odal", 1, nil},
-		{"sør-varanger", 1, nil},
-		{"sørfold", 1, nil},
-		{"sørreisa", 1, nil},
-		{"sørum", 1, nil},
-		{"tana", 1, nil},
-		{"tananger", 1, nil},
-		{"telemark", 0, []Node{
-			{"bo", 1, nil},
-			{"bø", 1, nil},
-		},
-		},
-		{"time", 1, nil},
-		{"tingvoll", 1, nil},
-		{"tinn", 1, nil},
-		{"tjeldsund", 1, nil},
-		{"tjome", 1, nil},
-		{"tjøme", 1, nil},
-		{"tm", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"tokke", 1, nil},
-		{"tolga", 1, nil},
-		{"tonsberg", 1, nil},
-		{"torsken", 1, nil},
-		{"tr", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"trana", 1, nil},
-		{"tranby", 1, nil},
-		{"tranoy", 1, nil},
-		{"tranøy", 1, nil},
-		{"troandin", 1, nil},
-		{"trogstad", 1, nil},
-		{"tromsa", 1, nil},
-		{"tromso", 1, nil},
-		{"tromsø", 1, nil},
-		{"trondheim", 1, nil},
-		{"trysil", 1, nil},
-		{"træna", 1, nil},
-		{"trøgstad", 1, nil},
-		{"tvedestrand", 1, nil},
-		{"tydal", 1, nil},
-		{"tynset", 1, nil},
-		{"tysfjord", 1, nil},
-		{"tysnes", 1, nil},
-		{"tysvar", 1, nil},
-		{"tysvær", 1, nil},
-		{"tønsberg", 1, nil},
-		{"ullensaker", 1, nil},
-		{"ullensvang", 1, nil},
-		{"ulvik", 1, nil},
-		{"unjarga", 1, nil},
-		{"unjárga", 1, nil},
-		{"utsira", 1, nil},
-		{"va", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"vaapste", 1, nil},
-		{"vadso", 1, nil},
-		{"vadsø", 1, nil},
-		{"vaga", 1, nil},
-		{"vagan", 1, nil},
-		{"vagsoy", 1, nil},
-		{"vaksdal", 1, nil},
-		{"valle", 1, nil},
-		{"vang", 1, nil},
-		{"vanylven", 1, nil},
-		{"vardo", 1, nil},
-		{"vardø", 1, nil},
-		{"varggat", 1, nil},
-		{"varoy", 1, nil},
-		{"vefsn", 1, nil},
-		{"vega", 1, nil},
-		{"vegarshei", 1, nil},
-		{"vegårshei", 1, nil},
-		{"vennesla", 1, nil},
-		{"verdal", 1, nil},
-		{"verran", 1, nil},
-		{"vestby", 1, nil},
-		{"vestfold", 0, []Node{
-			{"sande", 1, nil},
-		},
-		},
-		{"vestnes", 1, nil},
-		{"vestre-slidre", 1, nil},
-		{"vestre-toten", 1, nil},
-		{"vestvagoy", 1, nil},
-		{"vestvågøy", 1, nil},
-		{"vevelstad", 1, nil},
-		{"vf", 1, []Node{
-			{"gs", 1, nil},
-		},
-		},
-		{"vgs", 1, nil},
-		{"vik", 1, nil},
-		{"vikna", 1, nil},
-		{"vindafjord", 1, nil},
-		{"voagat", 1, nil},
-		{"volda", 1, nil},
-		{"voss", 1, nil},
-		{"vossevangen", 1, nil},
-		{"várggát", 1, nil},
-		{"vågan", 1, nil},
-		{"vågsøy", 1, nil},
-		{"vågå", 1, nil},
-		{"værøy", 1, nil},
-		{"ákŋoluokta", 1, nil},
-		{"álaheadju", 1, nil},
-		{"áltá", 1, nil},
-		{"åfjord", 1, nil},
-		{"åkrehamn", 1, nil},
-		{"ål", 1, nil},
-		{"ålesund", 1, nil},
-		{"ålgård", 1, nil},
-		{"åmli", 1, nil},
-		{"åmot", 1, nil},
-		{"årdal", 1, nil},
-		{"ås", 1, nil},
-		{"åseral", 1, nil},
-		{"åsnes", 1, nil},
-		{"øksnes", 1, nil},
-		{"ørland", 1, nil},
-		{"ørskog", 1, nil},
-		{"ørsta", 1, nil},
-		{"østfold", 0, []Node{
-			{"våler", 1, nil},
-		},
-		},
-		{"østre-toten", 1, nil},
-		{"øvre-eiker", 1, nil},
-		{"øyer", 1, nil},
-		{"øygarden", 1, nil},
-		{"øystre-slidre", 1, nil},
-		{"čáhcesuolo", 1, nil},
-	},
-	},
-	{"np", 3, nil},
-	{"nr", 1, []Node{
-		{"biz", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"nu", 1, []Node{
-		{"merseine", 1, nil},
-		{"mine", 1, nil},
-		{"shacknet", 1, nil},
-	},
-	},
-	{"nz", 3, nil},
-	{"om", 3, []Node{
-		{"mediaphone", 2, nil},
-		{"nawras", 2, nil},
-		{"nawrastelecom", 2, nil},
-		{"omanmobile", 2, nil},
-		{"omanpost", 2, nil},
-		{"omantel", 2, nil},
-		{"rakpetroleum", 2, nil},
-		{"siemens", 2, nil},
-		{"songfest", 2, nil},
-		{"statecouncil", 2, nil},
-	},
-	},
-	{"org", 1, []Node{
-		{"ae", 1, nil},
-		{"blogdns", 1, nil},
-		{"blogsite", 1, nil},
-		{"boldlygoingnowhere", 1, nil},
-		{"dnsalias", 1, nil},
-		{"dnsdojo", 1, nil},
-		{"doesntexist", 1, nil},
-		{"dontexist", 1, nil},
-		{"doomdns", 1, nil},
-		{"dvrdns", 1, nil},
-		{"dynalias", 1, nil},
-		{"dyndns", 1, []Node{
-			{"go", 1, nil},
-			{"home", 1, nil},
-		},
-		},
-		{"endofinternet", 1, nil},
-		{"endoftheinternet", 1, nil},
-		{"from-me", 1, nil},
-		{"game-host", 1, nil},
-		{"gotdns", 1, nil},
-		{"hobby-site", 1, nil},
-		{"homedns", 1, nil},
-		{"homeftp", 1, nil},
-		{"homelinux", 1, nil},
-		{"homeunix", 1, nil},
-		{"is-a-bruinsfan", 1, nil},
-		{"is-a-candidate", 1, nil},
-		{"is-a-celticsfan", 1, nil},
-		{"is-a-chef", 1, nil},
-		{"is-a-geek", 1, nil},
-		{"is-a-knight", 1, nil},
-		{"is-a-linux-user", 1, nil},
-		{"is-a-patsfan", 1, nil},
-		{"is-a-soxfan", 1, nil},
-		{"is-found", 1, nil},
-		{"is-lost", 1, nil},
-		{"is-saved", 1, nil},
-		{"is-very-bad", 1, nil},
-		{"is-very-evil", 1, nil},
-		{"is-very-good", 1, nil},
-		{"is-very-nice", 1, nil},
-		{"is-very-sweet", 1, nil},
-		{"isa-geek", 1, nil},
-		{"kicks-ass", 1, nil},
-		{"misconfused", 1, nil},
-		{"podzone", 1, nil},
-		{"readmyblog", 1, nil},
-		{"selfip", 1, nil},
-		{"sellsyourhome", 1, nil},
-		{"servebbs", 1, nil},
-		{"serveftp", 1, nil},
-		{"servegame", 1, nil},
-		{"stuff-4-sale", 1, nil},
-		{"us", 1, nil},
-		{"webhop", 1, nil},
-		{"za", 1, nil},
-	},
-	},
-	{"pa", 1, []Node{
-		{"abo", 1, nil},
-		{"ac", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gob", 1, nil},
-		{"ing", 1, nil},
-		{"med", 1, nil},
-		{"net", 1, nil},
-		{"nom", 1, nil},
-		{"org", 1, nil},
-		{"sld", 1, nil},
-	},
-	},
-	{"pe", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gob", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"nom", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"pf", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"pg", 3, nil},
-	{"ph", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"i", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"ngo", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"pk", 1, []Node{
-		{"biz", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"fam", 1, nil},
-		{"gob", 1, nil},
-		{"gok", 1, nil},
-		{"gon", 1, nil},
-		{"gop", 1, nil},
-		{"gos", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"web", 1, nil},
-	},
-	},
-	{"pl", 1, []Node{
-		{"6bone", 1, nil},
-		{"agro", 1, nil},
-		{"aid", 1, nil},
-		{"art", 1, nil},
-		{"atm", 1, nil},
-		{"augustow", 1, nil},
-		{"auto", 1, nil},
-		{"babia-gora", 1, nil},
-		{"bedzin", 1, nil},
-		{"beskidy", 1, nil},
-		{"bialowieza", 1, nil},
-		{"bialystok", 1, nil},
-		{"bielawa", 1, nil},
-		{"bieszczady", 1, nil},
-		{"biz", 1, nil},
-		{"boleslawiec", 1, nil},
-		{"bydgoszcz", 1, nil},
-		{"bytom", 1, nil},
-		{"cieszyn", 1, nil},
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"czeladz", 1, nil},
-		{"czest", 1, nil},
-		{"dlugoleka", 1, nil},
-		{"edu", 1, nil},
-		{"elblag", 1, nil},
-		{"elk", 1, nil},
-		{"gda", 1, nil},
-		{"gdansk", 1, nil},
-		{"gdynia", 1, nil},
-		{"gliwice", 1, nil},
-		{"glogow", 1, nil},
-		{"gmina", 1, nil},
-		{"gniezno", 1, nil},
-		{"gorlice", 1, nil},
-		{"gov", 1, []Node{
-			{"pa", 1, nil},
-			{"po", 1, nil},
-			{"so", 1, nil},
-			{"sr", 1, nil},
-			{"starostwo", 1, nil},
-			{"ug", 1, nil},
-			{"um", 1, nil},
-			{"upow", 1, nil},
-			{"uw", 1, nil},
-		},
-		},
-		{"grajewo", 1, nil},
-		{"gsm", 1, nil},
-		{"ilawa", 1, nil},
-		{"info", 1, nil},
-		{"irc", 1, nil},
-		{"jaworzno", 1, nil},
-		{"jelenia-gora", 1, nil},
-		{"jgora", 1, nil},
-		{"kalisz", 1, nil},
-		{"karpacz", 1, nil},
-		{"kartuzy", 1, nil},
-		{"kaszuby", 1, nil},
-		{"katowice", 1, nil},
-		{"kazimierz-dolny", 1, nil},
-		{"kepno", 1, nil},
-		{"ketrzyn", 1, nil},
-		{"klodzko", 1, nil},
-		{"kobierzyce", 1, nil},
-		{"kolobrzeg", 1, nil},
-		{"konin", 1, nil},
-		{"konskowola", 1, nil},
-		{"krakow", 1, nil},
-		{"kutno", 1, nil},
-		{"lapy", 1, nil},
-		{"lebork", 1, nil},
-		{"legnica", 1, nil},
-		{"lezajsk", 1, nil},
-		{"limanowa", 1, nil},
-		{"lomza", 1, nil},
-		{"lowicz", 1, nil},
-		{"lubin", 1, nil},
-		{"lukow", 1, nil},
-		{"mail", 1, nil},
-		{"malbork", 1, nil},
-		{"malopolska", 1, nil},
-		{"mazowsze", 1, nil},
-		{"mazury", 1, nil},
-		{"mbone", 1, nil},
-		{"med", 1, nil},
-		{"media", 1, nil},
-		{"miasta", 1, nil},
-		{"mielec", 1, nil},
-		{"mielno", 1, nil},
-		{"mil", 1, nil},
-		{"mragowo", 1, nil},
-		{"naklo", 1, nil},
-		{"net", 1, nil},
-		{"ngo", 1, nil},
-		{"nieruchomosci", 1, nil},
-		{"nom", 1, nil},
-		{"nowaruda", 1, nil},
-		{"nysa", 1, nil},
-		{"olawa", 1, nil},
-		{"olecko", 1, nil},
-		{"olkusz", 1, nil},
-		{"olsztyn", 1, nil},
-		{"opoczno", 1, nil},
-		{"opole", 1, nil},
-		{"org", 1, nil},
-		{"ostroda", 1, nil},
-		{"ostroleka", 1, nil},
-		{"ostrowiec", 1, nil},
-		{"ostrowwlkp", 1, nil},
-		{"pc", 1, nil},
-		{"pila", 1, nil},
-		{"pisz", 1, nil},
-		{"podhale", 1, nil},
-		{"podlasie", 1, nil},
-		{"polkowice", 1, nil},
-		{"pomorskie", 1, nil},
-		{"pomorze", 1, nil},
-		{"powiat", 1, nil},
-		{"poznan", 1, nil},
-		{"priv", 1, nil},
-		{"prochowice", 1, nil},
-		{"pruszkow", 1, nil},
-		{"przeworsk", 1, nil},
-		{"pulawy", 1, nil},
-		{"radom", 1, nil},
-		{"rawa-maz", 1, nil},
-		{"realestate", 1, nil},
-		{"rel", 1, nil},
-		{"rybnik", 1, nil},
-		{"rzeszow", 1, nil},
-		{"sanok", 1, nil},
-		{"sejny", 1, nil},
-		{"sex", 1, nil},
-		{"shop", 1, nil},
-		{"siedlce", 1, nil},
-		{"sklep", 1, nil},
-		{"skoczow", 1, nil},
-		{"slask", 1, nil},
-		{"slupsk", 1, nil},
-		{"sopot", 1, nil},
-		{"sos", 1, nil},
-		{"sosnowiec", 1, nil},
-		{"stalowa-wola", 1, nil},
-		{"starachowice", 1, nil},
-		{"stargard", 1, nil},
-		{"suwalki", 1, nil},
-		{"swidnica", 1, nil},
-		{"swiebodzin", 1, nil},
-		{"swinoujscie", 1, nil},
-		{"szczecin", 1, nil},
-		{"szczytno", 1, nil},
-		{"szkola", 1, nil},
-		{"targi", 1, nil},
-		{"tarnobrzeg", 1, nil},
-		{"tgory", 1, nil},
-		{"tm", 1, nil},
-		{"tourism", 1, nil},
-		{"travel", 1, nil},
-		{"turek", 1, nil},
-		{"turystyka", 1, nil},
-		{"tychy", 1, nil},
-		{"usenet", 1, nil},
-		{"ustka", 1, nil},
-		{"walbrzych", 1, nil},
-		{"warmia", 1, nil},
-		{"warszawa", 1, nil},
-		{"waw", 1, nil},
-		{"wegrow", 1, nil},
-		{"wielun", 1, nil},
-		{"wlocl", 1, nil},
-		{"wloclawek", 1, nil},
-		{"wodzislaw", 1, nil},
-		{"wolomin", 1, nil},
-		{"wroc", 1, nil},
-		{"wroclaw", 1, nil},
-		{"zachpomor", 1, nil},
-		{"zagan", 1, nil},
-		{"zakopane", 1, nil},
-		{"zarow", 1, nil},
-		{"zgora", 1, nil},
-		{"zgorzelec", 1, nil},
-	},
-	},
-	{"pm", 1, nil},
-	{"pn", 1, []Node{
-		{"co", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"pr", 1, []Node{
-		{"ac", 1, nil},
-		{"biz", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"est", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"isla", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"pro", 1, nil},
-		{"prof", 1, nil},
-	},
-	},
-	{"pro", 1, []Node{
-		{"aca", 1, nil},
-		{"bar", 1, nil},
-		{"cpa", 1, nil},
-		{"eng", 1, nil},
-		{"jur", 1, nil},
-		{"law", 1, nil},
-		{"med", 1, nil},
-	},
-	},
-	{"ps", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"plo", 1, nil},
-		{"sec", 1, nil},
-	},
-	},
-	{"pt", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"int", 1, nil},
-		{"net", 1, nil},
-		{"nome", 1, nil},
-		{"org", 1, nil},
-		{"publ", 1, nil},
-	},
-	},
-	{"pw", 1, []Node{
-		{"belau", 1, nil},
-		{"co", 1, nil},
-		{"ed", 1, nil},
-		{"go", 1, nil},
-		{"ne", 1, nil},
-		{"or", 1, nil},
-	},
-	},
-	{"py", 3, nil},
-	{"qa", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"sch", 1, nil},
-	},
-	},
-	{"re", 1, []Node{
-		{"asso", 1, nil},
-		{"com", 1, nil},
-		{"nom", 1, nil},
-	},
-	},
-	{"ro", 1, []Node{
-		{"arts", 1, nil},
-		{"com", 1, nil},
-		{"firm", 1, nil},
-		{"info", 1, nil},
-		{"nom", 1, nil},
-		{"nt", 1, nil},
-		{"org", 1, nil},
-		{"rec", 1, nil},
-		{"store", 1, nil},
-		{"tm", 1, nil},
-		{"www", 1, nil},
-	},
-	},
-	{"rs", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"in", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ru", 1, []Node{
-		{"ac", 1, nil},
-		{"adygeya", 1, nil},
-		{"altai", 1, nil},
-		{"amur", 1, nil},
-		{"amursk", 1, nil},
-		{"arkhangelsk", 1, nil},
-		{"astrakhan", 1, nil},
-		{"baikal", 1, nil},
-		{"bashkiria", 1, nil},
-		{"belgorod", 1, nil},
-		{"bir", 1, nil},
-		{"bryansk", 1, nil},
-		{"buryatia", 1, nil},
-		{"cbg", 1, nil},
-		{"chel", 1, nil},
-		{"chelyabinsk", 1, nil},
-		{"chita", 1, nil},
-		{"chukotka", 1, nil},
-		{"chuvashia", 1, nil},
-		{"cmw", 1, nil},
-		{"com", 1, nil},
-		{"dagestan", 1, nil},
-		{"dudinka", 1, nil},
-		{"e-burg", 1, nil},
-		{"edu", 1, nil},
-		{"fareast", 1, nil},
-		{"gov", 1, nil},
-		{"grozny", 1, nil},
-		{"int", 1, nil},
-		{"irkutsk", 1, nil},
-		{"ivanovo", 1, nil},
-		{"izhevsk", 1, nil},
-		{"jamal", 1, nil},
-		{"jar", 1, nil},
-		{"joshkar-ola", 1, nil},
-		{"k-uralsk", 1, nil},
-		{"kalmykia", 1, nil},
-		{"kaluga", 1, nil},
-		{"kamchatka", 1, nil},
-		{"karelia", 1, nil},
-		{"kazan", 1, nil},
-		{"kchr", 1, nil},
-		{"kemerovo", 1, nil},
-		{"khabarovsk", 1, nil},
-		{"khakassia", 1, nil},
-		{"khv", 1, nil},
-		{"kirov", 1, nil},
-		{"kms", 1, nil},
-		{"koenig", 1, nil},
-		{"komi", 1, nil},
-		{"kostroma", 1, nil},
-		{"krasnoyarsk", 1, nil},
-		{"kuban", 1, nil},
-		{"kurgan", 1, nil},
-		{"kursk", 1, nil},
-		{"kustanai", 1, nil},
-		{"kuzbass", 1, nil},
-		{"lipetsk", 1, nil},
-		{"magadan", 1, nil},
-		{"magnitka", 1, nil},
-		{"mari", 1, nil},
-		{"mari-el", 1, nil},
-		{"marine", 1, nil},
-		{"mil", 1, nil},
-		{"mordovia", 1, nil},
-		{"mosreg", 1, nil},
-		{"msk", 1, nil},
-		{"murmansk", 1, nil},
-		{"mytis", 1, nil},
-		{"nakhodka", 1, nil},
-		{"nalchik", 1, nil},
-		{"net", 1, nil},
-		{"nkz", 1, nil},
-		{"nnov", 1, nil},
-		{"norilsk", 1, nil},
-		{"nov", 1, nil},
-		{"novosibirsk", 1, nil},
-		{"nsk", 1, nil},
-		{"omsk", 1, nil},
-		{"orenburg", 1, nil},
-		{"org", 1, nil},
-		{"oryol", 1, nil},
-		{"oskol", 1, nil},
-		{"palana", 1, nil},
-		{"penza", 1, nil},
-		{"perm", 1, nil},
-		{"pp", 1, nil},
-		{"pskov", 1, nil},
-		{"ptz", 1, nil},
-		{"pyatigorsk", 1, nil},
-		{"rnd", 1, nil},
-		{"rubtsovsk", 1, nil},
-		{"ryazan", 1, nil},
-		{"sakhalin", 1, nil},
-		{"samara", 1, nil},
-		{"saratov", 1, nil},
-		{"simbirsk", 1, nil},
-		{"smolensk", 1, nil},
-		{"snz", 1, nil},
-		{"spb", 1, nil},
-		{"stavropol", 1, nil},
-		{"stv", 1, nil},
-		{"surgut", 1, nil},
-		{"syzran", 1, nil},
-		{"tambov", 1, nil},
-		{"tatarstan", 1, nil},
-		{"test", 1, nil},
-		{"tom", 1, nil},
-		{"tomsk", 1, nil},
-		{"tsaritsyn", 1, nil},
-		{"tsk", 1, nil},
-		{"tula", 1, nil},
-		{"tuva", 1, nil},
-		{"tver", 1, nil},
-		{"tyumen", 1, nil},
-		{"udm", 1, nil},
-		{"udmurtia", 1, nil},
-		{"ulan-ude", 1, nil},
-		{"vdonsk", 1, nil},
-		{"vladikavkaz", 1, nil},
-		{"vladimir", 1, nil},
-		{"vladivostok", 1, nil},
-		{"volgograd", 1, nil},
-		{"vologda", 1, nil},
-		{"voronezh", 1, nil},
-		{"vrn", 1, nil},
-		{"vyatka", 1, nil},
-		{"yakutia", 1, nil},
-		{"yamal", 1, nil},
-		{"yaroslavl", 1, nil},
-		{"yekaterinburg", 1, nil},
-		{"yuzhno-sakhalinsk", 1, nil},
-		{"zgrad", 1, nil},
-	},
-	},
-	{"rw", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gouv", 1, nil},
-		{"gov", 1, nil},
-		{"int", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-	},
-	},
-	{"sa", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"med", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"pub", 1, nil},
-		{"sch", 1, nil},
-	},
-	},
-	{"sb", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"sc", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"sd", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"med", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"tv", 1, nil},
-	},
-	},
-	{"se", 1, []Node{
-		{"a", 1, nil},
-		{"ac", 1, nil},
-		{"b", 1, nil},
-		{"bd", 1, nil},
-		{"brand", 1, nil},
-		{"c", 1, nil},
-		{"d", 1, nil},
-		{"e", 1, nil},
-		{"f", 1, nil},
-		{"fh", 1, nil},
-		{"fhsk", 1, nil},
-		{"fhv", 1, nil},
-		{"g", 1, nil},
-		{"h", 1, nil},
-		{"i", 1, nil},
-		{"k", 1, nil},
-		{"komforb", 1, nil},
-		{"kommunalforbund", 1, nil},
-		{"komvux", 1, nil},
-		{"l", 1, nil},
-		{"lanbib", 1, nil},
-		{"m", 1, nil},
-		{"n", 1, nil},
-		{"naturbruksgymn", 1, nil},
-		{"o", 1, nil},
-		{"org", 1, nil},
-		{"p", 1, nil},
-		{"parti", 1, nil},
-		{"pp", 1, nil},
-		{"press", 1, nil},
-		{"r", 1, nil},
-		{"s", 1, nil},
-		{"sshn", 1, nil},
-		{"t", 1, nil},
-		{"tm", 1, nil},
-		{"u", 1, nil},
-		{"w", 1, nil},
-		{"x", 1, nil},
-		{"y", 1, nil},
-		{"z", 1, nil},
-	},
-	},
-	{"sg", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"per", 1, nil},
-	},
-	},
-	{"sh", 1, []Node{
-		{"com", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"si", 1, nil},
-	{"sk", 1, nil},
-	{"sl", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"sm", 1, nil},
-	{"sn", 1, []Node{
-		{"art", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gouv", 1, nil},
-		{"org", 1, nil},
-		{"perso", 1, nil},
-		{"univ", 1, nil},
-	},
-	},
-	{"so", 1, []Node{
-		{"com", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"sr", 1, nil},
-	{"st", 1, []Node{
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"consulado", 1, nil},
-		{"edu", 1, nil},
-		{"embaixada", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"principe", 1, nil},
-		{"saotome", 1, nil},
-		{"store", 1, nil},
-	},
-	},
-	{"su", 1, nil},
-	{"sv", 3, nil},
-	{"sx", 1, []Node{
-		{"gov", 1, nil},
-	},
-	},
-	{"sy", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"sz", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"tc", 1, nil},
-	{"td", 1, nil},
-	{"tel", 1, nil},
-	{"tf", 1, nil},
-	{"tg", 1, nil},
-	{"th", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"go", 1, nil},
-		{"in", 1, nil},
-		{"mi", 1, nil},
-		{"net", 1, nil},
-		{"or", 1, nil},
-	},
-	},
-	{"tj", 1, []Node{
-		{"ac", 1, nil},
-		{"biz", 1, nil},
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"go", 1, nil},
-		{"gov", 1, nil},
-		{"int", 1, nil},
-		{"mil", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"nic", 1, nil},
-		{"org", 1, nil},
-		{"test", 1, nil},
-		{"web", 1, nil},
-	},
-	},
-	{"tk", 1, nil},
-	{"tl", 1, []Node{
-		{"gov", 1, nil},
-	},
-	},
-	{"tm", 1, []Node{
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"nom", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"tn", 1, []Node{
-		{"agrinet", 1, nil},
-		{"com", 1, nil},
-		{"defense", 1, nil},
-		{"edunet", 1, nil},
-		{"ens", 1, nil},
-		{"fin", 1, nil},
-		{"gov", 1, nil},
-		{"ind", 1, nil},
-		{"info", 1, nil},
-		{"intl", 1, nil},
-		{"mincom", 1, nil},
-		{"nat", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"perso", 1, nil},
-		{"rnrt", 1, nil},
-		{"rns", 1, nil},
-		{"rnu", 1, nil},
-		{"tourism", 1, nil},
-		{"turen", 1, nil},
-	},
-	},
-	{"to", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"tr", 3, []Node{
-		{"nc", 0, []Node{
-			{"gov", 1, nil},
-		},
-		},
-		{"nic", 2, nil},
-	},
-	},
-	{"travel", 1, nil},
-	{"tt", 1, []Node{
-		{"aero", 1, nil},
-		{"biz", 1, nil},
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"coop", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"int", 1, nil},
-		{"jobs", 1, nil},
-		{"mobi", 1, nil},
-		{"museum", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"pro", 1, nil},
-		{"travel", 1, nil},
-	},
-	},
-	{"tv", 1, []Node{
-		{"better-than", 1, nil},
-		{"dyndns", 1, nil},
-		{"on-the-web", 1, nil},
-		{"worse-than", 1, nil},
-	},
-	},
-	{"tw", 1, []Node{
-		{"club", 1, nil},
-		{"com", 1, nil},
-		{"ebiz", 1, nil},
-		{"edu", 1, nil},
-		{"game", 1, nil},
-		{"gov", 1, nil},
-		{"idv", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"商業", 1, nil},
-		{"組織", 1, nil},
-		{"網路", 1, nil},
-	},
-	},
-	{"tz", 0, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"go", 1, nil},
-		{"mil", 1, nil},
-		{"ne", 1, nil},
-		{"or", 1, nil},
-		{"sc", 1, nil},
-	},
-	},
-	{"ua", 1, []Node{
-		{"cherkassy", 1, nil},
-		{"cherkasy", 1, nil},
-		{"chernigov", 1, nil},
-		{"chernihiv", 1, nil},
-		{"chernivtsi", 1, nil},
-		{"chernovtsy", 1, nil},
-		{"ck", 1, nil},
-		{"cn", 1, nil},
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"cr", 1, nil},
-		{"crimea", 1, nil},
-		{"cv", 1, nil},
-		{"dn", 1, nil},
-		{"dnepropetrovsk", 1, nil},
-		{"dnipropetrovsk", 1, nil},
-		{"dominic", 1, nil},
-		{"donetsk", 1, nil},
-		{"dp", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"if", 1, nil},
-		{"in", 1, nil},
-		{"ivano-frankivsk", 1, nil},
-		{"kh", 1, nil},
-		{"kharkiv", 1, nil},
-		{"kharkov", 1, nil},
-		{"kherson", 1, nil},
-		{"khmelnitskiy", 1, nil},
-		{"khmelnytskyi", 1, nil},
-		{"kiev", 1, nil},
-		{"kirovograd", 1, nil},
-		{"km", 1, nil},
-		{"kr", 1, nil},
-		{"krym", 1, nil},
-		{"ks", 1, nil},
-		{"kv", 1, nil},
-		{"kyiv", 1, nil},
-		{"lg", 1, nil},
-		{"lt", 1, nil},
-		{"lugansk", 1, nil},
-		{"lutsk", 1, nil},
-		{"lv", 1, nil},
-		{"lviv", 1, nil},
-		{"mk", 1, nil},
-		{"mykolaiv", 1, nil},
-		{"net", 1, nil},
-		{"nikolaev", 1, nil},
-		{"od", 1, nil},
-		{"odesa", 1, nil},
-		{"odessa", 1, nil},
-		{"org", 1, nil},
-		{"pl", 1, nil},
-		{"poltava", 1, nil},
-		{"pp", 1, nil},
-		{"rivne", 1, nil},
-		{"rovno", 1, nil},
-		{"rv", 1, nil},
-		{"sb", 1, nil},
-		{"sebastopol", 1, nil},
-		{"sevastopol", 1, nil},
-		{"sm", 1, nil},
-		{"sumy", 1, nil},
-		{"te", 1, nil},
-		{"ternopil", 1, nil},
-		{"uz", 1, nil},
-		{"uzhgorod", 1, nil},
-		{"vinnica", 1, nil},
-		{"vinnytsia", 1, nil},
-		{"vn", 1, nil},
-		{"volyn", 1, nil},
-		{"yalta", 1, nil},
-		{"zaporizhzhe", 1, nil},
-		{"zaporizhzhia", 1, nil},
-		{"zhitomir", 1, nil},
-		{"zhytomyr", 1, nil},
-		{"zp", 1, nil},
-		{"zt", 1, nil},
-	},
-	},
-	{"ug", 1, []Node{
-		{"ac", 1, nil},
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"go", 1, nil},
-		{"ne", 1, nil},
-		{"or", 1, nil},
-		{"org", 1, nil},
-		{"sc", 1, nil},
-	},
-	},
-	{"uk", 3, []Node{
-		{"bl", 2, nil},
-		{"british-library", 2, nil},
-		{"jet", 2, nil},
-		{"mod", 2, nil},
-		{"national-library-scotland", 2, nil},
-		{"nel", 2, nil},
-		{"nhs", 3, nil},
-		{"nic", 2, nil},
-		{"nls", 2, nil},
-		{"parliament", 2, nil},
-		{"police", 3, nil},
-		{"sch", 3, nil},
-	},
-	},
-	{"us", 1, []Node{
-		{"ak", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"al", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ar", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"as", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"az", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ca", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"co", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ct", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"dc", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"de", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"dni", 1, nil},
-		{"fed", 1, nil},
-		{"fl", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ga", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"gu", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"hi", 1, []Node{
-			{"cc", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ia", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"id", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"il", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"in", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"is-by", 1, nil},
-		{"isa", 1, nil},
-		{"kids", 1, nil},
-		{"ks", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ky", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"la", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"land-4-sale", 1, nil},
-		{"ma", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, []Node{
-				{"chtr", 1, nil},
-				{"paroch", 1, nil},
-				{"pvt", 1, nil},
-			},
-			},
-			{"lib", 1, nil},
-		},
-		},
-		{"md", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"me", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"mi", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"mn", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"mo", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ms", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"mt", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"nc", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"nd", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ne", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"nh", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"nj", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"nm", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"nsn", 1, nil},
-		{"nv", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ny", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"oh", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ok", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"or", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"pa", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"pr", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ri", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"sc", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"sd", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"stuff-4-sale", 1, nil},
-		{"tn", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"tx", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"ut", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"va", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"vi", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"vt", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"wa", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"wi", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"wv", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-		{"wy", 1, []Node{
-			{"cc", 1, nil},
-			{"k12", 1, nil},
-			{"lib", 1, nil},
-		},
-		},
-	},
-	},
-	{"uy", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gub", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"uz", 1, []Node{
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"va", 1, nil},
-	{"vc", 1, []Node{
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"ve", 1, []Node{
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"e12", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"info", 1, nil},
-		{"mil", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"web", 1, nil},
-	},
-	},
-	{"vg", 1, nil},
-	{"vi", 1, []Node{
-		{"co", 1, nil},
-		{"com", 1, nil},
-		{"k12", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"vn", 1, []Node{
-		{"ac", 1, nil},
-		{"biz", 1, nil},
-		{"com", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"health", 1, nil},
-		{"info", 1, nil},
-		{"int", 1, nil},
-		{"name", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-		{"pro", 1, nil},
-	},
-	},
-	{"vu", 1, nil},
-	{"wf", 1, nil},
-	{"ws", 1, []Node{
-		{"com", 1, nil},
-		{"dyndns", 1, nil},
-		{"edu", 1, nil},
-		{"gov", 1, nil},
-		{"mypets", 1, nil},
-		{"net", 1, nil},
-		{"org", 1, nil},
-	},
-	},
-	{"xxx", 1, nil},
-	{"ye", 3, nil},
-	{"yt", 1, nil},
-	{"za", 3, nil},
-	{"zm", 3, nil},
-	{"zw", 3, nil},
-	{"рф", 1, nil},
-	{"срб", 1, nil},
-	{"укр", 1, nil},
-	{"الاردن", 1, nil},
-	{"الجزائر", 1, nil},
-	{"السعودية", 1, nil},
-	{"السعوديه", 1, nil},
-	{"السعودیة", 1, nil},
-	{"السعودیۃ", 1, nil},
-	{"المغرب", 1, nil},
-	{"اليمن", 1, nil},
-	{"امارات", 1, nil},
-	{"ايران", 1, nil},
-	{"ایران", 1, nil},
-	{"بھارت", 1, nil},
-	{"تونس", 1, nil},
-	{"سوريا", 1, nil},
-	{"سورية", 1, nil},
-	{"عمان", 1, nil},
-	{"فلسطين", 1, nil},
-	{"قطر", 1, nil},
-	{"مصر", 1, nil},
-	{"भारत", 1, nil},
-	{"বাংলা", 1, nil},
-	{"ভারত", 1, nil},
-	{"ਭਾਰਤ", 1, nil},
-	{"ભારત", 1, nil},
-	{"இந்தியா", 1, nil},
-	{"இலங்கை", 1, nil},
-	{"சிங்கப்பூர்", 1, nil},
-	{"భారత్", 1, nil},
-	{"ලංකා", 1, nil},
-	{"ไทย", 1, nil},
-	{"გე", 1, nil},
-	{"中国", 1, nil},
-	{"中國", 1, nil},
-	{"台湾", 1, nil},
-	{"台灣", 1, nil},
-	{"新加坡", 1, nil},
-	{"臺灣", 1, nil},
-	{"香港", 1, nil},
-	{"한국", 1, nil},
-}}
-
-// the needed fibonacci numbers
-var fibonacci = []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89, 144, 233, 377, 610, 987}
+// suffixes is the public suffix list flattened into a suffixTable.
+var suffixes = &suffixTable{
+	text: "" +
+		"acadaeaeroafagaialamanaoaqararpaasasiaatauawaxazbabbbdbebfbgbhbi" +
+		"bizbjbmbnbobrbsbtbwbybzcacatcccdcfcgchcickclcmcncocomcoopcrcucv" +
+		"cxcyczdedjdkdmdodzecedueeegereseteufifjfkfmfofrgagdgegfggghgigl" +
+		"gmgngovgpgqgrgsgtgugwgyhkhmhnhrhthuidieilimininfointioiqirisitje" +
+		"jmjojobsjpkekgkhkikmknkpkrkwkykzlalblclilklrlsltlulvlymamcmdmemg" +
+		"mhmilmkmlmmmnmomobimpmqmrmsmtmumuseummvmwmxmymznanamencnenetnfng" +
+		"ninlnonpnrnunzomorgpapepfpgphpkplpmpnprpropsptpwpyqarerorsrurwsa" +
+		"sbscsdsesgshsiskslsmsnsosrstsusvsxsysztctdteltftgthtjtktltmtnto" +
+		"trtraveltttvtwtzuaugukusuyuzvavcvevgvivnvuwfwsxxxyeytzazmzwрф" +
+		"србукрالاردنالجزائرالسعودية" +
+		"السعوديهالسعودیةالسعودیۃالمغرب" +
+		"اليمناماراتايرانایرانبھارتتونس" +
+		"سورياسوريةعمانفلسطينقطرمصرभारत" +
+		"বাংলাভারতਭਾਰਤભારત" +
+		"இந்தியாஇலங்கை" +
+		"சிங்கப்பூர்భారత్ලංකා" +
+		"ไทยგე中国中國台湾台灣新加坡臺灣香港" +
+		"한국nomschaccident-investigationaccident-preventionaerobatic" +
+		"aeroclubaerodromeagentsair-surveillanceair-traffic-control" +
+		"aircraftairlineairportairtrafficambulanceamusementassociation" +
+		"authorballooningbrokercaacargocateringcertificationchampionship" +
+		"chartercivilaviationclubconferenceconsultantconsultingcontrol" +
+		"councilcrewdesigndgcaeducatoremergencyengineengineer" +
+		"entertainmentequipmentexchangeexpressfederationflightfreightfuel" +
+		"glidinggovernmentgroundhandlinggrouphangglidinghomebuilt" +
+		"insurancejournaljournalistleasinglogisticsmagazinemaintenance" +
+		"marketplacemediamicrolightmodellingnavigationparachuting" +
+		"paraglidingpassenger-associationpilotpressproductionrecreation" +
+		"repbodyresresearchrotorcraftsafetyscientistservicesshowskydiving" +
+		"softwarestudenttaxitradertradingtrainerunionworkinggroupworksoff" +
+		"edgvogpbcongresodelalengua3educgobiernoelectronicomeconnacionnic" +
+		"promocionretinaubae164in-addrip6irisuriurnorprivactasnconfcsiro" +
+		"nswntozqldtasvicwappunbiunsastore0123456789abcdefghijklmnopqrstu" +
+		"vwxyzdyndnsfor-betterfor-morefor-somefor-theselfipwebhopasso" +
+		"barreaugouvgobadmadvagrarqartatobioblogbmdcimcngcntecnecoempeng" +
+		"espetcetifarflogfndfotfstg12ggfimbindinfjorjusleglelmatmedmusnot" +
+		"ntrodoppgpscpsiqslradiorecslgsrvteotmptrdturvetvlogwikizlgofabbc" +
+		"gcmbnbnsonqcykftpaccessgame-servermyphotosscrappingaéroportgo" +
+		"pressewwwahcqgxgzhahbhehihljljsjxlnnmnxqhxjxzynzj公司網絡" +
+		"网络artsfirmwebappspotblogdnscechirednsaliasdnsdojodoesntexist" +
+		"dontexistdoomdnsdreamhostersdyn-o-saurdynaliasdyndns-at-home" +
+		"dyndns-at-workdyndns-blogdyndns-freedyndns-homedyndns-ip" +
+		"dyndns-maildyndns-officedyndns-picsdyndns-remotedyndns-server" +
+		"dyndns-webdyndns-wikidyndns-workest-a-la-maisonest-a-la-masion" +
+		"est-le-patronest-mon-blogueurfrom-akfrom-alfrom-arfrom-cafrom-ct" +
+		"from-dcfrom-defrom-flfrom-gafrom-hifrom-iafrom-idfrom-ilfrom-in" +
+		"from-ksfrom-kyfrom-mafrom-mdfrom-mifrom-mnfrom-mofrom-msfrom-mt" +
+		"from-ncfrom-ndfrom-nefrom-nhfrom-njfrom-nmfrom-nvfrom-ohfrom-ok" +
+		"from-orfrom-pafrom-prfrom-rifrom-scfrom-sdfrom-tnfrom-txfrom-ut" +
+		"from-vafrom-vtfrom-wafrom-wifrom-wvfrom-wygbgetmyipgotdns" +
+		"hobby-sitehomelinuxhomeunixiamallamais-a-anarchistis-a-blogger" +
+		"is-a-bookkeeperis-a-bulls-fanis-a-catereris-a-chef" +
+		"is-a-conservativeis-a-cpais-a-cubicle-slaveis-a-democrat" +
+		"is-a-designeris-a-doctoris-a-financialadvisoris-a-geekis-a-green" +
+		"is-a-guruis-a-hard-workeris-a-hunteris-a-landscaperis-a-lawyer" +
+		"is-a-liberalis-a-libertarianis-a-llamais-a-musician" +
+		"is-a-nascarfanis-a-nurseis-a-painteris-a-personaltrainer" +
+		"is-a-photographeris-a-playeris-a-republicanis-a-rockstar" +
+		"is-a-socialistis-a-studentis-a-teacheris-a-techieis-a-therapist" +
+		"is-an-accountantis-an-actoris-an-actressis-an-anarchist" +
+		"is-an-artistis-an-engineeris-an-entertaineris-certifiedis-gone" +
+		"is-into-animeis-into-carsis-into-cartoonsis-into-gamesis-leet" +
+		"is-not-certifiedis-slickis-uberleetis-with-thebandisa-geek" +
+		"isa-hockeynutissmarterthanyoujpnlikes-pielikescandyneat-url" +
+		"operaunitesaves-the-whalessells-for-lesssells-for-uservebbs" +
+		"simple-urlspace-to-rentteaches-yogawritesthisblogath" +
+		"fuettertdasnetzisteingeekistmeinlebtimnetzleitungsen" +
+		"traeumtgeradesldpolfink12aipfielibpririikeunscialandikiaeroport" +
+		"assedicavocatavouesccichambagrichirurgiens-dentistes" +
+		"experts-comptablesgeometre-expertgretahuissier-justicemedecin" +
+		"notairespharmacienportprdveterinairepvtltdmodidv个人個人" +
+		"政府敎育教育箇人組織組织網络组織组织网絡from" +
+		"izadultpersorelshop2000agrarboltcasinocityeroticaerotikafilm" +
+		"forumgameshotelingatlanjogaszkonyvelolakasnewsreklamsexsportsuli" +
+		"szextozsdeutazasvideogenbarrel-of-knowledgebarrell-of-knowledge" +
+		"for-ourgroks-thegroks-thishere-for-moreknowsitallagrigento" +
+		"alessandriaalto-adigealtoadigeanconaandria-barletta-trani" +
+		"andria-trani-barlettaandriabarlettatraniandriatranibarlettaaosta" +
+		"aosteapaquilaarezzoascoli-picenoascolipicenoastiavavellinobalsan" +
+		"baribarletta-trani-andriabarlettatraniandriabellunobenevento" +
+		"bergamobiellablbolognabolzanobozenbresciabrindisicagliari" +
+		"caltanissettacampidano-mediocampidanomediocampobasso" +
+		"carbonia-iglesiascarboniaiglesiascarrara-massacarraramassa" +
+		"casertacataniacatanzarocbcecesena-forlicesenaforlichieticomo" +
+		"cosenzacremonacrotonecsctcuneodell-ogliastradellogliastraenenna" +
+		"fcfefermoferrarafgfirenzeflorencefoggiaforli-cesenaforlicesena" +
+		"frosinonegenoagenovagoriziagrossetoiglesias-carbonia" +
+		"iglesiascarboniaimperiaiserniala-spezialaquilalaspezialatinale" +
+		"lecceleccolivornololodiluccamaceratamantovamassa-carrara" +
+		"massacarraramateramedio-campidanomediocampidanomessinamimilan" +
+		"milanomodenamonzamonza-brianzamonza-e-della-brianzamonzabrianza" +
+		"monzaebrianzamonzaedellabrianzanaplesnapolinovaranuoroogliastra" +
+		"olbia-tempioolbiatempiooristanootpadovapaduapalermoparmapaviapc" +
+		"pdperugiapesaro-urbinopesarourbinopescarapipiacenzapisapistoiapo" +
+		"pordenonepotenzapratopupvpzraragusaravennarcreggio-calabria" +
+		"reggio-emiliareggiocalabriareggioemiliargririetiriminirmrnroma" +
+		"romerovigosalernosassarisavonasienasiracusasondriospsssuedtirol" +
+		"tatarantotetempio-olbiatempioolbiateramoternitorinotp" +
+		"trani-andria-barlettatrani-barletta-andriatraniandriabarletta" +
+		"tranibarlettaandriatrapanitrentinotrentotrevisotriestetsturinud" +
+		"udineurbino-pesarourbinopesarovaresevbveneziaveniceverbania" +
+		"vercelliveronavibo-valentiavibovalentiavicenzaviterbovrvsvtvv" +
+		"aichiakitaaomorichibaehimefukuifukuokafukushimagifugunma" +
+		"hiroshimahokkaidohyogoibarakiishikawaiwatekagawakagoshima" +
+		"kanagawakawasakikitakyushukobekochikumamotokyotolgmiemiyagi" +
+		"miyazakinaganonagasakinagoyanaraniigataoitaokayamaokinawaosaka" +
+		"sagasaitamasapporosendaishigashimaneshizuokatochigitokushima" +
+		"tokyotottoritoyamawakayamayamagatayamaguchiyamanashiyokohamaass" +
+		"pharmaciensreptrabusanchungbukchungnamdaegudaejeongangwongwangju" +
+		"gyeongbukgyeonggigyeongnamhsincheonjejujeonbukjeonnamseoululsan" +
+		"perassngrpngosocplcitsacademyagricultureairairguardalabamaalaska" +
+		"amberamericanamericanaamericanantiquesamericanartamsterdamand" +
+		"annefrankanthroanthropologyantiquesaquariumarboretum" +
+		"archaeologicalarchaeologyarchitectureartanddesignartcenter" +
+		"artdecoarteducationartgalleryartsandcraftsasmatartassassination" +
+		"assisiastronomyatlantaaustinaustraliaautomotiveaviationaxis" +
+		"badajozbaghdadbahnbalebaltimorebarcelonabaseballbaselbathsbauern" +
+		"beauxartsbeeldengeluidbellevuebergbauberkeleyberlinbernbible" +
+		"bilbaobillbirdartbirthplacebonnbostonbotanicalbotanicalgarden" +
+		"botanicgardenbotanybrandywinevalleybrasilbristolbritish" +
+		"britishcolumbiabroadcastbrunelbrusselbrusselsbruxellesbuilding" +
+		"burghofbusbusheycadaquescaliforniacambridgecancanadacapebreton" +
+		"carriercartoonartcasadelamonedacastlecastrescelticcenter" +
+		"chattanoogacheltenhamchesapeakebaychicagochildrenchildrens" +
+		"childrensgardenchiropracticchocolatechristiansburgcincinnati" +
+		"cinemacircuscivilisationcivilizationcivilwarclintonclockcoal" +
+		"coastaldefencecodycoldwarcollectioncolonialwilliamsburg" +
+		"coloradoplateaucolumbiacolumbuscommunicationcommunications" +
+		"communitycomputercomputerhistorycomunicaçõescontemporary" +
+		"contemporaryartconventcopenhagencorporation" +
+		"correios-e-telecomunicaçõescorvettecostumecountryestatecounty" +
+		"craftscranbrookcreationculturalculturalcenterculturecybercymru" +
+		"dalidallasdatabaseddrdecorativeartsdelawaredelmenhorstdenmark" +
+		"depotdetroitdinosaurdiscoverydollsdonostiadurhameastafrica" +
+		"eastcoasteducationeducationalegyptianeisenbahnelburgelvendrell" +
+		"embroideryencyclopedicenglandentomologyenvironment" +
+		"environmentalconservationepilepsyessexestateethnologyexeter" +
+		"exhibitionfamilyfarmfarmequipmentfarmersfarmsteadfieldfigueres" +
+		"filateliafineartfineartsfinlandflandersfloridaforcefortmissoula" +
+		"fortworthfoundationfrancaisefrankfurtfranziskanerfreemasonry" +
+		"freiburgfribourgfrogfundaciofurnituregallerygardengateway" +
+		"geelvinckgemologicalgeologygeorgiagiessenglasglassgorge" +
+		"grandrapidsgrazguernseyhalloffamehamburghandson" +
+		"harvestcelebrationhawaiihealthheimatunduhrenhellashelsinki" +
+		"hembygdsforbundheritagehistoirehistoricalhistoricalsociety" +
+		"historichouseshistorischhistorischeshistoryhistoryofscience" +
+		"horologyhousehumanitiesillustrationimageandsoundindianindiana" +
+		"indianapolisindianmarketintelligenceinteractiveiraqironisleofman" +
+		"jamisonjeffersonjerusalemjewelryjewishjewishartjfkjournalism" +
+		"judaicajudygarlandjuedischesjuifkaratekarikaturkidskoebenhavn" +
+		"koelnkunstkunstsammlungkunstunddesignlaborlabourlajolla" +
+		"lancashirelandeslanslarssonlewismillerlincolnlinzliving" +
+		"livinghistorylocalhistorylondonlosangeleslouvreloyalistlucerne" +
+		"luxembourgluzernlänsmadmadridmallorcamanchestermansionmansions" +
+		"manxmarburgmaritimemaritimomarylandmarylhurstmedical" +
+		"medizinhistorischesmeeresmemorialmesaverdemichiganmidatlantic" +
+		"militarymillminersminingminnesotamissilemissoulamodernmomamoney" +
+		"monmouthmonticellomontrealmoscowmotorcyclemuenchenmuenster" +
+		"mulhousemunciemuseetmuseumcentermuseumverenigingmusicnational" +
+		"nationalfirearmsnationalheritagenativeamericannaturalhistory" +
+		"naturalhistorymuseumnaturalsciencesnaturenaturhistorisches" +
+		"natuurwetenschappennaumburgnavalnebraskaneuesnewhampshire" +
+		"newjerseynewmexiconewportnewspapernewyorkniepcenorfolknorthnrw" +
+		"nuernbergnurembergnycnynyoceanographicoceanographiqueomahaonline" +
+		"ontarioopenairoregonoregontrailotagooxfordpacificpaderbornpalace" +
+		"paleopalmspringspanamaparispasadenapharmacyphiladelphia" +
+		"philadelphiaareaphilatelyphoenixphotographypilotspittsburgh" +
+		"planetariumplantationplantsplazaportalportlandportlligat" +
+		"posts-and-telecommunicationspreservationpresidioprojectpublic" +
+		"pubolquebecrailroadrailwayresistanceriodejaneirorochesterrockart" +
+		"russiasaintlouissalemsalvadordalisalzburgsandiegosanfrancisco" +
+		"santabarbarasantacruzsantafesaskatchewansatxsavannahga" +
+		"schlesischesschoenbrunnschokoladenschoolschweizscience" +
+		"science-fictionscienceandhistoryscienceandindustrysciencecenter" +
+		"sciencecenterssciencehistorysciencessciencesnaturellesscotland" +
+		"seaportsettlementsettlersshellsherbrookesibeniksilkskiskole" +
+		"societysolognesoundandvisionsouthcarolinasouthwestspacespysquare" +
+		"stadtstalbansstarnbergstatestateofdelawarestationsteamsteiermark" +
+		"stjohnstockholmstpetersburgstuttgartsuissesurgeonshallsurrey" +
+		"svizzeraswedensydneytanktcmtechnologytelekommunikationtelevision" +
+		"texastextiletheatertimetimekeepingtopologytouchtowntransporttree" +
+		"trolleytrusttrusteeuhrenulmunderseauniversityusausantiquesusarts" +
+		"uscountryestateuscultureusdecorativeartsusgardenushistoryushuaia" +
+		"uslivinghistoryutahuvicvalleyvantaaversaillesvikingvillage" +
+		"virginiavirtualvirtuelvlaanderenvolkenkundewaleswalloniewar" +
+		"washingtondcwatch-and-clockwatchandclockwesternwestfalenwhaling" +
+		"wildlifewilliamsburgwindmillworkshopyorkyorkshireyosemiteyouth" +
+		"zoologicalzoologyикомירושליםdrherhisat-band-camp" +
+		"broke-itbuyshousesdoes-itdynathomeendofinternetfrom-azfrom-co" +
+		"from-lafrom-nygets-itham-radio-ophomeftphomeipin-the-band" +
+		"kicks-assoffice-on-thepodzonescrapper-sitesells-itserveftp" +
+		"thruhereotherbvaaaarborteaejrieafjordagdenesakershusaknoluokta" +
+		"akrehamnalaheadjualesundalgardalstahaugaltaalvdalamliamot" +
+		"andasuoloandebuandoyandøyardalaremarkarendalarnaaseralasker" +
+		"askimaskoyaskvollaskøyasnesaudnedalnaukraaureaurland" +
+		"aurskog-holandaurskog-hølandaustevollaustrheimaveroyaverøy" +
+		"badaddjabahcavuotnabahccavuotnabaidarbajddarbalatbalestrand" +
+		"ballangenbalsfjordbamblebardubarumbatsfjordbearalvahki" +
+		"bearalváhkibeardubeiarnbergbergenberlevagberlevågbievatbievát" +
+		"bindalbirkenesbjarkoybjarkøybjerkreimbjugnbodobodøboknbomlo" +
+		"bremangerbronnoybronnoysundbrumunddalbrynebrønnøybrønnøysund" +
+		"bubudejjubuskerudbyglandbyklebáhcavuotnabáhccavuotnabáidár" +
+		"bájddarbálátbådåddjåbåtsfjordbærumbømlocahcesuolo" +
+		"davvenjargadavvenjárgadavvesiidadeatnudepdielddanuorri" +
+		"divtasvuodnadivttasvuotnadonnadovredrammendrangedaldrobakdrøbak" +
+		"dyroydyrøydønnaegersundeideidfjordeidsbergeidskogeidsvoll" +
+		"eigersundelverumenebakkengerdaletneetnedalevenassievenes" +
+		"evenáššievje-og-hornnesfarsundfauskefedjefetfetsundfhsfinnoy" +
+		"finnøyfitjarfjalerfjellflaflakstadflatangerflekkefjordflesberg" +
+		"florafloroflorøflåfolkebiblfolldalfordeforsandfosnesfrana" +
+		"fredrikstadfreifrognfrolandfrostafroyafrænafrøyafuoiskufuossko" +
+		"fusafylkesbiblfyresdalførdegaivuotnagalsagamvikgangaviikagaular" +
+		"gausdalgiehtavuoatnagildeskalgildeskålgiskegjemnesgjerdrum" +
+		"gjerstadgjesdalgjovikgjøvikgloppengolgrangranegranvingratangen" +
+		"grimstadgronggruegulenguovdageaidnugáivuotnagálságáŋgaviika" +
+		"habmerhadselhagebostadhaldenhalsahamarhamaroyhammarfeasta" +
+		"hammerfesthapmirharamhareidharstadhasvikhattfjelldalhaugesund" +
+		"hedmarkhemnehemneshemsedalheradhitrahjartdalhjelmelandhobol" +
+		"hobølhofhokksundholholeholmestrandholtalenholtålenhonefoss" +
+		"hordalandhornindalhortenhoyangerhoylandethurdalhurumhvaler" +
+		"hyllestadhábmerhámmárfeastahápmirhåhægebostadhønefoss" +
+		"høyangerhøylandetibestadidrettinderoyinderøyivelandivgu" +
+		"jan-mayenjessheimjevnakerjolsterjondaljorpelandjølster" +
+		"jørpelandkafjordkarasjohkakarasjokkarlsoykarmoykarmøy" +
+		"kautokeinokirkenesklabukleppklæbukommunekongsbergkongsvinger" +
+		"kopervikkraanghkekragerokragerøkristiansandkristiansund" +
+		"krodsheradkrokstadelvakråanghkekrødsheradkvafjordkvalsundkvam" +
+		"kvanangenkvinesdalkvinnheradkviteseidkvitsoykvitsøykvæfjord" +
+		"kvænangenkárášjohkakåfjordlaakesvuemielahppilangevag" +
+		"langevåglardallarviklavagislavangenleangaviikaleaŋgaviika" +
+		"lebesbyleikangerleirfjordleirviklekaleksviklenviklerdallesja" +
+		"levangerlierliernelillehammerlillesandlindaslindesneslindås" +
+		"loabatloabátlodingenlomloppalorenskoglotenlundlunnerluroylurøy" +
+		"lusterlyngdallyngenláhppilærdallødingenlørenskogløten" +
+		"malatvuopmimalselvmalvikmandalmarkermarnardalmasfjordenmasoy" +
+		"matta-varjjatmelandmeldalmelhusmeloymeløymerakermeråkermidsund" +
+		"midtre-gauldalmjondalenmjøndalenmo-i-ranamoarekemodalenmodum" +
+		"moldemore-og-romsdalmosjoenmosjøenmoskenesmossmosvikmoåreke" +
+		"muosatmuosátmálatvuopmimátta-várjjatmålselvmåsøy" +
+		"møre-og-romsdalnaamesjevuemienamdalseidnamsosnamsskogan" +
+		"nannestadnaroynarviikanarviknaustdalnavuotnanedre-eikernesna" +
+		"nesoddennesoddtangennessebynessetnissedalnittedalnord-aurdal" +
+		"nord-fronnord-odalnorddalnordkappnordlandnordre-landnordreisa" +
+		"nore-og-uvdalnotoddennotteroynávuotnanååmesjevuemienærøy" +
+		"nøtterøyoddaoksnesolomasvuotnaoppdaloppegardoppegårdorkanger" +
+		"orkdalorlandorskogorstaosenosloosoyroosteroyosterøyostfold" +
+		"ostre-totenosøyrooverhallaovre-eikeroyeroygardenoystre-slidre" +
+		"porsangerporsanguporsgrunnporsáŋguraderadoyradøyrahkkeravju" +
+		"raholtraisarakkestadralingenranarandabergraumarendalenrennebu" +
+		"rennesoyrennesøyrindalringeburingerikeringsakerrisorrissarisør" +
+		"rlroanrodoyrollagromsaromskogrorosrostroykenroyrvikruovatrygge" +
+		"ráhkkerávjuráisaråderåholtrælingenrødøyrømskogrøros" +
+		"røstrøykenrøyrviksalangensalatsaltdalsamnangersandefjord" +
+		"sandnessandnessjoensandnessjøensandoysandøysarpsborgsauda" +
+		"sauheradselselbuseljeseljordsfsiellaksigdalsiljansirdalskanit" +
+		"skanlandskaunskedsmoskedsmokorsetskienskiervaskierváskiptvet" +
+		"skjakskjervoyskjervøyskjåkskodjeskánitskånlandslattumsmola" +
+		"smølasnaasesnasasnillfjordsnoasasnåasesnåsasogndalsogne" +
+		"sokndalsolasolundsomnasondre-landsongdalensor-aurdalsor-fron" +
+		"sor-odalsor-varangersorfoldsorreisasortlandsorumspjelkavik" +
+		"spydebergstangestatstathellestavangerstavernsteigensteinkjer" +
+		"stjordalstjordalshalsenstjørdalstjørdalshalsenstokke" +
+		"stor-elvdalstordstordalstorfjordstrandstrandastrynsulasuldalsund" +
+		"sunndalsurnadalsvalbardsveiosvelviksykkylvensálatsálátsøgne" +
+		"sømnasøndre-landsør-aurdalsør-fronsør-odalsør-varanger" +
+		"sørfoldsørreisasørumtanatanangertelemarktingvolltinntjeldsund" +
+		"tjometjømetokketolgatonsbergtorskentranatranbytranoytranøy" +
+		"troandintrogstadtromsatromsotromsøtrondheimtrysiltræna" +
+		"trøgstadtvedestrandtydaltynsettysfjordtysnestysvartysvær" +
+		"tønsbergullensakerullensvangulvikunjargaunjárgautsiravaapste" +
+		"vadsovadsøvagavaganvagsoyvaksdalvallevangvanylvenvardovardø" +
+		"varggatvaroyvefsnvegavegarsheivegårsheivenneslaverdalverran" +
+		"vestbyvestfoldvestnesvestre-slidrevestre-totenvestvagoy" +
+		"vestvågøyvevelstadvfvgsvikviknavindafjordvoagatvoldavoss" +
+		"vossevangenvárggátvåganvågsøyvågåværøyákŋoluokta" +
+		"álaheadjuáltáåfjordåkrehamnålålesundålgårdåmliåmot" +
+		"årdalåsåseralåsnesøksnesørlandørskogørstaøstfold" +
+		"østre-totenøvre-eikerøyerøygardenøystre-slidrečáhcesuolo" +
+		"merseinemineshacknetmediaphonenawrasnawrastelecomomanmobile" +
+		"omanpostomantelrakpetroleumsiemenssongfeststatecouncilblogsite" +
+		"boldlygoingnowheredvrdnsendoftheinternetfrom-megame-hosthomedns" +
+		"is-a-bruinsfanis-a-candidateis-a-celticsfanis-a-knight" +
+		"is-a-linux-useris-a-patsfanis-a-soxfanis-foundis-lostis-saved" +
+		"is-very-badis-very-evilis-very-goodis-very-niceis-very-sweet" +
+		"misconfusedreadmyblogsellsyourhomeservegamestuff-4-saleaboingfam" +
+		"gokgongopgos6boneagroaidatmaugustowautobabia-gorabedzinbeskidy" +
+		"bialowiezabialystokbielawabieszczadyboleslawiecbydgoszczbytom" +
+		"cieszynczeladzczestdlugolekaelblagelkgdagdanskgdyniagliwice" +
+		"glogowgminagnieznogorlicegrajewogsmilawaircjaworznojelenia-gora" +
+		"jgorakaliszkarpaczkartuzykaszubykatowicekazimierz-dolnykepno" +
+		"ketrzynklodzkokobierzycekolobrzegkoninkonskowolakrakowkutnolapy" +
+		"leborklegnicalezajsklimanowalomzalowiczlubinlukowmailmalbork" +
+		"malopolskamazowszemazurymbonemiastamielecmielnomragowonaklo" +
+		"nieruchomoscinowarudanysaolawaoleckoolkuszolsztynopocznoopole" +
+		"ostrodaostrolekaostrowiecostrowwlkppilapiszpodhalepodlasie" +
+		"polkowicepomorskiepomorzepowiatpoznanprochowicepruszkowprzeworsk" +
+		"pulawyradomrawa-mazrealestaterybnikrzeszowsanoksejnysiedlcesklep" +
+		"skoczowslaskslupsksopotsossosnowiecstalowa-wolastarachowice" +
+		"stargardsuwalkiswidnicaswiebodzinswinoujscieszczecinszczytno" +
+		"szkolatargitarnobrzegtgorytourismturekturystykatychyusenetustka" +
+		"walbrzychwarmiawarszawawawwegrowwielunwloclwloclawekwodzislaw" +
+		"wolominwrocwroclawzachpomorzaganzakopanezarowzgorazgorzelecest" +
+		"islaprofacabarcpajurlawplosecnomepublbelauadygeyaaltaiamuramursk" +
+		"arkhangelskastrakhanbaikalbashkiriabelgorodbirbryanskburyatiacbg" +
+		"chelchelyabinskchitachukotkachuvashiacmwdagestandudinkae-burg" +
+		"fareastgroznyirkutskivanovoizhevskjamaljarjoshkar-olak-uralsk" +
+		"kalmykiakalugakamchatkakareliakazankchrkemerovokhabarovsk" +
+		"khakassiakhvkirovkmskoenigkomikostromakrasnoyarskkubankurgan" +
+		"kurskkustanaikuzbasslipetskmagadanmagnitkamarimari-elmarine" +
+		"mordoviamosregmskmurmanskmytisnakhodkanalchiknkznnovnorilsknov" +
+		"novosibirsknskomskorenburgoryoloskolpalanapenzapermpskovptz" +
+		"pyatigorskrndrubtsovskryazansakhalinsamarasaratovsimbirsk" +
+		"smolensksnzspbstavropolstvsurgutsyzrantambovtatarstantesttom" +
+		"tomsktsaritsyntsktulatuvatvertyumenudmudmurtiaulan-udevdonsk" +
+		"vladikavkazvladimirvladivostokvolgogradvologdavoronezhvrnvyatka" +
+		"yakutiayamalyaroslavlyekaterinburgyuzhno-sakhalinskzgradpubbrand" +
+		"fhfhskfhvkomforbkommunalforbundkomvuxlanbibnaturbruksgymnparti" +
+		"sshnunivconsuladoembaixadaprincipesaotomeagrinetdefenseedunetens" +
+		"intlmincomnatrnrtrnsrnuturenbetter-thanon-the-webworse-thanebiz" +
+		"game商業網路cherkassycherkasychernigovchernihivchernivtsi" +
+		"chernovtsycrimeadndnepropetrovskdnipropetrovskdominicdonetskdpif" +
+		"ivano-frankivskkharkivkharkovkhersonkhmelnitskiykhmelnytskyikiev" +
+		"kirovogradkrymkskvkyivlugansklutsklvivmykolaivnikolaevododesa" +
+		"odessapoltavarivnerovnorvsebastopolsevastopolsumyternopil" +
+		"uzhgorodvinnicavinnytsiavolynyaltazaporizhzhezaporizhzhia" +
+		"zhitomirzhytomyrzpztbritish-libraryjetnational-library-scotland" +
+		"nelnhsnlsparliamentpoliceakdcdnifedfliais-byisaland-4-salendnhnj" +
+		"nsnnvnyohoktxutwiwvwygube12mypetsaisaiamaanjoasukechiryufuso" +
+		"gamagorihandahazuhekinanhigashiuraichinomiyainazawainuyama" +
+		"isshikiiwakurakaniekariyakasugaikirakiyosukomakikonankotamihama" +
+		"miyoshinagakutenishionisshinobuoguchioharuokazakiowariasahiseto" +
+		"shikatsushinshiroshitarataharatakahamatobishimatoeitogotokai" +
+		"tokonametoyoaketoyohashitoyokawatoyonetoyotatsushimayatomidaisen" +
+		"fujisatogojomehachirogatahappouhigashinarusehonjohonjyoikawa" +
+		"kamikoanikamiokakatagamikazunokitaakitakosakakyowamisatomitane" +
+		"moriyoshinikahonoshiroodateogaogatasembokuyokoteyurihonjogonohe" +
+		"hachinohehashikamihiranaihirosakiitayanagikuroishimisawamutsu" +
+		"nakadomarinohejioiraseowanirokunohesannoheshichinoheshingotakko" +
+		"towadatsugarutsurutaabikoasahichonanchoseichoshichuofunabashi" +
+		"futtsuhanamigawaichiharaichikawainzaiisumikamagayakamogawa" +
+		"kashiwakatorikatsuurakimitsukisarazukozakikujukurikyonanmatsudo" +
+		"midoriminamibosomobaramutsuzawanagaranagareyamanarashinonarita" +
+		"nodaoamishirasatoomigawaonjukuotakisakaesakurashimofusashirako" +
+		"shiroishisuisodegaurasosatakotateyamatoganetohnoshotomisato" +
+		"urayasuyachimatayachiyoyokaichibayokoshibahikariyotsukaidoainan" +
+		"honaiikataimabariiyokamijimakihokukumakogenmasakimatsuno" +
+		"matsuyamanamikataniihamaozusaijoseiyoshikokuchuotobetoonuchiko" +
+		"uwajimayawatahamaechizeneiheijiikedakatsuyamaminamiechizenobama" +
+		"ohionosabaesakaitsurugawakasaashiyabuzenchikugochikuhochikujo" +
+		"chikushinochikuzendazaifufukuchihakatahigashihirokawahisayama" +
+		"iizukainatsukikahokasugakasuyakawarakeisenkogakuratekurogikurume" +
+		"minamimiyakomiyamamiyawakamizumakimunakatanakagawanakamanishi" +
+		"nogataogoriokagakiokawaokiomutaongaonojootosaigawasasagurishingu" +
+		"shinyoshitomishonaisoedasuetachiaraitagawatakatatohotoyotsu" +
+		"tsuikiukihaumiusuiyamadayameyanagawayukuhashiaizubangeaizumisato" +
+		"aizuwakamatsuasakawabandaidatefurudonofutabahanawahiratahirono" +
+		"iitateinawashiroiwakiizumizakikagamiishikaneyamakawamatakitakata" +
+		"kitashiobarakoorikoriyamakunimimiharumishimanamienangonishiaizu" +
+		"nishigookumaomotegootamasamegawashimogoshirakawashowasoma" +
+		"sukagawataishintamakawatanagurateneiyabukiyamatoyamatsuriyanaizu" +
+		"yugawaanpachienaginangodogujohashimahichisohidahigashishirakawa" +
+		"ibigawakakamigaharakanikasaharakasamatsukawauekitagatamino" +
+		"minokamomitakemizunamimotosunakatsugawaogakisakahogiseki" +
+		"sekigaharatajimitakayamataruitokitomikawanouchiyaotsuyoroannaka" +
+		"chiyodafujiokahigashiagatsumaisesakiitakurakannakanrakatashina" +
+		"kawabakiryukusatsumaebashimeiwaminakaminaganoharanakanojonanmoku" +
+		"numataoizumioraotashibukawashimonitashintotakasakitamamura" +
+		"tatebayashitomiokatsukiyonotsumagoiuenoyoshiokaasaminamidaiwa" +
+		"etajimafuchufukuyamahatsukaichihigashihiroshimahongojinsekikogen" +
+		"kaitakuikumanokuremiharanakaonomichiosakikamijimaotakesakasera" +
+		"seranishishinichishobaratakeharaabashiriabiraaibetsuakabira" +
+		"akkeshiasahikawaashibetsuashoroassabuatsumabibaibieibifukabihoro" +
+		"biratorichippubetsuchitoseebetsuembetsueniwaerimoesanesashi" +
+		"fukagawafuranofurubirahaborohakodatehamatonbetsuhidaka" +
+		"higashikagurahigashikawahiroohokuryuhokutohonbetsuhorokanai" +
+		"horonobeimakaneishikariiwamizawaiwanaikamifuranokamikawa" +
+		"kamishihorokamisunagawakamoenaikayabekembuchikikonaikimobetsu" +
+		"kitahiroshimakitamikiyosatokoshimizukunneppukuriyamakuromatsunai" +
+		"kushirokutchanmashikematsumaemikasaminamifuranomombetsumoseushi" +
+		"mukawamurorannaienakasatsunainakatombetsunanaenanporonayoro" +
+		"nemuroniikappunikinishiokoppenoboribetsuobihiroobiraoketookoppe" +
+		"otaruotobeotofukeotoineppuoumuozorapippurankoshirebunrikubetsu" +
+		"rishiririshirifujisaromasarufutsushakotansharishibechashibetsu" +
+		"shikabeshikaoishimamakishimizushimokawashinshinotsushintoku" +
+		"shiranukashiraoishiriuchisobetsusunagawataikitakasutakikawa" +
+		"takinoueteshikagatobetsutohmatomakomaitomaritoyatoyakotoyotomi" +
+		"toyouratsubetsutsukigataurakawaurausuuryuutashinaiwakkanai" +
+		"wassamuyakumoyoichiaioiakashiakoamagasakiaogakiasagoawaji" +
+		"fukusakigoshikiharimahimejiinagawaitamikakogawakamigorikasai" +
+		"kawanishimikiminamiawajinishinomiyanishiwakisandasannansasayama" +
+		"sayoshinonsenshisosumototaishitakatakarazukatakasagotakinotamba" +
+		"tatsunotoyookayabuyashiroyokayokawaamibandochikuseidaigo" +
+		"fujishirohitachihitachinakahitachiomiyahitachiotainainashiki" +
+		"itakoiwamajosokamisukasamakashimakasumigauramihomitomoriya" +
+		"namegataoaraiogawaomitamaryugasakisakuragawashimodateshimotsuma" +
+		"shirosatosowasuifutakahagitamatsukuritomobetonetoridetsuchiura" +
+		"tsukubauchiharaushikuyawarayukianamizuhakuihakusankagakahoku" +
+		"kanazawakawakitakomatsunakanotonanaonominonoichinotoshikasuzu" +
+		"tsubatatsurugiuchinadawajimafudaifujisawahanamakihiraizumi" +
+		"ichinoheichinosekiiwaizumijobojikamaishikanegasakikarumaikawai" +
+		"kitakamikujikunohekuzumakimizusawamoriokaninoheofunatooshu" +
+		"otsuchirikuzentakatashiwashizukuishisumitatakizawatanohatatono" +
+		"yahabaayagawahigashikagawakanonjikotohiramannomarugamemitoyo" +
+		"naoshimasanukitadotsutakamatsutonoshouchinomiutazuzentsujiakune" +
+		"amamihiokiisenizumikanoyakawanabekinkokouyamamakurazakimatsumoto" +
+		"minamitanenakatanenishinoomotesatsumasendaisootarumizuyusui" +
+		"aikawaatsugiayasechigasakiebinahadanohakonehiratsukaisehara" +
+		"kaiseikamakurakiyokawamatsudaminamiashigaramiuranakaininomiya" +
+		"odawaraoioisosagamiharasamukawatsukuiyamakitayokosukayugawara" +
+		"zamazushiakigeiseihigashitsunoinokagamikamikitagawamotoyama" +
+		"murotonaharinakamuranankokunishitosaniyodogawaochiotoyootsuki" +
+		"sakawasukumosusakitosatosashimizutoyotsunoumajiyasudayusuhara" +
+		"amakusaaraoasochoyogyokutohitoyoshikamiamakusakikuchikosamashiki" +
+		"mifuneminamataminamioguninagasunishiharaogunitakamoriukiuto" +
+		"yamagayatsushiroayabefukuchiyamahigashiyamaideinejoyokameokakamo" +
+		"kitakizukumiyamakyotambakyotanabekyotangomaizuruminamiyamashiro" +
+		"miyazumukonagaokakyonakagyonantanoyamazakisakyoseikatanabeuji" +
+		"ujitawarawazukayamashinayawatainabeisekameyamakawagoekiho" +
+		"kisosakikiwakomonokuwanamatsusakaminamiisemisuginabarishima" +
+		"suzukatadotakitamakitobatsuudonoureshinowataraiyokkaichifurukawa" +
+		"higashimatsushimaishinomakiiwanumakakudakesennumamarumori" +
+		"matsushimaminamisanrikumuratanatoriogawaraohiraonagawaosakirifu" +
+		"semineshibatashichikashukushikamashiogamashiroishitagajotaiwa" +
+		"tometomiyawakuyawatariyamamotozaoayaebinogokasehyugakadogawa" +
+		"kawaminamikijokitaurakobayashikunitomikushimamimatamiyakonojo" +
+		"morotsukanichinannishimeranobeokasaitoshiibashintomitakaharu" +
+		"takanabetakazakiachiagematsuananaokiazuminochikuhokuchikumachino" +
+		"fujimihakubaharahirayaiidaiijimaiiyamaiizunaikusakakaruizawa" +
+		"kawakamikisokisofukushimakitaaikikomaganekomoromatsukawamiasa" +
+		"minamiaikiminamimakiminamiminowaminowamiyadamiyotamochizuki" +
+		"nagawanagisonakanonozawaonsenobuseokayaomachiomiookuwaooshika" +
+		"otarisakakisakusakuhoshimosuwashinanomachishiojirisuwasuzaka" +
+		"takagitateshinatogakushitoguratomiuedawadayamanouchiyasaka" +
+		"yasuokachijiwafutsugotohasamihiradoisahayakawatanakuchinotsu" +
+		"matsuuraomuraosetosaikaisaseboseihishimabarashinkamigototogitsu" +
+		"unzenandogosehegurihigashiyoshinoikarugaikomakamikitayamakanmaki" +
+		"kashibakashiharakatsuragikoryokurotakimitsuemiyakenosegawaoji" +
+		"oudaoyodosakuraisangoshimoichishimokitayamashinjosonitakatori" +
+		"tawaramototenkawatenriudayamatokoriyamayamatotakadayamazoe" +
+		"yoshinoagaaganogosenitoigawaizumozakijoetsukariwakashiwazaki" +
+		"minamiuonumamitsukemuikamurakamimyokonagaokaojiyasadosanjoseiro" +
+		"seirousekikawatagamitainaitochiotokamachitsubametsunanuonuma" +
+		"yahikoyoitayuzawabeppubungoonobungotakadahasamahijihimeshimahita" +
+		"kamitsuekokonoekujukunisakikususaikitaketatsukumiusukiyufuakaiwa" +
+		"asakuchibizenhayashimaibarakagaminokasaokakibichuokumenan" +
+		"kurashikimaniwamisakinaginiiminishiawakurasatoshosetouchishoo" +
+		"sojatakahashitamanotsuyamawakeyakageaguniginowanginozagushikami" +
+		"haebaruhiraraiheyaishigakiitomanizenakadenakinkitadaito" +
+		"kitanakagusukukumejimakunigamiminamidaitomotobunagonaha" +
+		"nakagusukunakijinnanjoogimionnashimojitaketomitaramatokashiki" +
+		"tomigusukutonakiurasoeurumayaeseyomitanyonabaruyonagunizamami" +
+		"abenochihayaakasakadaitofujiiderahabikinohannanhigashiosaka" +
+		"higashisumiyoshihigashiyodogawahirakataizumiotsuizumisanokadoma" +
+		"kaizukakanankashiwarakatanokawachinaganokishiwadakumatori" +
+		"matsubaraminatominohmoriguchineyagawanoseosakasayamasayamasennan" +
+		"settsushijonawateshimamotosuitatadaokatajiritakaishitakatsuki" +
+		"tondabayashitoyonakatoyonoyaoariakearitafukudomigenkaihamatama" +
+		"hizenimarikamiminekanzakikaratsukitahatakiyamakouhokukyuragi" +
+		"nishiaritaogiouchitakutaratosuyoshinogariarakawaasakachichibu" +
+		"fujiminofukayahannohanyuhasudahatogayahatoyamahigashichichibu" +
+		"higashimatsuyamairumaiwatsukikamiizumikamisatokasukabekawaguchi" +
+		"kawajimakazokitamotokoshigayakounosukukikumagayamatsubushiminano" +
+		"miyashiromoroyamanagatoronamegawaniizaoganoogoseokegawaomiya" +
+		"ranzanryokamisakadosatteshikishiraokasokasugitotodatokigawa" +
+		"tokorozawatsurugashimaurawawarabiyashioyokozeyonoyoriiyoshida" +
+		"yoshikawayoshimiaishogamohigashiomihikonekokakoseikotomaibara" +
+		"moriyamanagahamanishiazainotogawaomihachimanotsurittoryuoh" +
+		"takashimatorahimetoyosatoyasuakagigotsuhamadahigashiizumohikawa" +
+		"hikimiizumokakinokimasudamatsuenishinoshimaohdaokinoshima" +
+		"okuizumotamayutsuwanounnanyasugiyatsukaaraiatamifujifujieda" +
+		"fujikawafujinomiyafukuroigotembahaibarahamamatsuhigashiizuito" +
+		"iwataizuizunokunikakegawakannamikawanehonkawazukikugawakosai" +
+		"makinoharamatsuzakiminamiizumorimachinishiizunumazuomaezaki" +
+		"shimadashimodasusonoyaizuashikagabatohagaichikaiiwafune" +
+		"kaminokawakanumakarasuyamakuroisomashikomibumokamoteginasu" +
+		"nasushiobaranikkonishikatanogiohtawaraoyamasanoshimotsukeshioya" +
+		"takanezawatsugaujiieutsunomiyayaitaaizumiichibaitanokainan" +
+		"komatsushimamatsushigemimamuginarutosanagochishishikuiwajiki" +
+		"adachiakirunoakishimaaogashimabunkyochofuedogawafussahachijo" +
+		"hachiojihamurahigashikurumehigashimurayamahigashiyamatohino" +
+		"hinodehinoharainagiitabashikatsushikakiyosekodairakoganei" +
+		"kokubunjikomaekouzushimakunitachimachidameguromitakamizuho" +
+		"musashimurayamamusashinonerimaogasawaraokutamaomeoshimasetagaya" +
+		"shibuyashinagawashinjukusuginamisumidatachikawataitotamatoshima" +
+		"chizukawaharakogekotouramisasananbusakaiminatoyazuyonago" +
+		"fukumitsufunahashihimiimizuinamijohanakamiichikurobenakaniikawa" +
+		"namerikawanantonyuzenoyabetairatakaokatogatonamiunazukiuozuarida" +
+		"aridagawagobohashimotohirogawaiwadekamitondakiminokinokawa" +
+		"kitayamakoyakozakozagawakudoyamakushimotonachikatsuurashirahama" +
+		"taijiyuasayurafunagatahigashineiidekaminoyamamamurogawamikawa" +
+		"murayamanagainakayamananyonishikawaobanazawaoeohkuraoishidasagae" +
+		"sakatasakegawashiratakatakahatatendotozawatsuruokayamanobe" +
+		"yonezawayuzaabuhagihikarihofuiwakunikudamatsumitounagato" +
+		"shimonosekishunantabusetokuyamaubeyuudoshifuefukifujikawaguchiko" +
+		"fujiyoshidahayakawaichikawamisatokaikofukoshukosugeminami-alps" +
+		"minobunakamichinarusawanirasakinishikatsuraoshinotabayamatsuru" +
+		"uenoharayamanakakoforgotnesosvalervålerheroysandeherøybøhome" +
+		"starostwoumupowuwchtrparoch",
+	offset: []uint32{
+		0, 0, 2, 4, 6, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 32,
+		34, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 67,
+		69, 71, 73, 75, 77, 79, 81, 83, 85, 87, 89, 92, 94, 96, 98, 100,
+		102, 104, 106, 108, 110, 112, 114, 117, 121, 123, 125, 127, 129, 131, 133, 135,
+		137, 139, 141, 143, 145, 147, 150, 152, 154, 156, 158, 160, 162, 164, 166, 168,
+		170, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190, 192, 194, 197, 199, 201,
+		203, 205, 207, 209, 211, 213, 215, 217, 219, 221, 223, 225, 227, 229, 231, 233,
+		235, 239, 242, 244, 246, 248, 250, 252, 254, 256, 258, 262, 264, 266, 268, 270,
+		272, 274, 276, 278, 280, 282, 284, 286, 288, 290, 292, 294, 296, 298, 300, 302,
+		304, 306, 308, 310, 312, 314, 316, 318, 320, 323, 325, 327, 329, 331, 333, 337,
+		339, 341, 343, 345, 347, 349, 355, 357, 359, 361, 363, 365, 367, 371, 373, 375,
+		378, 380, 382, 384, 386, 388, 390, 392, 394, 396, 398, 401, 403, 405, 407, 409,
+		411, 413, 415, 417, 419, 421, 424, 426, 428, 430, 432, 434, 436, 438, 440, 442,
+		444, 446, 448, 450, 452, 454, 456, 458, 460, 462, 464, 466, 468, 470, 472, 474,
+		476, 478, 480, 482, 484, 486, 488, 491, 493, 495, 497, 499, 501, 503, 505, 507,
+		509, 511, 517, 519, 521, 523, 525, 527, 529, 531, 533, 535, 537, 539, 541, 543,
+		545, 547, 549, 551, 553, 555, 558, 560, 562, 564, 566, 568, 572, 578, 584, 596,
+		610, 626, 642, 658, 674, 686, 696, 708, 718, 728, 738, 746, 756, 766, 774, 786,
+		792, 798, 810, 825, 837, 849, 861, 882, 900, 933, 948, 960, 969, 975, 981, 987,
+		993, 999, 1008, 1014, 1020, 114, 147, 194, 320, 375, 398, 1026, 0, 112, 194, 320,
+		375, 398, 1029, 1032, 1054, 1073, 1082, 1090, 1099, 1105, 1121, 1140, 1148, 1155, 1162, 1172,
+		1181, 1190, 1201, 1207, 1217, 1223, 1226, 1231, 1239, 1252, 1264, 1271, 1284, 1288, 1298, 1308,
+		1318, 1325, 1332, 1336, 1342, 1346, 1354, 1363, 1369, 1377, 1390, 1399, 1407, 1414, 1424, 1430,
+		1437, 1441, 1448, 1458, 1472, 1477, 1488, 1497, 1506, 1513, 1523, 1530, 1539, 1547, 1558, 1569,
+		1574, 1584, 1593, 1603, 1614, 1625, 1646, 1651, 1656, 1666, 1676, 1683, 1686, 1694, 1704, 1710,
+		1719, 1727, 1731, 1740, 1748, 1755, 1759, 1765, 1772, 1779, 1784, 1796, 114, 147, 194, 375,
+		398, 112, 114, 375, 1026, 398, 114, 375, 1801, 398, 114, 147, 194, 320, 375, 398,
+		114, 147, 375, 398, 112, 1804, 1806, 250, 1808, 1810, 1812, 1831, 1835, 1854, 1859, 1865,
+		1868, 1877, 1883, 1886, 1890, 1897, 1900, 1904, 1907, 194, 0, 64, 112, 1806, 235, 1910,
+		1912, 1916, 1919, 114, 1922, 1926, 147, 194, 225, 235, 375, 1931, 1934, 398, 1936, 1938,
+		444, 1941, 1944, 1947, 114, 64, 114, 147, 194, 235, 239, 320, 367, 375, 398, 1949,
+		421, 112, 114, 147, 194, 320, 375, 398, 438, 1951, 1955, 64, 114, 147, 194, 235,
+		375, 398, 1959, 0, 194, 1964, 1965, 1966, 1967, 1968, 1969, 1970, 1971, 1972, 1973, 1974,
+		1975, 1976, 1977, 1978, 1979, 1980, 1981, 1982, 1983, 1984, 1985, 1986, 1987, 1988, 1989, 1990,
+		1991, 1992, 1993, 1994, 1995, 1996, 1997, 1998, 1999, 114, 147, 194, 375, 398, 112, 114,
+		147, 1910, 398, 2000, 2006, 2016, 2024, 2032, 2039, 2045, 2051, 2055, 2062, 114, 147, 194,
+		375, 398, 114, 147, 2066, 194, 239, 320, 375, 398, 519, 2069, 2072, 2075, 18, 2078,
+		2081, 2084, 1975, 2087, 2090, 2094, 2097, 2100, 2103, 114, 117, 2106, 2109, 147, 2112, 2115,
+		2118, 2121, 2124, 2127, 2130, 168, 2134, 2137, 2140, 2143, 2146, 194, 2149, 2152, 2155, 2158,
+		2161, 2164, 2167, 2170, 2173, 320, 2176, 375, 1026, 2179, 2182, 2185, 398, 2188, 421, 2191,
+		2194, 2197, 2200, 2205, 2208, 2211, 1755, 2214, 2217, 2220, 2223, 519, 2226, 2229, 2233, 2237,
+		114, 147, 194, 375, 398, 114, 147, 194, 375, 398, 112, 398, 114, 194, 320, 2240,
+		114, 147, 194, 375, 398, 2242, 2244, 112, 2246, 2248, 2250, 378, 384, 2252, 1934, 392,
+		2254, 403, 2256, 460, 2258, 2260, 2269, 2280, 2288, 194, 0, 2051, 2297, 112, 114, 1804,
+		147, 2306, 2062, 239, 312, 375, 1910, 398, 2308, 2314, 112, 2066, 194, 320, 194, 0,
+		2317, 67, 114, 2319, 147, 164, 176, 194, 203, 2321, 2323, 2325, 2327, 2329, 2331, 213,
+		2333, 217, 2335, 2337, 2339, 2341, 320, 331, 375, 2343, 2345, 398, 2347, 448, 450, 456,
+		466, 478, 497, 521, 2349, 2351, 2353, 2355, 2357, 2363, 2369, 2375, 114, 147, 2379, 194,
+		235, 239, 320, 375, 1026, 398, 2205, 2383, 2386, 26, 2393, 75, 2400, 110, 133, 2407,
+		2415, 2422, 2433, 2442, 2449, 2461, 2471, 2479, 2493, 2507, 2518, 2529, 2540, 2549, 2560, 2573,
+		2584, 2597, 2610, 2620, 2631, 2642, 2657, 2672, 2685, 160, 2701, 2708, 2715, 2722, 2729, 2736,
+		2743, 2750, 2757, 2764, 2771, 2778, 2785, 2792, 2799, 2806, 2813, 2820, 2827, 2834, 2841, 2848,
+		2855, 2862, 2869, 2876, 2883, 2890, 2897, 2904, 2911, 2918, 2925, 2932, 2939, 2946, 2953, 2960,
+		2967, 2974, 2981, 2988, 2995, 3002, 3009, 3016, 3023, 3030, 3032, 3039, 201, 3045, 3055, 3064,
+		223, 3072, 3081, 3095, 3107, 3122, 3136, 3148, 3157, 3174, 3182, 3200, 3213, 3226, 3237, 3258,
+		3267, 3277, 3286, 3302, 3313, 3328, 3339, 3351, 3367, 3377, 3390, 3404, 3414, 3426, 3446, 3463,
+		3474, 3489, 3502, 3516, 3528, 3540, 3551, 3565, 3581, 3592, 3605, 3620, 3632, 3646, 3663, 3675,
+		3682, 3695, 3707, 3723, 3736, 3743, 3759, 3767, 3778, 3793, 3801, 3814, 3830, 278, 3833, 3842,
+		3852, 386, 3860, 2256, 440, 444, 3870, 452, 2039, 3886, 3900, 3911, 3919, 3929, 3942, 529,
+		531, 533, 3954, 562, 0, 112, 1804, 162, 2306, 1910, 444, 114, 147, 194, 2155, 375,
+		398, 3968, 194, 114, 3971, 3986, 3996, 4003, 4013, 4023, 114, 147, 194, 375, 398, 2081,
+		114, 147, 2066, 194, 320, 375, 398, 4036, 2383, 2081, 2051, 114, 147, 194, 375, 398,
+		4039, 114, 147, 4042, 2066, 194, 235, 4045, 2173, 320, 375, 398, 421, 4048, 114, 147,
+		4051, 194, 4054, 2173, 398, 4057, 4060, 114, 147, 4064, 194, 320, 367, 375, 398, 4067,
+		114, 147, 2066, 1026, 398, 4070, 4075, 4078, 4086, 2051, 4093, 4099, 4105, 4108, 4117, 114,
+		4138, 4156, 2062, 4171, 4176, 4192, 1026, 4199, 4207, 4217, 4221, 2308, 503, 4224, 114, 147,
+		194, 320, 375, 398, 4235, 112, 194, 375, 398, 1029, 114, 147, 194, 320, 398, 114,
+		147, 194, 4238, 4241, 398, 0, 114, 147, 194, 375, 398, 2051, 114, 147, 333, 375,
+		398, 114, 147, 194, 375, 398, 2314, 112, 114, 375, 114, 147, 194, 4244, 375, 398,
+		4247, 4253, 2357, 4259, 4265, 4271, 4277, 4283, 4289, 2363, 4295, 4301, 4307, 4313, 2369, 114,
+		147, 2066, 320, 375, 398, 114, 4319, 4323, 367, 4325, 2081, 2051, 114, 117, 147, 2379,
+		2062, 235, 2173, 375, 398, 4330, 4039, 421, 4335, 4338, 4342, 4346, 4351, 4355, 4361, 112,
+		4365, 4372, 4379, 4383, 4388, 4393, 235, 4398, 4406, 4412, 4420, 1569, 4425, 398, 1912, 4429,
+		4435, 4338, 4438, 4443, 4447, 503, 4451, 4457, 4463, 0, 112, 2306, 320, 375, 1910, 1029,
+		2383, 194, 0, 112, 194, 375, 1865, 398, 0, 112, 147, 2379, 4468, 194, 2152, 320,
+		375, 1865, 398, 1683, 4471, 4490, 2000, 4510, 4517, 4526, 4536, 4549, 2039, 2045, 160, 114,
+		114, 147, 194, 320, 375, 398, 0, 112, 194, 225, 375, 398, 1029, 708, 718, 114,
+		147, 194, 239, 375, 398, 12, 4559, 16, 4568, 4579, 4589, 20, 4598, 4604, 4625, 4646,
+		4665, 22, 4684, 4689, 4694, 24, 4696, 26, 4702, 4708, 4721, 4733, 38, 4737, 4739, 48,
+		4747, 4753, 4757, 4778, 4797, 4804, 4813, 58, 62, 4820, 4826, 71, 73, 4828, 4835, 4842,
+		75, 4847, 4854, 77, 79, 85, 87, 4862, 4870, 4883, 4898, 4912, 4922, 4939, 4955, 4968,
+		4980, 4987, 4994, 5003, 5005, 5007, 5019, 100, 5030, 102, 106, 110, 112, 5036, 5040, 121,
+		5047, 5054, 5061, 5063, 5065, 131, 5070, 5084, 147, 5097, 5099, 5103, 5105, 5107, 5112, 5119,
+		162, 5121, 5128, 168, 5136, 5142, 5154, 172, 5165, 178, 5174, 5179, 2306, 5185, 194, 201,
+		5192, 5200, 5217, 231, 5233, 248, 5240, 278, 5247, 5256, 5263, 5271, 290, 5277, 5279, 5284,
+		292, 5289, 5296, 5298, 300, 302, 5302, 5307, 5315, 5322, 5335, 5347, 2248, 310, 314, 5353,
+		5368, 5382, 5389, 5391, 5396, 329, 331, 5402, 5408, 5413, 5426, 5447, 5459, 5472, 343, 345,
+		365, 5490, 5496, 386, 5502, 392, 5508, 1808, 5513, 5522, 5534, 1910, 5545, 5553, 401, 5555,
+		5561, 5566, 5573, 5578, 5583, 5585, 403, 5587, 5594, 5607, 5619, 407, 5626, 5628, 5636, 5640,
+		417, 5647, 5649, 5658, 419, 5665, 426, 5670, 5672, 5674, 5676, 5678, 5684, 5691, 434, 5693,
+		5708, 5721, 5735, 5747, 5749, 5751, 5756, 5762, 5764, 436, 5766, 5770, 5774, 444, 5780, 5787,
+		5794, 458, 5800, 5805, 468, 5813, 5820, 470, 5822, 5824, 476, 5833, 5835, 5842, 5844, 5856,
+		5867, 5873, 505, 507, 5878, 5884, 509, 5886, 5907, 5928, 5947, 5966, 5973, 5981, 5987, 5994,
+		6001, 6003, 519, 6008, 6010, 6015, 6028, 537, 6040, 6046, 539, 541, 6048, 6055, 6061, 6069,
+		6077, 545, 6083, 6096, 6108, 6115, 6122, 6124, 6126, 6128, 112, 194, 375, 398, 1029, 114,
+		147, 194, 320, 367, 375, 398, 1029, 0, 2, 6130, 6135, 6140, 6146, 112, 1804, 6151,
+		6156, 6161, 6168, 6177, 2306, 201, 6181, 6186, 6195, 6203, 6208, 6215, 6223, 6228, 6234, 6243,
+		6251, 6259, 6269, 6273, 6278, 6286, 6291, 6293, 6296, 6302, 6310, 6316, 6324, 6330, 373, 6334,
+		6341, 6345, 6352, 1910, 6359, 6364, 6368, 6375, 6382, 6388, 6393, 6400, 6408, 6415, 6424, 6429,
+		6436, 6442, 6450, 6458, 6467, 6476, 114, 147, 194, 320, 375, 398, 64, 114, 147, 194,
+		235, 375, 398, 6484, 2051, 114, 117, 147, 2062, 194, 4192, 320, 1026, 4199, 398, 6487,
+		4221, 2308, 503, 4224, 147, 194, 375, 398, 114, 147, 194, 398, 6498, 6501, 0, 6504,
+		6509, 6517, 112, 6525, 6530, 156, 6537, 2306, 6544, 6551, 6560, 6568, 6577, 6579, 6586, 6590,
+		6597, 266, 320, 343, 373, 1910, 403, 434, 448, 6604, 6609, 114, 147, 194, 375, 398,
+		114, 147, 194, 320, 375, 398, 1976, 114, 147, 194, 235, 239, 375, 398, 6614, 114,
+		147, 194, 375, 398, 112, 114, 147, 194, 375, 398, 6617, 114, 147, 194, 6621, 4393,
+		239, 4238, 375, 6624, 398, 1029, 6627, 2383, 114, 147, 194, 375, 398, 112, 398, 194,
+		1919, 114, 1922, 147, 194, 225, 320, 375, 398, 114, 147, 194, 225, 2173, 375, 398,
+		6630, 1029, 0, 112, 194, 375, 398, 1651, 2051, 503, 0, 112, 147, 194, 6633, 375,
+		398, 1912, 114, 147, 194, 320, 1026, 398, 4221, 503, 114, 147, 194, 2155, 367, 375,
+		398, 114, 147, 2062, 194, 375, 398, 2308, 147, 194, 398, 114, 147, 194, 375, 398,
+		194, 0, 112, 114, 194, 375, 1910, 398, 6636, 6643, 6654, 6657, 6665, 6672, 6678, 1172,
+		6683, 6691, 6700, 6716, 6727, 6736, 6739, 6748, 6754, 6766, 6774, 6782, 6791, 6805, 6816, 2081,
+		6828, 6840, 6849, 6856, 6868, 2375, 6878, 6891, 6899, 6912, 1190, 6918, 6927, 6934, 6940, 6949,
+		6959, 6967, 6971, 6978, 6985, 6989, 6993, 7002, 7011, 7019, 7024, 7029, 7035, 7044, 7057, 7065,
+		7072, 7080, 7086, 7090, 7095, 7101, 7105, 7112, 7122, 7126, 7132, 7141, 7156, 7169, 7175, 7191,
+		7197, 7204, 7211, 7226, 7235, 7241, 7248, 7256, 7265, 7273, 7280, 7283, 7289, 7297, 7307, 7316,
+		7319, 7325, 7335, 7342, 7352, 7366, 7372, 7379, 7385, 7391, 7402, 7412, 7425, 7432, 7440, 7449,
+		7464, 7476, 7485, 7499, 7509, 7515, 7521, 7533, 7545, 7553, 7560, 7565, 7569, 7583, 7587, 7594,
+		7604, 7624, 7639, 7647, 7655, 7668, 7682, 7691, 7699, 7714, 7728, 7740, 7755, 7762, 7772, 7783,
+		7812, 7820, 7827, 7840, 7846, 7852, 7861, 7869, 7877, 7891, 7898, 7903, 7908, 7912, 7918, 7926,
+		7929, 7943, 7951, 7962, 7969, 1336, 7974, 7981, 7989, 7998, 8003, 8011, 8017, 8027, 8036, 8045,
+		8056, 8064, 8073, 8079, 8089, 8099, 8111, 8118, 8128, 8139, 8164, 8172, 8177, 8183, 8192, 8198,
+		8208, 8214, 8218, 8231, 8238, 8247, 8252, 8260, 4379, 8269, 8276, 8284, 8291, 8299, 8306, 8311,
+		8323, 8332, 8342, 8351, 8360, 8372, 8383, 8391, 8399, 8403, 8411, 8420, 8427, 8433, 8440, 8449,
+		8460, 8467, 8474, 8481, 8485, 8490, 8495, 8506, 8510, 8518, 8528, 8535, 8542, 8560, 8566, 8572,
+		8586, 8592, 8600, 8615, 8623, 8631, 8641, 8658, 8672, 8682, 8694, 8701, 8717, 8725, 8730, 8740,
+		8752, 8765, 8771, 8778, 8790, 8802, 8814, 8825, 8829, 8833, 8842, 8849, 8858, 8867, 8874, 8880,
+		8889, 8892, 8902, 8909, 8920, 8930, 8934, 8940, 8949, 8953, 8963, 8968, 8973, 8986, 9000, 9005,
+		9011, 9018, 9028, 9034, 9038, 9045, 9056, 9063, 9067, 9073, 9086, 9098, 9104, 9114, 9120, 9128,
+		9135, 9145, 9151, 9156, 9159, 9165, 9173, 9183, 9190, 9198, 9202, 9209, 9217, 9225, 9233, 1569,
+		9243, 9250, 9269, 9275, 9283, 9292, 9300, 9311, 9319, 9323, 9329, 9335, 9344, 9351, 9359, 9365,
+		9369, 9374, 9382, 9392, 9400, 9406, 9416, 9424, 9432, 9440, 9446, 9452, 9464, 9480, 9485, 9493,
+		9509, 9525, 9539, 9553, 9573, 9588, 9594, 9611, 9630, 9638, 9643, 9651, 9656, 9668, 9677, 9686,
+		9693, 9702, 9709, 9715, 9722, 9727, 9730, 9739, 9748, 9751, 9755, 9768, 9783, 9788, 9794, 9801,
+		9808, 9814, 9825, 9830, 9836, 9843, 9852, 9858, 9863, 9874, 9880, 9885, 9893, 9901, 9913, 9929,
+		9938, 9945, 9956, 9962, 9972, 9983, 9993, 9999, 10004, 10010, 10018, 10028, 10056, 10068, 1651, 10076,
+		10083, 10089, 10094, 10100, 10108, 1686, 10115, 10125, 10137, 10146, 5766, 10153, 10159, 10169, 10174, 10186,
+		10194, 10202, 10214, 10226, 10235, 10242, 10254, 10258, 10268, 10280, 10291, 10302, 10308, 10315, 10322, 10337,
+		10354, 10372, 10385, 10399, 10413, 10421, 10439, 10447, 10454, 10464, 10472, 10477, 10487, 10494, 10498, 10501,
+		10506, 10513, 10520, 10534, 10547, 10556, 10561, 10564, 10570, 10575, 10583, 10592, 10597, 10612, 10619, 10624,
+		10634, 10640, 10649, 10661, 10670, 10676, 10688, 10694, 10702, 10708, 10714, 10718, 10721, 10731, 10748, 10758,
+		10763, 10770, 10777, 10781, 10792, 5878, 10800, 10805, 10809, 10818, 10822, 10829, 10834, 10841, 10846, 10849,
+		10857, 10867, 10870, 10880, 10886, 10901, 10910, 10926, 10934, 10943, 10950, 10965, 10969, 10973, 10979, 10985,
+		10995, 11001, 11008, 11016, 11023, 11030, 11040, 11051, 11056, 11064, 11067, 11079, 11094, 11107, 11114, 11123,
+		11130, 11138, 11150, 11158, 11166, 11170, 11179, 11187, 11192, 11202, 11209, 11217, 6, 64, 114, 117,
+		147, 194, 235, 239, 320, 349, 367, 375, 398, 421, 0, 64, 112, 114, 117, 147,
+		194, 239, 349, 375, 398, 114, 147, 2066, 375, 398, 114, 147, 194, 320, 367, 375,
+		398, 87, 92, 112, 114, 11231, 233, 235, 333, 359, 367, 1910, 398, 421, 10302, 519,
+		531, 553, 11233, 11236, 2051, 11239, 2393, 11251, 11259, 2407, 2415, 11269, 2433, 2471, 11276, 11285,
+		11298, 11305, 11312, 11319, 3030, 11326, 11333, 11345, 11352, 3055, 3064, 223, 11358, 3148, 3258, 3793,
+		262, 11369, 11378, 11391, 11398, 452, 2039, 11411, 3911, 11419, 11427, 529, 2045, 562, 2375, 114,
+		2379, 235, 375, 11435, 6614, 2205, 1959, 2383, 0, 114, 147, 194, 375, 398, 11440, 112,
+		11442, 11444, 11452, 11458, 11464, 2317, 11471, 11479, 11489, 16, 11497, 11506, 11513, 11519, 11528, 11532,
+		11538, 11542, 11546, 11555, 11561, 11566, 11572, 11577, 11584, 11591, 11595, 11601, 11606, 11611, 11616, 11623,
+		11629, 11634, 11643, 11648, 11652, 11659, 11673, 11688, 11697, 11706, 11712, 11719, 11727, 11738, 11750, 11756,
+		11763, 11768, 11778, 11787, 11796, 11802, 11807, 11812, 11821, 11832, 11844, 11850, 11856, 11860, 11866, 11874,
+		11883, 11889, 11896, 11902, 11910, 11917, 11925, 11934, 11939, 11943, 11948, 11952, 11957, 11966, 11973, 11984,
+		11994, 11999, 12008, 12021, 12023, 12030, 12038, 12045, 12050, 12062, 12075, 12083, 12091, 12098, 12109, 12119,
+		12125, 12131, 112, 12141, 12152, 12164, 12174, 12180, 12183, 12196, 12208, 12221, 12226, 12231, 12238, 12247,
+		12253, 12260, 12265, 12271, 12277, 12285, 12288, 12296, 12304, 12311, 12319, 12328, 12335, 12342, 12350, 12354,
+		12361, 12369, 12375, 12386, 12401, 12408, 12414, 12419, 12422, 12429, 12432, 12438, 12445, 12451, 12457, 12462,
+		12465, 12473, 12482, 12493, 12501, 12506, 12511, 12517, 168, 12521, 12530, 12537, 12542, 12549, 12555, 12560,
+		12571, 12575, 12580, 12587, 12593, 12598, 12604, 12610, 12617, 12624, 12628, 12638, 12646, 12652, 12661, 12666,
+		12672, 12682, 12688, 12695, 12708, 12717, 12727, 12732, 12739, 12747, 12755, 12762, 12768, 12775, 12782, 12785,
+		12789, 12794, 12801, 12810, 12818, 12823, 12827, 12832, 12845, 12855, 12862, 2325, 12874, 12880, 12886, 12896,
+		12902, 12907, 12912, 12919, 12931, 12941, 12947, 12952, 12958, 12965, 12971, 12983, 12992, 12999, 13004, 13010,
+		13018, 13023, 13028, 13036, 2333, 215, 13046, 13051, 13057, 13060, 13068, 13071, 13075, 13086, 13094, 13103,
+		13111, 13120, 13129, 13135, 13143, 13152, 13158, 13163, 13169, 13178, 13185, 13199, 13206, 13209, 13220, 13229,
+		13238, 13248, 13255, 13261, 13268, 13276, 13283, 13287, 13296, 13304, 13312, 13319, 13325, 13334, 13342, 13352,
+		13359, 13369, 13377, 13384, 13390, 13397, 13407, 13415, 13420, 13425, 13431, 13438, 13447, 13458, 13466, 13475,
+		13482, 13490, 13502, 13514, 13524, 13536, 13546, 13557, 13565, 13573, 13577, 13586, 13595, 13605, 13614, 13621,
+		13629, 13638, 13648, 13661, 13669, 13681, 13687, 13695, 13704, 13710, 13716, 13723, 13731, 13742, 13754, 13761,
+		13770, 13779, 13786, 13790, 13797, 13803, 13809, 13814, 13822, 13826, 13832, 13843, 13852, 13858, 13867, 13874,
+		13880, 13887, 13895, 13898, 13903, 13912, 13917, 13921, 13927, 13932, 13938, 13944, 13951, 13957, 13964, 13971,
+		13980, 13990, 13996, 14007, 14014, 14020, 14026, 14032, 14041, 14051, 14056, 14069, 14075, 14081, 14087, 14092,
+		14098, 14105, 14113, 14120, 320, 14134, 14143, 14153, 14162, 14169, 14176, 14181, 14186, 14201, 14208, 14216,
+		14224, 14228, 14234, 341, 14242, 14248, 349, 14255, 14267, 14282, 14290, 14297, 14313, 14327, 14337, 14343,
+		14353, 14362, 14367, 14375, 14381, 14389, 14397, 14408, 14413, 14421, 14433, 14440, 14446, 14454, 384, 14462,
+		14473, 14482, 14491, 14498, 14506, 14514, 14525, 14534, 14547, 14555, 1934, 14563, 14572, 14588, 14595, 14605,
+		2240, 14609, 14615, 14617, 14627, 14633, 14641, 14650, 14658, 14664, 14670, 14676, 14681, 14685, 14689, 14695,
+		14702, 14710, 14717, 14728, 14735, 14744, 14754, 14758, 14766, 14779, 14788, 14796, 14805, 1912, 14815, 14819,
+		14824, 14830, 14841, 14847, 14852, 14861, 14869, 14873, 14882, 14887, 14895, 14902, 14910, 14919, 14925, 14932,
+		14941, 14950, 14955, 14960, 14966, 14968, 14972, 14977, 14983, 14988, 14995, 15000, 15004, 15010, 15017, 15023,
+		15028, 15041, 15047, 15052, 15059, 15068, 15075, 15083, 15089, 15094, 15101, 15109, 15117, 15122, 15129, 15138,
+		15148, 15155, 15167, 15180, 15186, 15193, 15202, 15207, 15215, 15218, 15223, 15228, 15235, 15237, 15244, 15250,
+		15256, 15262, 15268, 15276, 15281, 15288, 10498, 15301, 15306, 15313, 15321, 15329, 15334, 15342, 15351, 15357,
+		15363, 15370, 15379, 15386, 15391, 15397, 15403, 15408, 15418, 15424, 15431, 15437, 15444, 15449, 15456, 15460,
+		15466, 15471, 15482, 15491, 15501, 15509, 15517, 15529, 15536, 15544, 15552, 15557, 15567, 472, 15576, 15582,
+		15586, 15595, 15604, 15611, 15618, 15627, 15635, 15650, 15659, 15675, 15681, 15692, 15697, 15704, 15713, 15719,
+		15726, 15731, 15735, 15741, 15745, 15752, 15760, 15768, 15773, 15780, 15789, 15795, 15802, 15808, 15814, 15826,
+		15837, 15846, 15855, 15868, 15876, 15885, 15891, 15895, 15903, 10777, 15911, 15919, 15923, 15932, 15937, 503,
+		15943, 15948, 15953, 15961, 509, 15968, 15973, 15979, 15985, 15992, 16000, 16008, 16014, 16020, 16027, 16036,
+		16042, 16048, 16057, 16068, 16073, 16079, 16087, 16093, 16099, 16106, 16115, 16125, 16135, 16140, 16147, 16155,
+		537, 16161, 16168, 16173, 16179, 16183, 16188, 16194, 16201, 16206, 16210, 16218, 16223, 16229, 16236, 16241,
+		16246, 16250, 16259, 16269, 16277, 16283, 16289, 16295, 16303, 16310, 16323, 16335, 16344, 16355, 16364, 16366,
+		16369, 16372, 16377, 16387, 16393, 16398, 16402, 16413, 16422, 16428, 16436, 16442, 16449, 16461, 16471, 16477,
+		16484, 16493, 16496, 16504, 16512, 16517, 16522, 16528, 16531, 16538, 16544, 16551, 16558, 16565, 16571, 16579,
+		16591, 16602, 16607, 16616, 16630, 64, 114, 147, 194, 235, 375, 398, 16642, 16650, 16654, 16662,
+		16672, 16678, 16691, 16701, 16709, 16716, 16728, 16735, 16743, 4, 2393, 16755, 16763, 2407, 2415, 2422,
+		2433, 2442, 16781, 2471, 2000, 11285, 16787, 16803, 16810, 3039, 3045, 16819, 11345, 3055, 3064, 16826,
+		16840, 16854, 3148, 3258, 16869, 16880, 16895, 16907, 16918, 16926, 16933, 16941, 16952, 16964, 16976, 16988,
+		3793, 11369, 17001, 11391, 17012, 2039, 17022, 3911, 11419, 17035, 17044, 531, 2045, 562, 17056, 0,
+		114, 147, 2066, 17059, 2173, 375, 1026, 398, 4036, 114, 147, 2066, 320, 375, 1026, 398,
+		114, 147, 398, 114, 147, 194, 1982, 320, 375, 6624, 398, 64, 114, 147, 17062, 2066,
+		17065, 17068, 17071, 17074, 194, 235, 375, 398, 2383, 17077, 17082, 17086, 2081, 17089, 17092, 17100,
+		17104, 17114, 17120, 17127, 17137, 17146, 17153, 64, 17163, 17174, 17183, 17188, 112, 114, 17195, 17202,
+		17207, 147, 17216, 17222, 17225, 17228, 17234, 17240, 17247, 17253, 17258, 17265, 194, 17272, 17279, 17282,
+		235, 17287, 17290, 17298, 17310, 17315, 17321, 17328, 17335, 17342, 17350, 17365, 17370, 17377, 17384, 17394,
+		17403, 17408, 17418, 17424, 17429, 17433, 17439, 17446, 17453, 17461, 17466, 17472, 17477, 17482, 17486, 17493,
+		17503, 17511, 17517, 2173, 1569, 17522, 17528, 17534, 320, 17540, 17547, 375, 6624, 17552, 1026, 17565,
+		17573, 17577, 17582, 17588, 17594, 17601, 17608, 398, 17613, 17620, 17629, 17638, 5583, 17648, 17652, 17656,
+		17663, 17671, 17680, 17689, 17696, 17702, 1912, 17708, 17718, 17726, 17735, 17741, 17746, 17754, 4335, 17764,
+		17770, 17777, 17782, 4435, 4338, 17787, 17794, 17799, 17806, 17811, 17817, 17822, 17825, 17834, 17846, 17858,
+		17866, 17873, 17881, 17891, 17902, 17910, 17918, 17924, 17929, 17939, 503, 17944, 511, 17951, 17956, 17965,
+		17970, 17976, 17981, 17990, 17996, 18004, 18007, 18013, 18019, 18024, 18033, 18042, 18049, 18053, 18060, 18069,
+		18074, 18082, 18087, 18092, 112, 147, 194, 375, 398, 0, 64, 114, 147, 18101, 194, 235,
+		18104, 367, 375, 398, 421, 18108, 18112, 18115, 18118, 2115, 18121, 18124, 2173, 114, 147, 194,
+		375, 398, 18127, 18130, 114, 147, 194, 239, 375, 18133, 398, 18137, 18141, 112, 1804, 2306,
+		373, 1910, 114, 147, 194, 320, 367, 375, 398, 1029, 2051, 114, 1026, 2375, 114, 2379,
+		235, 1026, 1934, 398, 2205, 1959, 503, 2314, 0, 112, 147, 194, 233, 398, 0, 18146,
+		18153, 18158, 18162, 18168, 18179, 18188, 18194, 18203, 18211, 18214, 18221, 18229, 18232, 18236, 18247, 18252,
+		18260, 18269, 114, 18272, 18280, 18287, 147, 18293, 194, 18300, 239, 18306, 18313, 18320, 18327, 18332,
+		18335, 18346, 18354, 18362, 18368, 18377, 18384, 18389, 18393, 18401, 18411, 18420, 18423, 18428, 18431, 18437,
+		18441, 18449, 18460, 18465, 18471, 18476, 18484, 18491, 18498, 18505, 18513, 18517, 18524, 320, 18530, 18538,
+		18544, 18547, 18555, 18560, 18568, 375, 18575, 18578, 18582, 18589, 18592, 18603, 18606, 18610, 398, 18618,
+		18623, 18628, 18634, 18639, 1949, 18643, 18648, 18651, 18661, 18664, 18673, 18679, 18687, 18693, 18700, 18708,
+		18716, 18719, 18722, 18731, 18734, 18740, 18746, 18752, 18761, 18765, 18768, 18773, 18782, 18785, 18789, 18793,
+		18797, 18803, 18806, 18814, 18822, 18828, 18839, 18847, 18858, 18867, 18874, 18882, 18885, 18891, 18898, 18903,
+		18912, 18925, 18942, 0, 112, 114, 147, 2062, 194, 239, 320, 375, 114, 147, 194, 2173,
+		375, 398, 18947, 1029, 114, 147, 194, 375, 398, 114, 147, 194, 375, 398, 114, 147,
+		194, 235, 2173, 375, 398, 519, 1974, 0, 1975, 52, 18950, 1976, 1977, 1978, 1979, 18955,
+		18957, 18961, 1980, 1981, 1982, 1984, 18964, 18971, 18986, 1985, 18992, 1986, 1987, 18998, 1988, 398,
+		1989, 19012, 1949, 1651, 1991, 1992, 19017, 1993, 503, 1994, 1996, 1997, 1998, 1999, 114, 147,
+		194, 375, 398, 6614, 114, 194, 320, 375, 398, 114, 147, 194, 375, 398, 2081, 114,
+		147, 2062, 398, 4330, 19021, 114, 375, 398, 112, 114, 19025, 147, 19034, 194, 320, 375,
+		398, 19043, 19051, 1959, 194, 114, 147, 194, 320, 375, 398, 0, 112, 398, 0, 112,
+		2306, 233, 5389, 375, 1910, 0, 64, 112, 114, 147, 2306, 194, 239, 320, 367, 375,
+		1865, 398, 18761, 2383, 194, 112, 114, 147, 194, 320, 375, 1026, 398, 19058, 114, 19065,
+		19072, 19078, 4042, 194, 2152, 235, 19081, 19085, 19091, 375, 398, 4330, 19094, 19098, 19101, 17944,
+		19104, 114, 147, 194, 320, 375, 398, 371, 1865, 6, 64, 112, 114, 117, 147, 194,
+		235, 239, 258, 333, 349, 367, 375, 398, 421, 511, 19109, 2000, 19120, 19130, 1284, 114,
+		19140, 147, 19144, 194, 4244, 320, 375, 398, 19148, 4283, 19154, 0, 112, 2306, 320, 373,
+		1910, 448, 19160, 19169, 19177, 19186, 19195, 19205, 104, 110, 112, 114, 121, 19215, 125, 19221,
+		19223, 19237, 19251, 19258, 19265, 147, 194, 19267, 233, 19269, 268, 19284, 19291, 19298, 19305, 19317,
+		19329, 19333, 272, 278, 19343, 19347, 19349, 19351, 6291, 300, 19355, 19362, 304, 19367, 323, 19371,
+		375, 19379, 19387, 19389, 19394, 398, 413, 19400, 1949, 19407, 19412, 19417, 446, 19419, 19429, 464,
+		19439, 5842, 19443, 535, 19451, 19459, 19466, 547, 19475, 19480, 19485, 19496, 19508, 19516, 19524, 19526,
+		0, 112, 114, 2306, 373, 1910, 398, 448, 4826, 19528, 19543, 4241, 19546, 19571, 19574, 1865,
+		19577, 19580, 19590, 1029, 19596, 16, 26, 32, 46, 87, 112, 5063, 19598, 133, 19600, 19603,
+		19606, 174, 207, 2331, 19608, 225, 229, 233, 19610, 19615, 8949, 19347, 282, 286, 19618, 308,
+		312, 314, 5389, 329, 331, 343, 345, 371, 19629, 373, 19631, 19633, 2343, 19635, 19638, 19640,
+		19642, 19644, 1910, 401, 419, 5749, 448, 450, 17044, 505, 19646, 19648, 537, 545, 6126, 1947,
+		19650, 19652, 19654, 114, 147, 19656, 320, 375, 398, 112, 114, 375, 398, 114, 147, 194,
+		320, 375, 398, 112, 114, 19659, 147, 194, 235, 320, 375, 398, 2383, 112, 114, 4045,
+		375, 398, 0, 64, 114, 147, 194, 8566, 235, 239, 367, 375, 398, 421, 114, 2000,
+		147, 194, 19662, 375, 398, 1916, 1931, 1934, 1938, 444, 1941, 1944, 1947, 1916, 1934, 1938,
+		444, 1941, 1944, 1947, 4238, 6630, 19668, 19673, 19676, 19680, 19685, 18247, 19691, 19695, 19703, 19708,
+		19712, 19719, 19729, 19739, 19746, 19753, 19760, 19767, 19772, 19778, 19785, 19789, 19795, 19801, 19806, 19810,
+		19816, 19823, 19831, 19837, 19844, 19847, 19853, 19858, 19865, 19875, 19879, 19887, 19896, 19903, 19909, 19917,
+		19926, 19930, 19934, 19939, 19947, 19954, 19963, 19971, 19977, 19983, 19991, 6135, 19997, 20003, 20011, 20017,
+		20028, 20034, 20047, 20052, 20058, 20063, 20072, 20079, 20087, 20093, 20102, 20108, 20113, 20119, 20125, 20134,
+		20140, 20147, 20152, 20155, 20160, 20167, 20173, 6140, 20182, 20188, 20197, 20206, 20213, 20221, 20230, 20238,
+		20244, 20249, 20259, 20265, 20271, 20276, 20284, 20291, 20301, 20307, 20312, 20318, 20325, 20332, 20337, 20342,
+		20348, 20354, 20360, 20364, 20373, 20379, 20389, 20397, 19729, 20405, 20410, 20415, 20423, 20431, 20438, 20444,
+		20452, 20459, 20467, 20473, 20481, 20487, 20494, 19810, 20500, 20510, 20516, 20525, 20531, 20541, 20550, 20556,
+		20560, 20573, 20580, 20586, 20591, 20596, 20602, 20611, 20618, 20624, 20630, 20639, 20643, 20647, 20655, 20661,
+		20669, 20677, 20684, 20693, 20700, 20710, 20725, 20735, 20740, 20745, 20750, 20757, 20760, 20768, 20774, 20783,
+		20789, 20796, 20805, 20813, 20820, 20823, 20828, 20833, 20844, 20848, 20852, 20858, 20865, 20875, 20882, 6156,
+		20889, 20894, 19810, 20903, 20916, 20921, 20924, 20927, 20932, 19909, 20937, 20944, 20950, 20956, 20961, 20968,
+		20975, 20982, 20992, 20360, 21000, 21007, 21014, 21020, 21027, 21035, 21043, 21049, 21057, 21061, 21067, 21073,
+		21079, 21085, 21089, 21095, 21101, 21107, 21113, 21119, 21125, 21133, 21141, 21149, 21157, 21163, 21168, 21174,
+		21179, 21186, 21191, 21194, 21199, 21203, 21208, 21211, 21218, 21226, 21232, 21245, 21251, 21256, 21259, 21268,
+		21274, 21280, 21284, 21291, 21297, 21302, 21305, 21309, 21315, 21319, 21327, 21336, 21345, 21355, 21368, 21375,
+		21381, 6168, 21385, 21393, 21399, 21020, 21405, 21411, 21417, 21423, 6215, 21433, 21438, 21447, 21457, 21465,
+		21473, 21481, 21493, 21498, 21506, 21512, 21518, 21525, 21530, 21535, 21544, 21551, 21556, 20924, 21563, 21568,
+		21576, 21583, 21592, 21597, 21601, 21609, 21616, 21624, 21632, 21637, 21643, 21649, 21658, 21665, 21671, 21678,
+		6177, 21681, 21686, 21690, 21694, 21701, 21708, 21712, 21728, 20889, 21735, 21747, 21751, 21759, 21768, 21774,
+		21782, 21786, 21794, 21800, 21808, 21814, 21825, 21830, 21838, 21842, 21583, 21852, 21858, 21866, 21871, 21875,
+		21881, 6450, 21889, 21895, 21899, 21905, 21912, 21919, 21934, 21941, 21948, 21953, 21958, 21967, 21973, 21978,
+		21985, 21993, 20494, 21998, 22006, 22016, 22024, 22031, 22037, 22043, 22046, 22049, 22058, 22067, 21592, 22073,
+		21858, 22081, 22089, 22100, 22107, 22116, 22124, 22128, 22136, 22145, 22150, 22157, 22162, 22170, 22181, 22197,
+		22202, 22214, 22219, 22222, 22228, 22232, 19816, 22238, 22242, 22250, 22263, 22268, 22272, 22276, 22285, 22293,
+		22300, 22308, 22316, 22321, 22328, 22335, 22342, 22351, 22360, 22366, 22372, 22378, 22383, 22387, 22393, 22399,
+		22407, 22418, 21381, 22425, 22431, 22438, 22443, 22448, 22452, 22458, 6168, 22466, 22472, 22480, 22486, 22494,
+		22506, 22512, 22525, 22536, 22541, 22548, 22554, 22562, 22571, 20889, 22579, 22586, 22594, 22603, 22609, 22619,
+		22627, 22638, 22650, 22658, 22664, 22672, 22679, 22688, 22701, 22707, 22715, 22724, 22732, 22740, 22752, 22759,
+		20108, 22766, 22773, 22781, 22787, 22799, 22807, 22815, 22821, 22828, 21149, 22832, 22844, 22856, 22861, 22868,
+		22874, 22880, 22888, 22892, 22903, 22031, 22914, 22921, 22926, 22931, 22937, 22942, 22947, 22954, 22963, 22967,
+		22972, 22977, 22985, 22990, 22999, 23006, 23017, 23023, 23032, 23040, 23045, 23053, 23061, 23068, 23075, 23084,
+		23091, 23100, 23112, 23120, 23129, 23136, 23145, 23152, 23160, 23165, 23171, 23179, 23187, 23196, 23203, 23208,
+		23217, 23223, 23227, 23233, 23241, 23248, 23256, 23265, 23272, 23278, 23282, 23291, 23299, 23306, 23312, 23318,
+		23322, 23328, 23331, 23340, 23346, 20950, 23351, 23356, 23364, 23371, 23377, 20397, 23383, 23390, 23395, 23403,
+		22619, 23411, 21061, 23416, 23425, 23429, 23440, 23451, 20924, 23460, 23465, 23471, 23479, 21226, 23483, 23492,
+		23497, 23503, 23509, 23513, 23523, 23531, 23537, 23542, 23549, 23556, 23560, 23567, 23571, 23577, 20337, 23580,
+		23585, 23593, 23598, 23607, 23614, 23625, 23637, 6208, 23647, 23650, 23658, 23663, 23668, 23672, 23678, 23684,
+		23691, 21085, 23702, 23706, 23710, 22238, 23716, 23724, 23729, 23734, 23741, 20932, 23750, 23760, 23769, 23779,
+		23788, 23792, 23797, 23805, 19934, 23816, 23822, 23826, 23832, 23841, 23848, 23856, 20693, 6450, 23862, 23868,
+		23872, 23879, 23884, 23891, 23895, 23901, 23909, 23917, 23924, 23932, 23937, 23941, 23949, 23953, 23958, 23962,
+		23969, 23976, 23984, 23990, 23995, 24003, 24011, 21411, 24020, 24028, 24038, 6223, 24046, 24052, 24060, 24070,
+		24077, 24082, 24090, 24094, 24100, 21113, 24108, 24116, 24123, 20556, 24129, 24136, 24140, 24147, 24160, 24165,
+		24176, 24182, 24190, 24198, 24202, 21309, 24208, 24215, 24228, 24235, 24243, 24248, 24256, 24262, 24270, 24276,
+		24283, 24292, 24299, 24307, 24312, 24320, 24325, 24330, 19615, 24335, 24339, 6234, 24344, 24350, 24358, 24363,
+		24370, 24380, 24389, 24399, 24407, 24419, 24432, 24435, 24443, 24448, 24454, 24460, 24465, 24474, 23995, 24479,
+		24485, 24491, 24500, 24507, 24513, 24521, 24529, 24536, 24550, 24555, 24560, 24568, 24575, 24577, 24581, 24591,
+		24599, 24605, 21643, 24613, 24621, 24629, 24633, 4361, 4361, 4361, 24638, 24641, 22506, 24647, 24659, 24662,
+		24668, 24672, 6273, 22232, 24680, 24688, 24694, 24700, 24708, 24715, 24724, 24734, 21186, 24738, 24743, 24749,
+		24755, 24761, 24767, 24771, 24782, 24786, 24791, 24796, 24802, 24810, 24817, 24821, 24824, 24829, 24836, 24845,
+		23684, 24856, 24863, 6278, 24867, 24874, 24880, 24888, 24899, 24905, 24914, 20820, 23497, 24919, 24927, 24930,
+		24933, 21643, 24939, 24949, 24954, 24965, 24976, 24979, 24982, 24986, 24993, 24997, 25001, 25005, 25013, 25021,
+		25030, 25038, 21107, 25045, 25060, 25066, 25070, 25080, 25087, 25093, 25102, 25107, 25112, 25118, 25121, 25130,
+		25136, 25145, 20337, 25151, 25156, 25159, 25167, 25174, 25178, 25186, 25190, 22222, 25196, 25202, 21993, 19810,
+		25211, 25220, 21119, 25226, 25232, 25237, 25243, 23160, 25247, 25251, 25257, 25261, 25264, 25269, 25277, 25284,
+		25293, 25301, 25318, 25328, 25335, 24668, 6251, 25341, 25350, 25358, 25368, 20113, 25381, 25387, 25393, 25400,
+		25405, 25412, 25417, 25421, 25427, 25434, 25447, 25454, 25462, 25471, 25477, 25482, 25486, 25492, 25498, 25504,
+		25512, 25515, 25518, 25523, 25529, 25534, 25542, 25552, 24672, 21473, 25556, 25563, 25572, 25580, 25587, 25593,
+		6302, 25603, 25612, 25620, 25629, 25636, 25641, 25647, 25655, 25663, 25671, 24786, 25679, 25683, 25691, 25695,
+		20337, 25699, 25706, 25715, 25722, 25727, 25733, 25739, 25743, 25749, 25753, 25759, 25765, 20889, 25771, 23647,
+		25778, 25787, 25795, 25799, 25812, 25820, 25828, 25834, 24380, 25843, 25848, 25858, 25868, 25880, 25886, 25892,
+		25898, 6310, 25907, 25913, 21149, 25919, 25925, 25936, 23729, 25941, 25946, 25952, 25955, 25961, 20586, 25968,
+		20591, 25973, 25979, 25983, 25989, 25998, 26010, 26018, 26022, 26028, 24919, 21858, 26034, 23542, 26043, 26052,
+		26058, 26062, 26066, 6450, 26070, 26080, 26086, 26093, 26100, 26105, 26109, 26115, 4075, 26121, 26128, 26136,
+		26146, 6316, 20916, 26154, 26159, 26164, 26170, 26176, 26181, 26190, 26202, 19983, 26209, 4361, 26214, 26218,
+		26222, 26228, 26242, 26249, 26254, 26266, 26273, 26280, 26289, 24077, 25787, 23416, 26298, 26303, 26311, 26317,
+		6330, 26323, 26331, 26334, 26338, 26343, 26350, 26355, 26364, 26377, 26383, 26387, 26395, 26405, 26412, 26417,
+		26420, 26434, 26446, 26453, 26460, 26463, 26468, 26473, 26481, 26490, 24993, 26496, 26502, 26513, 26525, 26532,
+		26537, 26545, 26550, 6334, 26557, 25952, 26562, 26566, 26571, 26576, 26582, 25427, 26590, 26596, 26602, 26608,
+		26617, 26624, 26630, 26636, 26642, 26647, 26653, 26658, 26666, 26677, 26683, 26687, 26696, 26700, 26708, 26715,
+		26719, 26727, 6341, 26731, 26736, 26742, 10867, 26749, 26754, 26758, 26764, 26772, 26777, 26786, 26791, 26799,
+		26806, 26814, 26821, 26830, 26836, 26842, 26846, 26851, 6345, 26863, 26870, 26377, 26878, 26882, 26886, 26895,
+		26901, 26908, 26912, 26918, 26923, 26930, 26936, 26945, 21020, 26952, 26958, 26963, 6215, 26971, 26977, 26982,
+		26988, 26991, 27000, 27014, 27022, 27030, 27041, 27047, 27051, 27055, 27065, 27072, 24905, 27077, 6352, 27082,
+		27086, 27093, 27101, 27107, 27116, 27126, 27132, 27138, 27143, 27148, 27155, 27163, 27171, 27177, 27182, 20360,
+		27196, 27201, 27210, 27218, 27224, 27236, 27252, 27267, 6208, 20889, 24339, 27275, 27284, 27293, 27299, 27306,
+		27311, 27320, 27326, 27339, 24997, 27348, 27356, 27365, 27371, 26836, 27376, 27385, 21163, 27393, 27397, 20932,
+		27408, 27414, 27420, 27426, 27437, 27446, 27451, 23503, 27458, 27464, 27472, 27481, 27493, 27501, 27507, 27510,
+		27516, 27521, 27529, 27535, 27543, 27548, 27553, 27561, 27568, 23684, 21774, 27575, 27583, 27589, 27596, 27603,
+		27613, 25946, 27616, 6364, 25462, 27621, 27625, 27629, 27633, 27644, 27651, 27656, 25727, 27664, 27672, 27678,
+		27683, 27688, 27694, 27702, 22506, 27710, 27725, 20047, 23647, 27741, 27746, 27754, 22619, 27763, 27771, 25167,
+		27779, 27788, 27796, 27800, 27808, 27817, 27824, 27828, 27836, 27846, 20113, 27852, 19816, 27861, 27869, 27877,
+		27885, 27890, 23729, 27895, 27900, 27907, 20586, 27912, 27918, 6368, 27925, 27931, 27408, 27936, 27941, 27949,
+		27953, 27959, 27963, 27971, 27981, 27993, 27998, 28004, 28010, 28016, 28020, 28025, 28032, 28041, 4361, 4361,
+		28048, 28053, 28057, 28067, 28073, 19801, 28077, 28082, 21978, 28086, 28093, 28101, 28109, 28118, 28126, 28137,
+		28141, 28146, 28151, 27472, 28160, 28168, 28176, 28180, 19673, 28185, 28190, 28196, 28208, 28214, 28220, 28225,
+		28233, 28239, 20113, 28245, 28257, 28261, 28271, 6393, 28279, 28285, 28292, 23306, 28297, 28303, 28310, 28314,
+		28319, 28323, 28330, 28338, 28348, 28355, 28362, 28369, 28378, 28388, 28391, 28396, 28399, 28408, 28416, 28423,
+		28432, 28438, 28446, 28451, 28461, 28470, 21518, 28479, 28488, 28496, 28502, 28510, 23084, 28517, 6400, 28524,
+		28530, 28025, 28535, 28543, 28547, 28551, 28558, 28565, 28575, 28581, 28591, 28598, 28605, 28609, 28613, 28619,
+		28623, 28635, 28640, 28649, 25400, 28653, 28661, 20596, 28666, 28670, 28680, 28686, 6408, 28696, 28701, 28706,
+		28716, 28721, 25691, 28727, 28733, 28738, 28744, 28756, 28766, 21107, 19816, 28770, 21149, 28774, 28780, 28789,
+		6415, 28798, 28804, 28810, 28817, 28825, 27644, 28834, 21905, 28840, 20360, 28845, 22157, 28852, 28857, 28864,
+		28872, 28878, 28891, 28906, 28919, 28923, 28929, 28937, 28942, 28950, 24997, 28960, 28966, 28973, 28980, 28989,
+		28082, 28994, 29004, 29013, 29020, 27365, 29026, 29032, 29038, 29053, 25919, 29062, 29068, 29077, 29084, 29087,
+		22046, 29093, 29101, 29108, 29117, 29125, 29133, 29139, 29148, 29153, 29157, 29164, 28919, 29169, 29177, 29181,
+		29188, 29194, 25612, 29199, 6429, 20944, 29210, 29214, 20337, 22157, 29220, 29229, 29238, 29242, 29247, 29252,
+		29258, 29266, 29272, 29283, 29293, 29298, 29304, 29309, 29314, 20647, 29321, 29325, 6436, 29331, 29338, 21309,
+		29342, 29347, 29356, 29360, 22506, 29369, 29247, 29377, 28738, 29382, 26289, 29391, 29397, 29405, 29413, 29417,
+		29421, 29429, 29437, 19810, 20113, 29446, 21226, 29459, 29468, 25112, 6442, 29473, 29478, 20337, 29482, 29490,
+		29499, 23895, 29503, 21457, 23416, 29513, 29523, 29529, 29537, 29542, 29550, 29555, 29564, 29573, 24914, 29575,
+		29581, 29588, 29593, 29599, 26377, 29607, 21245, 29616, 29624, 29629, 29635, 6450, 29643, 29651, 29659, 29663,
+		29666, 29670, 29676, 29680, 29687, 29696, 29701, 29087, 29707, 29718, 29724, 29730, 19977, 29738, 29741, 20360,
+		29744, 29749, 28330, 29756, 29771, 29782, 22548, 29790, 29804, 29807, 29811, 29816, 29822, 29833, 29839, 29194,
+		29848, 29856, 29864, 29876, 24743, 21592, 29882, 29890, 29895, 29903, 6467, 4361, 29913, 29913, 203, 203,
+		29919, 203, 29919, 203, 29922, 29924, 29929, 203, 203, 29922, 203, 29935, 29940, 203, 29945, 29940,
+		203, 73, 29951, 29935, 29945, 203, 203, 203, 203, 29924, 203, 203, 203, 203, 73, 29951,
+		203, 203, 203, 29940, 203, 29929, 2306, 29954, 401, 5647, 468, 470, 29958, 527, 29967, 29969,
+		29973, 194, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045,
+		4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054,
+		92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4054, 92, 4045, 4054, 92, 4045,
+		4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054,
+		92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92,
+		4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045,
+		4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054,
+		92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92,
+		4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045,
+		4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054, 92, 4045, 4054,
+		92, 4045, 4054, 92, 4045, 4054, 29975, 29979, 4235,
+	},
+	length: []uint8{
+		0, 2, 2, 2, 4, 2, 2, 2, 2, 2, 2, 2, 2, 2, 4, 2,
+		4, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 3, 4, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 4, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 2, 2, 2, 2, 4, 2,
+		2, 2, 2, 2, 2, 6, 2, 2, 2, 2, 2, 2, 4, 2, 2, 3,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 6, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 3, 2, 2, 2, 2, 2, 4, 6, 6, 12, 14,
+		16, 16, 16, 16, 12, 10, 12, 10, 10, 10, 8, 10, 10, 8, 12, 6,
+		6, 12, 15, 12, 12, 12, 21, 18, 33, 15, 12, 9, 6, 6, 6, 6,
+		6, 9, 6, 6, 6, 3, 3, 3, 3, 3, 3, 3, 2, 2, 3, 3,
+		3, 3, 3, 22, 19, 9, 8, 9, 6, 16, 19, 8, 7, 7, 10, 9,
+		9, 11, 6, 10, 6, 3, 5, 8, 13, 12, 7, 13, 4, 10, 10, 10,
+		7, 7, 4, 6, 4, 8, 9, 6, 8, 13, 9, 8, 7, 10, 6, 7,
+		4, 7, 10, 14, 5, 11, 9, 9, 7, 10, 7, 9, 8, 11, 11, 5,
+		10, 9, 10, 11, 11, 21, 5, 5, 10, 10, 7, 3, 8, 10, 6, 9,
+		8, 4, 9, 8, 7, 4, 6, 7, 7, 5, 12, 5, 3, 3, 3, 3,
+		3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 2, 2, 2, 2, 2, 2, 19, 4, 19, 5, 6, 3,
+		9, 6, 3, 4, 7, 3, 4, 3, 3, 3, 2, 3, 2, 2, 4, 2,
+		4, 3, 3, 3, 4, 5, 3, 3, 2, 4, 3, 3, 2, 3, 2, 3,
+		2, 3, 3, 2, 3, 3, 3, 3, 3, 4, 3, 3, 4, 3, 3, 2,
+		3, 2, 3, 3, 3, 3, 3, 3, 2, 4, 4, 3, 3, 3, 3, 4,
+		3, 3, 5, 2, 3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 3, 3, 3, 3, 2, 3,
+		3, 2, 3, 6, 10, 8, 8, 7, 6, 6, 4, 7, 4, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 2, 3, 3, 3, 2, 3,
+		3, 3, 1, 3, 4, 3, 3, 3, 3, 3, 4, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 4, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 5, 3, 3, 3, 4, 3, 3, 3, 3, 2, 3, 4, 4, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 2, 3, 3, 3, 3, 2,
+		3, 3, 3, 3, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 9, 11, 8, 9, 3, 2, 4, 9, 2, 3, 2,
+		3, 2, 4, 3, 2, 3, 2, 3, 6, 3, 2, 3, 3, 3, 3, 2,
+		2, 2, 3, 2, 3, 2, 2, 3, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 3, 2, 3, 2, 2, 3, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 6, 6, 6, 4, 3, 3, 4, 3,
+		4, 3, 3, 3, 3, 3, 3, 3, 7, 2, 7, 2, 7, 2, 2, 8,
+		7, 11, 9, 7, 12, 10, 8, 14, 14, 11, 11, 11, 9, 11, 13, 11,
+		13, 13, 10, 11, 11, 15, 15, 13, 16, 2, 7, 7, 7, 7, 7, 7,
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 2, 7, 6, 2, 10, 9, 8,
+		2, 9, 14, 12, 15, 14, 12, 9, 17, 8, 18, 13, 13, 11, 21, 9,
+		10, 9, 16, 11, 15, 11, 12, 16, 10, 13, 14, 10, 12, 20, 17, 11,
+		15, 13, 14, 12, 12, 11, 14, 16, 11, 13, 15, 12, 14, 17, 12, 7,
+		13, 12, 16, 13, 7, 16, 8, 11, 15, 8, 13, 16, 3, 2, 9, 10,
+		8, 2, 10, 2, 2, 2, 16, 2, 6, 14, 11, 8, 10, 13, 12, 2,
+		2, 2, 14, 2, 2, 2, 2, 2, 2, 2, 2, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 15, 10, 7, 10, 10, 13, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 4, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 4, 3, 3, 3, 3, 3, 4, 3, 3, 3,
+		3, 3, 3, 3, 3, 5, 3, 8, 7, 4, 6, 6, 3, 9, 21, 3,
+		18, 15, 4, 5, 16, 7, 3, 8, 10, 4, 3, 6, 2, 11, 3, 3,
+		3, 3, 3, 3, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 2, 3, 3, 3, 3, 3, 4, 3, 3, 4, 3,
+		3, 3, 3, 3, 3, 3, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3,
+		6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 3,
+		3, 3, 3, 3, 3, 3, 4, 2, 4, 5, 3, 4, 3, 4, 3, 4,
+		4, 4, 3, 3, 3, 5, 3, 3, 3, 4, 4, 5, 4, 6, 4, 2,
+		7, 7, 4, 5, 5, 5, 4, 8, 6, 8, 5, 5, 4, 3, 4, 6,
+		3, 4, 5, 4, 4, 2, 6, 6, 5, 2, 2, 2, 3, 3, 2, 3,
+		3, 3, 2, 2, 3, 3, 3, 3, 2, 2, 3, 4, 3, 3, 3, 3,
+		3, 3, 3, 3, 19, 20, 6, 7, 9, 10, 13, 10, 6, 6, 2, 3,
+		3, 3, 3, 3, 3, 3, 2, 2, 3, 2, 3, 3, 3, 10, 10, 3,
+		3, 3, 3, 3, 3, 2, 9, 2, 11, 10, 9, 2, 6, 21, 21, 19,
+		19, 2, 5, 5, 2, 2, 6, 2, 6, 13, 12, 4, 2, 2, 8, 2,
+		6, 4, 21, 19, 7, 9, 7, 2, 2, 6, 2, 2, 2, 7, 7, 5,
+		2, 7, 8, 2, 2, 2, 2, 8, 13, 15, 14, 10, 17, 16, 13, 12,
+		7, 7, 9, 2, 2, 12, 11, 2, 6, 2, 2, 2, 2, 4, 7, 2,
+		7, 7, 2, 2, 5, 2, 14, 13, 3, 2, 4, 2, 2, 5, 7, 2,
+		2, 7, 8, 2, 6, 12, 11, 2, 9, 2, 5, 6, 2, 7, 3, 2,
+		8, 17, 16, 2, 7, 2, 7, 2, 9, 7, 8, 6, 2, 2, 5, 5,
+		2, 7, 2, 4, 2, 2, 5, 8, 7, 13, 12, 6, 2, 2, 2, 15,
+		14, 7, 2, 5, 6, 2, 2, 6, 5, 13, 21, 12, 13, 18, 2, 2,
+		2, 6, 6, 2, 6, 2, 5, 2, 9, 12, 11, 2, 8, 2, 2, 6,
+		5, 7, 5, 5, 2, 2, 2, 7, 13, 12, 7, 2, 2, 8, 4, 7,
+		2, 2, 9, 7, 2, 5, 2, 2, 2, 2, 2, 6, 7, 2, 2, 15,
+		13, 14, 12, 2, 2, 5, 6, 2, 2, 2, 4, 4, 6, 2, 7, 7,
+		6, 2, 5, 8, 2, 7, 2, 2, 2, 9, 2, 2, 7, 2, 12, 11,
+		6, 5, 2, 2, 6, 2, 2, 21, 21, 19, 19, 7, 8, 6, 7, 7,
+		2, 5, 2, 2, 5, 13, 12, 2, 6, 2, 2, 2, 7, 6, 8, 8,
+		6, 2, 13, 12, 7, 7, 2, 2, 2, 2, 2, 3, 3, 3, 3, 3,
+		3, 3, 3, 4, 3, 3, 3, 2, 2, 5, 5, 6, 5, 2, 2, 5,
+		5, 7, 9, 4, 2, 2, 5, 9, 8, 5, 7, 8, 5, 6, 9, 8,
+		8, 10, 4, 5, 8, 5, 2, 3, 6, 8, 6, 8, 6, 4, 2, 7,
+		4, 7, 7, 2, 5, 4, 7, 7, 6, 5, 7, 8, 7, 9, 5, 7,
+		6, 8, 8, 9, 9, 8, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		4, 3, 3, 3, 4, 3, 4, 3, 4, 3, 7, 3, 3, 8, 3, 11,
+		3, 6, 2, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 2, 5,
+		8, 8, 2, 5, 7, 2, 7, 2, 7, 9, 8, 9, 2, 7, 4, 7,
+		7, 2, 3, 2, 2, 2, 2, 2, 2, 5, 5, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 1, 3, 3, 3, 4, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 2, 3, 3, 3, 3, 3, 4, 3, 3, 3, 3, 5,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 2, 3, 3,
+		3, 3, 4, 3, 3, 2, 3, 3, 3, 3, 3, 3, 2, 3, 3, 3,
+		3, 3, 2, 2, 3, 3, 3, 5, 4, 2, 2, 2, 3, 3, 3, 3,
+		3, 4, 3, 3, 3, 3, 3, 3, 3, 2, 3, 3, 3, 3, 4, 3,
+		3, 3, 3, 4, 3, 3, 3, 6, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 2, 2, 3, 3, 3, 2, 3, 7, 11, 3, 8, 7, 6, 5, 9,
+		8, 9, 16, 11, 9, 3, 9, 6, 12, 8, 8, 9, 14, 11, 12, 3,
+		12, 9, 7, 12, 10, 4, 13, 8, 13, 6, 11, 9, 7, 6, 9, 10,
+		8, 4, 7, 7, 4, 4, 9, 9, 8, 5, 5, 6, 9, 13, 8, 7,
+		8, 6, 4, 5, 6, 4, 7, 10, 4, 6, 9, 15, 13, 6, 16, 6,
+		7, 7, 15, 9, 6, 7, 8, 9, 8, 7, 3, 6, 8, 10, 9, 3,
+		6, 10, 7, 10, 14, 6, 7, 6, 6, 11, 10, 13, 7, 8, 9, 15,
+		12, 9, 14, 10, 6, 6, 12, 12, 8, 7, 5, 4, 14, 4, 7, 10,
+		20, 15, 8, 8, 13, 14, 9, 8, 15, 14, 12, 15, 7, 10, 11, 29,
+		8, 7, 13, 6, 6, 9, 8, 8, 14, 7, 5, 5, 4, 6, 8, 3,
+		14, 8, 11, 7, 5, 6, 7, 8, 9, 5, 8, 6, 10, 9, 9, 11,
+		8, 9, 6, 10, 10, 12, 7, 10, 11, 25, 8, 5, 6, 9, 6, 10,
+		6, 4, 13, 7, 9, 5, 8, 9, 4, 7, 8, 7, 8, 7, 5, 12,
+		9, 10, 9, 9, 12, 11, 8, 8, 4, 8, 9, 7, 6, 7, 9, 11,
+		7, 7, 7, 4, 5, 5, 11, 4, 8, 10, 7, 7, 18, 6, 6, 14,
+		6, 8, 15, 8, 8, 10, 17, 14, 10, 12, 7, 16, 8, 5, 10, 12,
+		13, 6, 7, 12, 12, 12, 11, 4, 4, 9, 7, 9, 9, 7, 6, 9,
+		3, 10, 7, 11, 10, 4, 6, 9, 4, 10, 5, 5, 13, 14, 5, 6,
+		7, 10, 6, 4, 7, 11, 7, 4, 6, 13, 12, 6, 10, 6, 8, 7,
+		10, 6, 5, 3, 6, 8, 10, 7, 8, 4, 7, 8, 8, 8, 10, 5,
+		7, 19, 6, 8, 9, 8, 11, 8, 4, 6, 6, 9, 7, 8, 6, 4,
+		5, 8, 10, 8, 6, 10, 8, 8, 8, 6, 6, 12, 16, 5, 8, 16,
+		16, 14, 14, 20, 15, 6, 17, 19, 8, 5, 8, 5, 12, 9, 9, 7,
+		9, 7, 6, 7, 5, 3, 9, 9, 3, 4, 13, 15, 5, 6, 7, 7,
+		6, 11, 5, 6, 7, 9, 6, 5, 11, 6, 5, 8, 8, 12, 16, 9,
+		7, 11, 6, 10, 11, 10, 6, 5, 6, 8, 10, 28, 12, 8, 5, 7,
+		6, 5, 6, 8, 7, 8, 10, 12, 9, 7, 4, 6, 10, 5, 12, 8,
+		8, 12, 12, 9, 7, 12, 4, 10, 12, 11, 11, 6, 7, 7, 15, 17,
+		18, 13, 14, 14, 8, 18, 8, 7, 10, 8, 5, 10, 7, 4, 3, 5,
+		7, 7, 14, 13, 9, 5, 3, 6, 5, 8, 9, 5, 15, 7, 5, 10,
+		6, 9, 12, 9, 6, 12, 6, 8, 6, 6, 4, 3, 10, 17, 10, 5,
+		7, 7, 4, 11, 8, 6, 5, 4, 9, 4, 7, 5, 7, 5, 3, 8,
+		10, 3, 10, 6, 15, 9, 16, 8, 9, 7, 15, 4, 4, 6, 6, 10,
+		6, 7, 8, 7, 7, 10, 11, 5, 8, 3, 12, 15, 13, 7, 9, 7,
+		8, 12, 8, 8, 4, 9, 8, 5, 10, 7, 8, 14, 4, 3, 3, 4,
+		3, 3, 4, 3, 3, 6, 4, 3, 3, 3, 2, 3, 2, 3, 4, 3,
+		3, 3, 6, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3,
+		3, 2, 2, 2, 3, 2, 2, 4, 4, 2, 4, 2, 3, 3, 6, 2,
+		2, 2, 3, 3, 4, 12, 7, 8, 10, 8, 7, 7, 9, 8, 9, 13,
+		7, 7, 7, 7, 2, 7, 12, 7, 6, 9, 8, 2, 11, 9, 9, 8,
+		2, 9, 13, 7, 13, 2, 6, 8, 8, 8, 8, 2, 6, 2, 4, 3,
+		4, 4, 3, 5, 3, 3, 5, 3, 2, 3, 3, 3, 3, 3, 2, 2,
+		2, 8, 6, 6, 7, 2, 8, 10, 8, 2, 9, 7, 6, 9, 4, 6,
+		4, 4, 9, 6, 5, 6, 5, 7, 7, 4, 6, 5, 5, 5, 7, 6,
+		5, 9, 5, 4, 7, 14, 15, 9, 9, 6, 7, 8, 11, 12, 6, 7,
+		5, 10, 9, 9, 6, 5, 5, 9, 11, 12, 6, 6, 4, 6, 8, 9,
+		6, 7, 6, 8, 7, 8, 9, 5, 4, 5, 4, 5, 9, 7, 11, 10,
+		5, 9, 13, 2, 7, 8, 7, 5, 12, 13, 8, 8, 7, 11, 10, 6,
+		6, 10, 2, 11, 12, 10, 6, 3, 13, 12, 13, 5, 5, 7, 9, 6,
+		7, 5, 6, 6, 8, 3, 8, 8, 7, 8, 9, 7, 7, 8, 4, 7,
+		8, 6, 11, 15, 7, 6, 5, 3, 7, 3, 6, 7, 6, 6, 5, 3,
+		8, 9, 11, 8, 5, 5, 6, 4, 2, 9, 7, 5, 7, 6, 5, 11,
+		4, 5, 7, 6, 5, 6, 6, 7, 7, 4, 10, 8, 6, 9, 5, 6,
+		10, 6, 7, 13, 9, 10, 5, 7, 8, 8, 7, 6, 7, 7, 3, 4,
+		5, 7, 9, 8, 5, 4, 5, 13, 10, 7, 12, 2, 6, 6, 10, 6,
+		5, 5, 7, 12, 10, 6, 5, 6, 7, 6, 12, 9, 7, 5, 6, 8,
+		5, 5, 8, 10, 2, 2, 5, 6, 3, 8, 3, 4, 11, 8, 9, 8,
+		9, 9, 6, 8, 9, 6, 5, 6, 9, 7, 14, 7, 3, 11, 9, 9,
+		10, 7, 6, 7, 8, 7, 4, 9, 8, 8, 7, 6, 9, 8, 10, 7,
+		10, 8, 7, 6, 7, 10, 8, 5, 5, 6, 7, 9, 11, 8, 9, 7,
+		8, 12, 12, 10, 12, 10, 11, 8, 8, 4, 9, 9, 10, 9, 7, 8,
+		9, 10, 13, 8, 12, 6, 8, 9, 6, 6, 7, 8, 11, 12, 7, 9,
+		9, 7, 4, 7, 6, 6, 5, 8, 4, 6, 11, 9, 6, 9, 7, 6,
+		7, 8, 3, 5, 9, 5, 4, 6, 5, 6, 6, 7, 6, 7, 7, 9,
+		10, 6, 11, 7, 6, 6, 6, 9, 10, 5, 13, 6, 6, 6, 5, 6,
+		7, 8, 7, 14, 3, 9, 10, 9, 7, 7, 5, 5, 15, 7, 8, 8,
+		4, 6, 8, 2, 6, 7, 6, 12, 15, 8, 7, 16, 14, 10, 6, 10,
+		9, 5, 8, 6, 8, 8, 11, 5, 8, 12, 7, 6, 8, 8, 2, 11,
+		9, 9, 7, 8, 8, 11, 9, 13, 8, 8, 2, 9, 16, 7, 10, 4,
+		2, 6, 2, 10, 6, 8, 9, 8, 6, 6, 6, 5, 4, 4, 6, 7,
+		8, 7, 11, 7, 9, 10, 4, 8, 13, 9, 8, 9, 10, 4, 4, 5,
+		6, 11, 6, 5, 9, 8, 4, 9, 5, 8, 7, 8, 9, 6, 7, 9,
+		9, 5, 5, 6, 2, 4, 5, 6, 5, 7, 5, 4, 6, 7, 6, 5,
+		13, 6, 5, 7, 9, 7, 8, 6, 5, 7, 8, 8, 5, 7, 9, 10,
+		7, 12, 13, 6, 7, 9, 5, 8, 3, 5, 5, 7, 2, 7, 6, 6,
+		6, 6, 8, 5, 7, 13, 3, 5, 7, 8, 8, 5, 8, 9, 6, 6,
+		7, 9, 7, 5, 6, 6, 5, 10, 6, 7, 6, 7, 5, 7, 4, 6,
+		5, 11, 9, 10, 8, 8, 12, 7, 8, 8, 5, 10, 9, 2, 6, 4,
+		9, 9, 7, 7, 9, 8, 15, 9, 16, 6, 11, 5, 7, 9, 6, 7,
+		5, 4, 6, 4, 7, 8, 8, 5, 7, 9, 6, 7, 6, 6, 12, 11,
+		9, 9, 13, 8, 9, 6, 4, 8, 8, 4, 8, 4, 9, 5, 6, 2,
+		5, 5, 8, 7, 2, 5, 6, 6, 7, 8, 8, 6, 6, 7, 9, 6,
+		6, 9, 11, 5, 6, 8, 6, 6, 7, 9, 10, 10, 5, 7, 8, 6,
+		2, 7, 5, 6, 4, 5, 6, 7, 5, 4, 8, 5, 6, 7, 5, 5,
+		4, 9, 10, 8, 6, 6, 6, 8, 7, 13, 12, 9, 11, 9, 2, 3,
+		3, 5, 10, 6, 5, 4, 11, 9, 6, 8, 6, 7, 12, 10, 6, 7,
+		9, 3, 8, 8, 5, 5, 6, 3, 7, 6, 7, 7, 7, 6, 8, 12,
+		11, 5, 9, 14, 12, 3, 3, 3, 3, 4, 3, 3, 8, 4, 8, 10,
+		6, 13, 10, 8, 7, 12, 7, 8, 12, 2, 7, 8, 18, 8, 7, 11,
+		9, 7, 6, 8, 6, 13, 16, 7, 9, 6, 10, 7, 7, 9, 8, 14,
+		14, 15, 9, 9, 11, 15, 12, 11, 8, 7, 8, 11, 12, 12, 12, 13,
+		8, 9, 11, 7, 10, 6, 13, 8, 8, 9, 12, 2, 6, 2, 3, 2,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 4, 3, 3, 3, 5, 4, 3, 3, 3, 8, 4,
+		10, 6, 7, 10, 9, 7, 10, 3, 11, 9, 5, 7, 2, 3, 7, 5,
+		9, 3, 6, 3, 3, 6, 6, 7, 6, 5, 7, 7, 3, 7, 3, 5,
+		4, 3, 8, 12, 5, 6, 7, 7, 7, 8, 15, 5, 7, 7, 10, 9,
+		5, 10, 6, 5, 4, 6, 7, 7, 8, 5, 6, 5, 5, 4, 7, 10,
+		8, 6, 5, 3, 5, 6, 6, 6, 3, 7, 5, 3, 3, 13, 3, 8,
+		4, 5, 6, 6, 7, 7, 5, 3, 7, 9, 9, 10, 2, 4, 4, 7,
+		8, 9, 9, 7, 6, 6, 4, 10, 8, 9, 6, 5, 8, 10, 3, 6,
+		7, 5, 5, 3, 4, 7, 5, 7, 5, 6, 5, 3, 9, 12, 12, 8,
+		7, 8, 10, 11, 8, 8, 6, 5, 10, 5, 2, 7, 6, 5, 9, 5,
+		6, 5, 9, 6, 8, 3, 6, 6, 5, 9, 9, 7, 4, 7, 9, 5,
+		8, 5, 5, 9, 2, 3, 3, 3, 3, 2, 3, 3, 3, 3, 3, 4,
+		4, 4, 3, 3, 3, 4, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4, 5, 2, 2, 2,
+		2, 2, 3, 3, 3, 3, 4, 3, 3, 3, 4, 3, 3, 4, 3, 4,
+		4, 3, 2, 3, 3, 5, 2, 3, 2, 2, 3, 3, 2, 3, 2, 7,
+		5, 4, 6, 11, 9, 6, 9, 8, 3, 7, 8, 3, 4, 11, 5, 8,
+		9, 3, 3, 8, 7, 6, 3, 7, 3, 6, 3, 7, 7, 7, 5, 3,
+		11, 8, 8, 6, 9, 7, 5, 4, 8, 10, 9, 3, 5, 3, 6, 4,
+		8, 11, 5, 6, 5, 8, 7, 7, 7, 8, 4, 7, 6, 3, 8, 6,
+		3, 8, 5, 8, 7, 3, 3, 4, 7, 3, 11, 3, 4, 8, 3, 5,
+		5, 6, 5, 4, 2, 5, 3, 10, 3, 9, 6, 8, 6, 7, 8, 8,
+		3, 3, 9, 3, 6, 6, 6, 9, 4, 3, 5, 9, 3, 4, 4, 4,
+		6, 3, 8, 8, 6, 11, 8, 11, 9, 7, 8, 3, 6, 7, 5, 9,
+		13, 17, 5, 2, 2, 3, 3, 4, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 4, 3, 3, 3, 2, 1, 2, 1, 2, 5, 1, 1, 1, 1, 2,
+		4, 3, 1, 1, 1, 1, 7, 15, 6, 1, 6, 1, 1, 14, 1, 3,
+		1, 5, 2, 5, 1, 1, 4, 1, 2, 1, 1, 1, 1, 1, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		3, 4, 3, 5, 4, 3, 3, 3, 2, 3, 9, 3, 9, 3, 3, 3,
+		3, 8, 7, 5, 3, 3, 3, 3, 3, 3, 3, 2, 2, 3, 2, 2,
+		2, 2, 2, 3, 2, 2, 3, 2, 3, 3, 2, 3, 3, 3, 4, 3,
+		3, 3, 4, 3, 3, 2, 3, 3, 3, 3, 3, 3, 3, 7, 3, 7,
+		6, 3, 3, 3, 3, 4, 4, 6, 3, 3, 3, 5, 4, 3, 3, 7,
+		5, 3, 3, 3, 3, 3, 3, 2, 3, 4, 3, 2, 3, 4, 3, 3,
+		4, 3, 4, 4, 6, 4, 3, 3, 3, 6, 11, 6, 10, 10, 4, 3,
+		4, 3, 4, 3, 3, 3, 3, 3, 6, 6, 6, 2, 2, 2, 3, 2,
+		2, 2, 9, 8, 9, 9, 10, 10, 2, 2, 2, 3, 2, 6, 2, 2,
+		14, 14, 7, 7, 2, 3, 3, 2, 2, 15, 2, 7, 7, 7, 12, 12,
+		4, 10, 2, 2, 4, 2, 2, 4, 2, 2, 7, 5, 2, 4, 2, 8,
+		3, 8, 2, 5, 6, 3, 2, 7, 2, 5, 5, 2, 2, 10, 10, 2,
+		4, 2, 8, 2, 8, 7, 9, 2, 5, 5, 11, 12, 8, 8, 2, 2,
+		2, 2, 3, 2, 2, 2, 3, 2, 2, 15, 3, 3, 25, 3, 3, 3,
+		3, 10, 6, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 3,
+		2, 2, 2, 2, 2, 2, 2, 2, 5, 3, 4, 2, 2, 2, 11, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 12, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 3, 3, 3, 3, 3, 3, 2, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 2, 3, 3, 3, 3, 4, 3, 3, 3, 3, 2, 3, 3,
+		3, 3, 2, 3, 3, 3, 3, 6, 4, 3, 4, 3, 3, 3, 3, 6,
+		3, 3, 6, 3, 3, 3, 3, 2, 3, 2, 3, 3, 2, 3, 2, 3,
+		2, 3, 3, 2, 3, 3, 5, 3, 4, 5, 6, 5, 4, 8, 5, 4,
+		7, 10, 10, 7, 7, 7, 7, 5, 6, 7, 4, 6, 6, 5, 4, 6,
+		7, 8, 6, 7, 3, 6, 5, 7, 10, 4, 8, 9, 7, 6, 8, 9,
+		4, 4, 5, 8, 7, 9, 8, 6, 6, 8, 6, 5, 6, 8, 6, 11,
+		6, 13, 5, 6, 5, 9, 7, 8, 6, 9, 6, 5, 6, 6, 9, 6,
+		7, 5, 3, 5, 7, 6, 9, 6, 6, 9, 9, 7, 8, 9, 8, 6,
+		5, 10, 6, 6, 5, 8, 7, 10, 6, 5, 6, 7, 7, 5, 5, 6,
+		6, 6, 4, 9, 6, 10, 8, 8, 10, 5, 5, 8, 8, 7, 6, 8,
+		7, 8, 6, 8, 6, 7, 6, 6, 10, 6, 9, 6, 10, 9, 6, 4,
+		13, 7, 6, 5, 5, 6, 9, 7, 6, 6, 9, 4, 4, 8, 6, 8,
+		8, 7, 9, 7, 10, 15, 10, 5, 5, 5, 7, 3, 8, 6, 9, 6,
+		7, 9, 8, 7, 3, 5, 5, 11, 4, 4, 6, 7, 10, 7, 7, 5,
+		5, 9, 6, 13, 5, 3, 3, 5, 5, 8, 7, 6, 6, 5, 7, 7,
+		7, 10, 8, 4, 7, 7, 6, 7, 8, 8, 6, 8, 4, 6, 6, 6,
+		6, 4, 6, 6, 6, 6, 6, 6, 8, 8, 8, 8, 6, 5, 6, 5,
+		7, 5, 3, 5, 4, 5, 3, 7, 8, 6, 13, 6, 5, 3, 9, 6,
+		6, 4, 7, 6, 5, 3, 4, 6, 4, 8, 9, 9, 10, 13, 7, 6,
+		4, 9, 8, 6, 6, 7, 6, 6, 6, 10, 8, 5, 9, 10, 8, 8,
+		8, 12, 5, 8, 6, 6, 7, 5, 5, 9, 7, 5, 7, 3, 5, 8,
+		7, 9, 5, 4, 8, 7, 8, 8, 5, 6, 6, 9, 7, 6, 7, 3,
+		4, 5, 4, 4, 7, 7, 4, 16, 7, 5, 12, 4, 8, 9, 6, 8,
+		4, 8, 6, 8, 6, 11, 5, 8, 4, 10, 9, 6, 8, 5, 4, 6,
+		8, 8, 6, 4, 6, 7, 7, 15, 7, 7, 5, 5, 9, 6, 5, 7,
+		8, 5, 6, 8, 10, 8, 7, 6, 6, 3, 3, 9, 9, 6, 5, 8,
+		8, 8, 11, 7, 9, 8, 4, 8, 9, 5, 7, 5, 8, 11, 16, 5,
+		12, 5, 3, 6, 4, 6, 7, 4, 8, 13, 5, 4, 4, 9, 8, 7,
+		8, 8, 5, 7, 7, 7, 9, 9, 6, 6, 6, 5, 4, 6, 6, 8,
+		11, 7, 4, 6, 7, 5, 5, 4, 6, 8, 9, 6, 8, 6, 8, 12,
+		6, 13, 11, 5, 7, 6, 8, 9, 8, 5, 7, 8, 9, 6, 10, 8,
+		11, 12, 8, 6, 8, 7, 9, 13, 6, 8, 9, 8, 8, 12, 7, 7,
+		5, 7, 8, 6, 12, 8, 8, 6, 7, 4, 8, 12, 12, 5, 7, 6,
+		6, 8, 4, 11, 11, 6, 7, 5, 5, 6, 5, 5, 7, 9, 4, 5,
+		5, 8, 5, 9, 7, 11, 6, 9, 8, 5, 8, 8, 7, 7, 9, 7,
+		9, 12, 8, 9, 7, 9, 7, 8, 5, 6, 8, 8, 9, 7, 5, 9,
+		6, 4, 6, 8, 7, 8, 9, 7, 6, 4, 9, 8, 7, 6, 6, 4,
+		6, 3, 9, 6, 5, 6, 5, 8, 7, 6, 6, 8, 7, 5, 8, 8,
+		8, 5, 6, 9, 4, 11, 11, 9, 3, 5, 6, 8, 4, 6, 9, 5,
+		6, 6, 4, 10, 8, 6, 5, 7, 7, 4, 7, 4, 6, 3, 5, 5,
+		8, 5, 9, 7, 11, 12, 10, 7, 3, 8, 5, 5, 4, 6, 6, 7,
+		11, 4, 4, 4, 6, 4, 8, 5, 5, 7, 9, 5, 10, 9, 10, 9,
+		4, 5, 8, 11, 5, 6, 4, 6, 9, 7, 8, 6, 7, 8, 6, 4,
+		7, 5, 7, 4, 6, 8, 8, 7, 8, 5, 4, 8, 4, 5, 4, 7,
+		7, 8, 6, 5, 8, 8, 9, 6, 8, 10, 8, 5, 6, 8, 10, 7,
+		5, 8, 4, 6, 8, 6, 8, 7, 6, 4, 7, 4, 7, 13, 5, 11,
+		6, 8, 8, 4, 6, 6, 7, 13, 7, 8, 5, 8, 6, 8, 6, 7,
+		9, 7, 8, 5, 8, 5, 5, 5, 3, 4, 5, 9, 6, 8, 5, 7,
+		10, 9, 10, 8, 12, 13, 3, 8, 5, 6, 6, 5, 9, 5, 8, 6,
+		6, 9, 7, 6, 8, 8, 7, 14, 5, 5, 8, 7, 2, 4, 10, 8,
+		6, 8, 6, 8, 8, 4, 5, 4, 4, 4, 3, 6, 6, 12, 3, 6,
+		4, 8, 5, 6, 8, 6, 6, 8, 7, 9, 10, 4, 5, 5, 6, 6,
+		6, 6, 4, 11, 4, 5, 5, 6, 8, 7, 4, 3, 5, 7, 9, 11,
+		7, 7, 4, 8, 7, 6, 8, 11, 6, 9, 5, 3, 6, 8, 3, 3,
+		6, 6, 10, 5, 11, 11, 3, 3, 4, 7, 4, 4, 4, 8, 8, 9,
+		8, 7, 6, 15, 6, 4, 10, 7, 6, 9, 5, 5, 6, 3, 9, 6,
+		9, 6, 5, 5, 3, 8, 7, 4, 8, 4, 6, 6, 6, 9, 5, 6,
+		9, 6, 6, 6, 5, 6, 4, 5, 4, 6, 4, 3, 5, 8, 7, 9,
+		8, 17, 10, 7, 6, 4, 8, 9, 8, 10, 13, 6, 6, 6, 7, 5,
+		7, 5, 4, 6, 7, 13, 7, 8, 9, 6, 5, 4, 6, 6, 6, 8,
+		3, 3, 5, 6, 5, 8, 10, 4, 8, 8, 7, 9, 8, 7, 6, 10,
+		8, 9, 8, 9, 7, 5, 6, 8, 8, 8, 8, 5, 4, 8, 4, 4,
+		5, 7, 9, 7, 5, 6, 6, 4, 6, 4, 6, 6, 6, 5, 7, 3,
+		9, 8, 4, 13, 8, 8, 6, 9, 9, 5, 10, 10, 12, 6, 6, 6,
+		9, 6, 6, 6, 8, 6, 11, 5, 5, 5, 6, 3, 6, 7, 5, 5,
+		5, 6, 4, 6, 9, 12, 8, 4, 6, 6, 8, 8, 9, 7, 9, 6,
+		4, 4, 4, 8, 10, 6, 7, 7, 5, 4, 6, 6, 3, 7, 8, 10,
+		8, 8, 5, 5, 5, 6, 6, 5, 9, 12, 7, 8, 5, 4, 4, 4,
+		6, 14, 7, 5, 12, 7, 7, 9, 9, 5, 8, 9, 5, 8, 6, 6,
+		4, 8, 3, 4, 5, 7, 5, 9, 13, 6, 4, 8, 10, 7, 5, 3,
+		14, 12, 7, 7, 3, 5, 5, 8, 9, 6, 4, 6, 11, 12, 7, 5,
+		8, 5, 7, 7, 5, 3, 4, 5, 5, 6, 8, 7, 6, 6, 6, 9,
+		7, 6, 6, 6, 5, 6, 5, 8, 11, 6, 4, 9, 4, 8, 7, 4,
+		8, 4, 4, 5, 6, 7, 3, 5, 4, 6, 8, 5, 9, 5, 8, 7,
+		8, 7, 9, 6, 6, 4, 5, 12, 7, 7, 8, 6, 4, 4, 9, 6,
+		7, 4, 6, 5, 7, 6, 9, 7, 7, 6, 5, 8, 8, 6, 5, 6,
+		3, 9, 14, 8, 8, 11, 6, 4, 4, 10, 7, 5, 9, 5, 7, 4,
+		7, 8, 6, 9, 10, 6, 6, 5, 5, 7, 8, 8, 6, 5, 14, 4,
+		5, 9, 8, 6, 12, 16, 15, 8, 7, 5, 5, 9, 9, 6, 7, 5,
+		9, 6, 13, 9, 4, 8, 9, 6, 5, 6, 9, 8, 5, 4, 11, 5,
+		6, 6, 6, 11, 9, 5, 7, 6, 6, 8, 9, 12, 8, 6, 3, 6,
+		5, 8, 6, 8, 5, 5, 8, 7, 7, 7, 8, 8, 6, 7, 7, 10,
+		3, 6, 5, 4, 9, 4, 4, 4, 11, 7, 5, 8, 6, 8, 6, 5,
+		5, 6, 8, 8, 6, 15, 16, 5, 3, 5, 8, 9, 8, 8, 8, 7,
+		9, 8, 4, 8, 9, 7, 4, 8, 10, 6, 6, 9, 7, 8, 8, 8,
+		5, 5, 5, 5, 7, 5, 5, 6, 7, 7, 6, 5, 6, 5, 8, 4,
+		6, 4, 8, 10, 12, 5, 6, 6, 6, 4, 5, 7, 9, 7, 4, 4,
+		5, 4, 10, 6, 4, 5, 5, 4, 7, 7, 8, 8, 9, 8, 11, 4,
+		5, 5, 9, 9, 8, 8, 4, 5, 3, 5, 6, 12, 6, 6, 5, 8,
+		6, 6, 6, 12, 4, 10, 8, 7, 6, 7, 5, 6, 6, 7, 4, 5,
+		4, 7, 8, 10, 7, 7, 7, 9, 10, 3, 5, 3, 9, 8, 7, 9,
+		6, 8, 5, 10, 9, 9, 7, 9, 8, 6, 8, 7, 7, 7, 8, 6,
+		5, 7, 8, 4, 4, 7, 7, 10, 6, 10, 7, 7, 4, 4, 6, 4,
+		12, 5, 9, 4, 5, 8, 5, 6, 4, 10, 6, 10, 7, 5, 5, 10,
+		5, 6, 4, 6, 5, 6, 12, 10, 4, 6, 7, 4, 8, 6, 9, 9,
+		9, 6, 6, 7, 8, 9, 7, 6, 7, 5, 4, 7, 5, 5, 7, 8,
+		6, 13, 15, 13, 4, 6, 8, 5, 8, 10, 4, 6, 7, 7, 9, 5,
+		4, 10, 9, 7, 6, 6, 6, 6, 15, 9, 6, 6, 9, 7, 3, 6,
+		3, 8, 7, 9, 8, 8, 6, 9, 5, 4, 7, 5, 4, 8, 4, 7,
+		6, 5, 8, 11, 7, 6, 4, 6, 5, 5, 9, 9, 4, 5, 5, 6,
+		8, 6, 11, 10, 5, 6, 5, 5, 7, 8, 4, 6, 6, 7, 4, 6,
+		5, 9, 4, 9, 6, 8, 5, 5, 6, 9, 9, 6, 8, 8, 4, 4,
+		8, 8, 9, 6, 6, 13, 6, 9, 5, 6, 8, 5, 4, 5, 8, 9,
+		4, 6, 10, 8, 9, 10, 6, 8, 5, 8, 5, 9, 9, 2, 5, 6,
+		7, 5, 6, 8, 6, 9, 6, 8, 5, 6, 8, 8, 8, 8, 4, 3,
+		4, 6, 4, 7, 9, 5, 6, 6, 11, 6, 6, 8, 6, 3, 3, 4,
+		5, 7, 8, 15, 11, 8, 6, 14, 3, 4, 5, 6, 11, 6, 9, 5,
+		8, 8, 12, 6, 6, 5, 8, 5, 8, 10, 9, 4, 6, 6, 2, 2,
+		3, 2, 3, 2, 2, 5, 6, 2, 2, 2, 2, 5, 5, 2, 6, 5,
+		2, 2, 3, 5, 6, 2, 2, 2, 2, 5, 2, 2, 2, 2, 2, 3,
+		2, 2, 2, 5, 2, 6, 2, 4, 2, 2, 2, 2, 9, 2, 2, 4,
+		2, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3,
+		3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3,
+		2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 2, 3, 3, 2, 3,
+		3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3,
+		2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2,
+		3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3,
+		3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3,
+		2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2,
+		3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3,
+		3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3, 2, 3, 3,
+		2, 3, 3, 2, 3, 3, 4, 6, 3,
+	},
+	kind: []Rule{
+		0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 0, 1,
+		1, 1, 0, 1, 1, 1, 1, 1, 3, 1, 1, 1, 1, 1, 1, 1,
+		1, 3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 3, 1, 1, 3, 3, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 1, 1, 1, 1,
+		1, 3, 3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 1, 1, 3, 1, 3, 1,
+		1, 1, 0, 1, 3, 1, 1, 1, 0, 1, 1, 1, 0, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 1, 1, 1,
+		1, 1, 1, 3, 1, 1, 1, 1, 1, 1, 3, 1, 1, 1, 1, 1,
+		1, 0, 3, 1, 1, 3, 1, 1, 3, 3, 1, 1, 1, 1, 3, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		3, 1, 1, 1, 1, 0, 1, 1, 3, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 3, 1, 3, 3, 3, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		3, 3, 3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 3, 3, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 0, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 0, 2, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 3, 2,
+		2, 2, 3, 3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1,
+	},
+	first: []uint32{
+		1, 309, 315, 316, 323, 412, 417, 422, 426, 432, 432, 436, 442, 442, 451, 457,
+		458, 458, 465, 484, 485, 485, 497, 507, 515, 515, 516, 517, 553, 558, 563, 570,
+		573, 578, 578, 587, 656, 661, 666, 668, 672, 677, 693, 693, 697, 698, 698, 698,
+		698, 713, 714, 718, 719, 763, 776, 948, 948, 955, 961, 961, 963, 963, 963, 970,
+		970, 970, 975, 985, 993, 1005, 1005, 1015, 1024, 1024, 1029, 1029, 1029, 1031, 1031, 1031,
+		1031, 1031, 1054, 1054, 1054, 1061, 1061, 1066, 1071, 1077, 1077, 1077, 1083, 1083, 1089, 1089,
+		1094, 1094, 1095, 1095, 1095, 1098, 1119, 1119, 1125, 1129, 1146, 1177, 1185, 1186, 1186, 1192,
+		1204, 1214, 1215, 1216, 1222, 1231, 1237, 1514, 1519, 1519, 1527, 1527, 1590, 1590, 1596, 1596,
+		1603, 1620, 1624, 1630, 1659, 1659, 1664, 1670, 1679, 1684, 1690, 1690, 1704, 1709, 1711, 1712,
+		1712, 1721, 1730, 1736, 1738, 1738, 1746, 1754, 1754, 1754, 1761, 1768, 1768, 1771, 1776, 1776,
+		1776, 1776, 1777, 1777, 1777, 1784, 2332, 2346, 2357, 2362, 2369, 2369, 2386, 2388, 2389, 2389,
+		2430, 2440, 2446, 2446, 2448, 3173, 3173, 3180, 3183, 3183, 3193, 3246, 3257, 3264, 3267, 3267,
+		3275, 3289, 3460, 3460, 3465, 3478, 3485, 3492, 3500, 3506, 3506, 3514, 3517, 3528, 3534, 3667,
+		3676, 3684, 3689, 3694, 3702, 3742, 3748, 3753, 3753, 3753, 3758, 3758, 3765, 3768, 3768, 3780,
+		3780, 3780, 3781, 3787, 3790, 3790, 3790, 3790, 3790, 3790, 3797, 3812, 3812, 3813, 3821, 3841,
+		3847, 3849, 3849, 3866, 3870, 3883, 3890, 3968, 3976, 3988, 4051, 4057, 4061, 4061, 4067, 4077,
+		4077, 4082, 4094, 4094, 4094, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101, 4101,
+		4101, 4101, 4101, 4101, 4101, 4101, 4101, 4109, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116, 4116,
+		4116, 4116, 4116, 4116, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118,
+		4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4118, 4171, 4199, 4221, 4279, 4279, 4279,
+		4301, 4316, 4379, 4430, 4468, 4468, 4468, 4504, 4529, 4671, 4717, 4768, 4787, 4822, 4837, 4857,
+		4887, 4888, 4889, 4890, 4921, 4947, 4978, 4978, 5008, 5041, 5068, 5143, 5165, 5166, 5204, 5204,
+		5238, 5257, 5283, 5325, 5325, 5375, 5401, 5470, 5471, 5472, 5495, 5518, 5554, 5585, 5602, 5659,
+		5672, 5696, 5725, 5759, 5775, 5803, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804, 5804,
+		5804, 5804, 5804, 5805, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806,
+		5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806,
+		5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806,
+		5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806, 5806,
+		5806, 5807, 5807, 5807, 5807, 5807, 5808, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809,
+		5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809,
+		5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809,
+		5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809,
+		5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809, 5809,
+		5809, 5809, 5809, 5809, 5810, 5810, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811,
+		5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811,
+		5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811,
+		5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811,
+		5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5811, 5812, 5812, 5812, 5812, 5812, 5812, 5812,
+		5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812,
+		5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812,
+		5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812,
+		5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5812, 5815, 5815, 5815,
+		5815, 5815, 5815, 5815, 5815, 5816, 5817, 5817, 5817, 5817, 5817, 5817, 5817, 5817, 5817, 5817,
+		5817, 5818, 5818, 5818, 5818, 5818, 5818, 5818, 5818, 5818, 5818, 5818, 5818, 5818, 5818, 5818,
+		5818, 5818, 5818, 5818, 5818, 5818, 5818, 5818, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819,
+		5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819,
+		5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819,
+		5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819,
+		5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819,
+		5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819,
+		5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819,
+		5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5819, 5821, 5821, 5821,
+		5821, 5821, 5821, 5821, 5822, 5822, 5822, 5822, 5822, 5822, 5822, 5822, 5824, 5824, 5824, 5824,
+		5824, 5824, 5824, 5824, 5824, 5824, 5824, 5824, 5824, 5824, 5824, 5824, 5824, 5824, 5824, 5825,
+		5825, 5825, 5825, 5825, 5825, 5829, 5829, 5829, 5829, 5829, 5829, 5830, 5830, 5830, 5830, 5830,
+		5830, 5831, 5831, 5832, 5832, 5832, 5832, 5832, 5832, 5832, 5832, 5832, 5832, 5832, 5833, 5833,
+		5833, 5833, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834,
+		5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834, 5834,
+		5834, 5834, 5834, 5834, 5834, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835,
+		5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835,
+		5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5835, 5836, 5836, 5836,
+		5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836,
+		5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836,
+		5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5836, 5837, 5837,
+		5837, 5837, 5837, 5837, 5837, 5837, 5837, 5837, 5837, 5837, 5837, 5837, 5837, 5837, 5837, 5837,
+		5837, 5837, 5837, 5837, 5837, 5837, 5837, 5838, 5838, 5838, 5838, 5838, 5838, 5838, 5838, 5838,
+		5838, 5838, 5838, 5838, 5838, 5838, 5838, 5838, 5838, 5840, 5840, 5840, 5840, 5840, 5840, 5840,
+		5841, 5841, 5841, 5841, 5841, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842,
+		5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842, 5842,
+		5842, 5843, 5843, 5843, 5843, 5843, 5843, 5843, 5843, 5843, 5843, 5843, 5843, 5843, 5843, 5843,
+		5843, 5843, 5843, 5843, 5843, 5843, 5843, 5843, 5844, 5844, 5844, 5844, 5844, 5844, 5844, 5845,
+		5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845,
+		5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5845, 5846,
+		5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846,
+		5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846, 5846,
+		5846, 5846, 5846, 5846, 5846, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848,
+		5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848,
+		5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848,
+		5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848,
+		5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848,
+		5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848,
+		5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848,
+		5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5848, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857,
+		5857, 5857, 5857, 5857, 5857, 5857, 5857, 5857, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858,
+		5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858,
+		5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858,
+		5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858,
+		5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858,
+		5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858,
+		5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858,
+		5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858,
+		5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858, 5858,
+		5858, 5858, 5858, 5858, 5858, 5861, 5864, 5867, 5870, 5873, 5876, 5879, 5882, 5885, 5888, 5888,
+		5888, 5891, 5894, 5897, 5899, 5902, 5905, 5908, 5911, 5911, 5911, 5911, 5914, 5917, 5920, 5920,
+		5923, 5926, 5929, 5932, 5935, 5938, 5941, 5944, 5947, 5950, 5953, 5956, 5959, 5962, 5962, 5965,
+		5968, 5971, 5974, 5977, 5980, 5983, 5986, 5989, 5992, 5992, 5995, 5998, 6001, 6004, 6007, 6010,
+		6013, 6016, 6019, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022, 6022,
+		6022, 6022, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025,
+		6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025,
+		6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025,
+		6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025,
+		6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025,
+		6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025,
+		6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025, 6025,
+	},
+	count: []uint32{
+		308, 6, 1, 7, 89, 5, 5, 4, 6, 0, 4, 6, 0, 9, 6, 1,
+		0, 7, 19, 1, 0, 12, 10, 8, 0, 1, 1, 36, 5, 5, 7, 3,
+		5, 0, 9, 69, 5, 5, 2, 4, 5, 16, 0, 4, 1, 0, 0, 0,
+		15, 1, 4, 1, 44, 13, 172, 0, 7, 6, 0, 2, 0, 0, 7, 0,
+		0, 5, 10, 8, 12, 0, 10, 9, 0, 5, 0, 0, 2, 0, 0, 0,
+		0, 23, 0, 0, 7, 0, 5, 5, 6, 0, 0, 6, 0, 6, 0, 5,
+		0, 1, 0, 0, 3, 21, 0, 6, 4, 17, 31, 8, 1, 0, 6, 12,
+		10, 1, 1, 6, 9, 6, 277, 5, 0, 8, 0, 63, 0, 6, 0, 7,
+		17, 4, 6, 29, 0, 5, 6, 9, 5, 6, 0, 14, 5, 2, 1, 0,
+		9, 9, 6, 2, 0, 8, 8, 0, 0, 7, 7, 0, 3, 5, 0, 0,
+		0, 1, 0, 0, 7, 548, 14, 11, 5, 7, 0, 17, 2, 1, 0, 41,
+		10, 6, 0, 2, 725, 0, 7, 3, 0, 10, 53, 11, 7, 3, 0, 8,
+		14, 171, 0, 5, 13, 7, 7, 8, 6, 0, 8, 3, 11, 6, 133, 9,
+		8, 5, 5, 8, 40, 6, 5, 0, 0, 5, 0, 7, 3, 0, 12, 0,
+		0, 1, 6, 3, 0, 0, 0, 0, 0, 7, 15, 0, 1, 8, 20, 6,
+		2, 0, 17, 4, 13, 7, 78, 8, 12, 63, 6, 4, 0, 6, 10, 0,
+		5, 12, 0, 0, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 8, 7, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 53, 28, 22, 58, 0, 0, 22,
+		15, 63, 51, 38, 0, 0, 36, 25, 142, 46, 51, 19, 35, 15, 20, 30,
+		1, 1, 1, 31, 26, 31, 0, 30, 33, 27, 75, 22, 1, 38, 0, 34,
+		19, 26, 42, 0, 50, 26, 69, 1, 1, 23, 23, 36, 31, 17, 57, 13,
+		24, 29, 34, 16, 28, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0,
+		0, 0, 0, 0, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0,
+		0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0,
+		1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0,
+		0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 1, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 0, 0,
+		3, 3, 3, 2, 3, 3, 3, 3, 0, 0, 0, 3, 3, 3, 0, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 0, 3, 3,
+		3, 3, 3, 3, 3, 3, 3, 3, 0, 3, 3, 3, 3, 3, 3, 3,
+		3, 3, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0,
+	},
+}
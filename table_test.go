@@ -0,0 +1,6707 @@
+// Copyright 2012 Volker Dobler. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cookiejar
+
+// This file was generated by performing
+//     go run maketable.go -tree
+// on  Thu, 25 Oct 2012 02:28:04 +0200
+// Do not modify.
+
+// referenceSuffixes is the public suffix list as tree of Node nodes.
+var referenceSuffixes = Node{"", 0, []Node{
+	{"ac", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ad", 1, []Node{
+		{"nom", 1, nil},
+	},
+	},
+	{"ae", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"sch", 1, nil},
+	},
+	},
+	{"aero", 1, []Node{
+		{"accident-investigation", 1, nil},
+		{"accident-prevention", 1, nil},
+		{"aerobatic", 1, nil},
+		{"aeroclub", 1, nil},
+		{"aerodrome", 1, nil},
+		{"agents", 1, nil},
+		{"air-surveillance", 1, nil},
+		{"air-traffic-control", 1, nil},
+		{"aircraft", 1, nil},
+		{"airline", 1, nil},
+		{"airport", 1, nil},
+		{"airtraffic", 1, nil},
+		{"ambulance", 1, nil},
+		{"amusement", 1, nil},
+		{"association", 1, nil},
+		{"author", 1, nil},
+		{"ballooning", 1, nil},
+		{"broker", 1, nil},
+		{"caa", 1, nil},
+		{"cargo", 1, nil},
+		{"catering", 1, nil},
+		{"certification", 1, nil},
+		{"championship", 1, nil},
+		{"charter", 1, nil},
+		{"civilaviation", 1, nil},
+		{"club", 1, nil},
+		{"conference", 1, nil},
+		{"consultant", 1, nil},
+		{"consulting", 1, nil},
+		{"control", 1, nil},
+		{"council", 1, nil},
+		{"crew", 1, nil},
+		{"design", 1, nil},
+		{"dgca", 1, nil},
+		{"educator", 1, nil},
+		{"emergency", 1, nil},
+		{"engine", 1, nil},
+		{"engineer", 1, nil},
+		{"entertainment", 1, nil},
+		{"equipment", 1, nil},
+		{"exchange", 1, nil},
+		{"express", 1, nil},
+		{"federation", 1, nil},
+		{"flight", 1, nil},
+		{"freight", 1, nil},
+		{"fuel", 1, nil},
+		{"gliding", 1, nil},
+		{"government", 1, nil},
+		{"groundhandling", 1, nil},
+		{"group", 1, nil},
+		{"hanggliding", 1, nil},
+		{"homebuilt", 1, nil},
+		{"insurance", 1, nil},
+		{"journal", 1, nil},
+		{"journalist", 1, nil},
+		{"leasing", 1, nil},
+		{"logistics", 1, nil},
+		{"magazine", 1, nil},
+		{"maintenance", 1, nil},
+		{"marketplace", 1, nil},
+		{"media", 1, nil},
+		{"microlight", 1, nil},
+		{"modelling", 1, nil},
+		{"navigation", 1, nil},
+		{"parachuting", 1, nil},
+		{"paragliding", 1, nil},
+		{"passenger-association", 1, nil},
+		{"pilot", 1, nil},
+		{"press", 1, nil},
+		{"production", 1, nil},
+		{"recreation", 1, nil},
+		{"repbody", 1, nil},
+		{"res", 1, nil},
+		{"research", 1, nil},
+		{"rotorcraft", 1, nil},
+		{"safety", 1, nil},
+		{"scientist", 1, nil},
+		{"services", 1, nil},
+		{"show", 1, nil},
+		{"skydiving", 1, nil},
+		{"software", 1, nil},
+		{"student", 1, nil},
+		{"taxi", 1, nil},
+		{"trader", 1, nil},
+		{"trading", 1, nil},
+		{"trainer", 1, nil},
+		{"union", 1, nil},
+		{"workinggroup", 1, nil},
+		{"works", 1, nil},
+	},
+	},
+	{"af", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ag", 1, []Node{
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"net", 1, nil},
+		{"nom", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ai", 1, []Node{
+		{"com", 1, nil},
+		{"net", 1, nil},
+		{"off", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"al", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"am", 1, nil},
+	{"an", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ao", 1, []Node{
+		{"co", 1, nil},
+		{"ed", 1, nil},
+		{"gv", 1, nil},
+		{"it", 1, nil},
+		{"og", 1, nil},
+		{"pb", 1, nil},
+	},
+	},
+	{"aq", 1, nil},
+	{"ar", 3, []Node{
+		{"congresodelalengua3", 2, nil},
+		{"educ", 2, nil},
+		{"gobiernoelectronico", 2, nil},
+		{"mecon", 2, nil},
+		{"nacion", 2, nil},
+		{"nic", 2, nil},
+		{"promocion", 2, nil},
+		{"retina", 2, nil},
+		{"uba", 2, nil},
+	},
+	},
+	{"arpa", 0, []Node{
+		{"e164", 1, nil},
+		{"in-addr", 1, nil},
+		{"ip6", 1, nil},
+		{"iris", 1, nil},
+		{"uri", 1, nil},
+		{"urn", 1, nil},
+	},
+	},
+	{"as", 1, []Node{
+		{"gov", 1, nil},
+	},
+	},
+	{"asia", 1, nil},
+	{"at", 1, []Node{
+		{"ac", 1, nil},
+		{"biz", 1, nil},
+		{"co", 1, nil},
+		{"gv", 1, nil},
+		{"info", 1, nil},
+		{"or", 1, nil},
+		{"priv", 1, nil},
+	},
+	},
+	{"au", 0, []Node{
+		{"act", 1, nil},
+		{"asn", 1, nil},
+		{"com", 1, nil},
+		{"conf", 1, nil},
+		{"csiro", 1, nil},
+		{"edu", 1, []Node{
+			{"act", 1, nil},
+			{"nsw", 1, nil},
+			{"nt", 1, nil},
+			{"qld", 1, nil},
+			{"sa", 1, nil},
+			{"tas", 1, nil},
+			{"vic", 1, nil},
+			{"wa", 1, nil},
+		},
+		},
+		{"gov", 1, []Node{
+			{"act", 1, nil},
+			{"nt", 1, nil},
+			{"qld", 1, nil},
+			{"sa", 1, nil},
+			{"tas", 1, nil},
+			{"vic", 1, nil},
+			{"wa", 1, nil},
+		},
+		},
+		{"id", 1, nil},
+		{"info", 1, nil},
+		{"net", 1, nil},
+		{"nsw", 1, nil},
+		{"nt", 1, nil},
+		{"org", 1, nil},
+		{"oz", 1, nil},
+		{"qld", 1, nil},
+		{"sa", 1, nil},
+		{"tas", 1, nil},
+		{"vic", 1, nil},
+		{"wa", 1, nil},
+	},
+	},
+	{"aw", 1, []Node{
+		{"com", 1, nil},
+	},
+	},
+	{"ax", 1, nil},
+	{"az", 1, []Node{
+		{"biz", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"int", 1, nil},
+		{"mil", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"pp", 1, nil},
+		{"pro", 1, nil},
+	},
+	},
+	{"ba", 1, []Node{
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"rs", 1, nil},
+		{"unbi", 1, nil},
+		{"unsa", 1, nil},
+	},
+	},
+	{"bb", 1, []Node{
+		{"biz", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"store", 1, nil},
+	},
+	},
+	{"bd", 3, nil},
+	{"be", 1, []Node{
+		{"ac", 1, nil},
+	},
+	},
+	{"bf", 1, []Node{
+		{"gov", 1, nil},
+	},
+	},
+	{"bg", 1, []Node{
+		{"0", 1, nil},
+		{"1", 1, nil},
+		{"2", 1, nil},
+		{"3", 1, nil},
+		{"4", 1, nil},
+		{"5", 1, nil},
+		{"6", 1, nil},
+		{"7", 1, nil},
+		{"8", 1, nil},
+		{"9", 1, nil},
+		{"a", 1, nil},
+		{"b", 1, nil},
+		{"c", 1, nil},
+		{"d", 1, nil},
+		{"e", 1, nil},
+		{"f", 1, nil},
+		{"g", 1, nil},
+		{"h", 1, nil},
+		{"i", 1, nil},
+		{"j", 1, nil},
+		{"k", 1, nil},
+		{"l", 1, nil},
+		{"m", 1, nil},
+		{"n", 1, nil},
+		{"o", 1, nil},
+		{"p", 1, nil},
+		{"q", 1, nil},
+		{"r", 1, nil},
+		{"s", 1, nil},
+		{"t", 1, nil},
+		{"u", 1, nil},
+		{"v", 1, nil},
+		{"w", 1, nil},
+		{"x", 1, nil},
+		{"y", 1, nil},
+		{"z", 1, nil},
+	},
+	},
+	{"bh", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"bi", 1, []Node{
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"or", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"biz", 1, []Node{
+		{"dyndns", 1, nil},
+		{"for-better", 1, nil},
+		{"for-more", 1, nil},
+		{"for-some", 1, nil},
+		{"for-the", 1, nil},
+		{"selfip", 1, nil},
+		{"webhop", 1, nil},
+	},
+	},
+	{"bj", 1, []Node{
+		{"asso", 1, nil},
+		{"barreau", 1, nil},
+		{"gouv", 1, nil},
+	},
+	},
+	{"bm", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"bn", 3, nil},
+	{"bo", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gob", 1, nil},
+		{"gov", 1, nil},
+		{"int", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"tv", 1, nil},
+	},
+	},
+	{"br", 1, []Node{
+		{"adm", 1, nil},
+		{"adv", 1, nil},
+		{"agr", 1, nil},
+		{"am", 1, nil},
+		{"arq", 1, nil},
+		{"art", 1, nil},
+		{"ato", 1, nil},
+		{"b", 1, nil},
+		{"bio", 1, nil},
+		{"blog", 1, nil},
+		{"bmd", 1, nil},
+		{"cim", 1, nil},
+		{"cng", 1, nil},
+		{"cnt", 1, nil},
+		{"com", 1, nil},
+		{"coop", 1, nil},
+		{"ecn", 1, nil},
+		{"eco", 1, nil},
+		{"edu", 1, nil},
+		{"emp", 1, nil},
+		{"eng", 1, nil},
+		{"esp", 1, nil},
+		{"etc", 1, nil},
+		{"eti", 1, nil},
+		{"far", 1, nil},
+		{"flog", 1, nil},
+		{"fm", 1, nil},
+		{"fnd", 1, nil},
+		{"fot", 1, nil},
+		{"fst", 1, nil},
+		{"g12", 1, nil},
+		{"ggf", 1, nil},
+		{"gov", 1, nil},
+		{"imb", 1, nil},
+		{"ind", 1, nil},
+		{"inf", 1, nil},
+		{"jor", 1, nil},
+		{"jus", 1, nil},
+		{"leg", 1, nil},
+		{"lel", 1, nil},
+		{"mat", 1, nil},
+		{"med", 1, nil},
+		{"mil", 1, nil},
+		{"mus", 1, nil},
+		{"net", 1, nil},
+		{"nom", 1, nil},
+		{"not", 1, nil},
+		{"ntr", 1, nil},
+		{"odo", 1, nil},
+		{"org", 1, nil},
+		{"ppg", 1, nil},
+		{"pro", 1, nil},
+		{"psc", 1, nil},
+		{"psi", 1, nil},
+		{"qsl", 1, nil},
+		{"radio", 1, nil},
+		{"rec", 1, nil},
+		{"slg", 1, nil},
+		{"srv", 1, nil},
+		{"taxi", 1, nil},
+		{"teo", 1, nil},
+		{"tmp", 1, nil},
+		{"trd", 1, nil},
+		{"tur", 1, nil},
+		{"tv", 1, nil},
+		{"vet", 1, nil},
+		{"vlog", 1, nil},
+		{"wiki", 1, nil},
+		{"zlg", 1, nil},
+	},
+	},
+	{"bs", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"bt", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"bw", 1, []Node{
+		{"co", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"by", 1, []Node{
+		{"com", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"of", 1, nil},
+	},
+	},
+	{"bz", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ca", 1, []Node{
+		{"ab", 1, nil},
+		{"bc", 1, nil},
+		{"co", 1, nil},
+		{"gc", 1, nil},
+		{"mb", 1, nil},
+		{"nb", 1, nil},
+		{"nf", 1, nil},
+		{"nl", 1, nil},
+		{"ns", 1, nil},
+		{"nt", 1, nil},
+		{"nu", 1, nil},
+		{"on", 1, nil},
+		{"pe", 1, nil},
+		{"qc", 1, nil},
+		{"sk", 1, nil},
+		{"yk", 1, nil},
+	},
+	},
+	{"cat", 1, nil},
+	{"cc", 1, []Node{
+		{"ftpaccess", 1, nil},
+		{"game-server", 1, nil},
+		{"myphotos", 1, nil},
+		{"scrapping", 1, nil},
+	},
+	},
+	{"cd", 1, []Node{
+		{"gov", 1, nil},
+	},
+	},
+	{"cf", 1, nil},
+	{"cg", 1, nil},
+	{"ch", 1, nil},
+	{"ci", 1, []Node{
+		{"ac", 1, nil},
+		{"asso", 1, nil},
+		{"aéroport", 1, nil},
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"ed", 1, nil},
+		{"edu", 1, nil},
+		{"go", 1, nil},
+		{"gouv", 1, nil},
+		{"int", 1, nil},
+		{"md", 1, nil},
+		{"net", 1, nil},
+		{"or", 1, nil},
+		{"org", 1, nil},
+		{"presse", 1, nil},
+	},
+	},
+	{"ck", 3, []Node{
+		{"www", 2, nil},
+	},
+	},
+	{"cl", 1, []Node{
+		{"co", 1, nil},
+		{"gob", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+	},
+	},
+	{"cm", 1, []Node{
+		{"gov", 1, nil},
+	},
+	},
+	{"cn", 1, []Node{
+		{"ac", 1, nil},
+		{"ah", 1, nil},
+		{"bj", 1, nil},
+		{"com", 1, nil},
+		{"cq", 1, nil},
+		{"edu", 1, nil},
+		{"fj", 1, nil},
+		{"gd", 1, nil},
+		{"gov", 1, nil},
+		{"gs", 1, nil},
+		{"gx", 1, nil},
+		{"gz", 1, nil},
+		{"ha", 1, nil},
+		{"hb", 1, nil},
+		{"he", 1, nil},
+		{"hi", 1, nil},
+		{"hk", 1, nil},
+		{"hl", 1, nil},
+		{"hn", 1, nil},
+		{"jl", 1, nil},
+		{"js", 1, nil},
+		{"jx", 1, nil},
+		{"ln", 1, nil},
+		{"mil", 1, nil},
+		{"mo", 1, nil},
+		{"net", 1, nil},
+		{"nm", 1, nil},
+		{"nx", 1, nil},
+		{"org", 1, nil},
+		{"qh", 1, nil},
+		{"sc", 1, nil},
+		{"sd", 1, nil},
+		{"sh", 1, nil},
+		{"sn", 1, nil},
+		{"sx", 1, nil},
+		{"tj", 1, nil},
+		{"tw", 1, nil},
+		{"xj", 1, nil},
+		{"xz", 1, nil},
+		{"yn", 1, nil},
+		{"zj", 1, nil},
+		{"公司", 1, nil},
+		{"網絡", 1, nil},
+		{"网络", 1, nil},
+	},
+	},
+	{"co", 1, []Node{
+		{"arts", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"firm", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"int", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"nom", 1, nil},
+		{"org", 1, nil},
+		{"rec", 1, nil},
+		{"web", 1, nil},
+	},
+	},
+	{"com", 1, []Node{
+		{"appspot", 1, nil},
+		{"ar", 1, nil},
+		{"blogdns", 1, nil},
+		{"br", 1, nil},
+		{"cechire", 1, nil},
+		{"cn", 1, nil},
+		{"de", 1, nil},
+		{"dnsalias", 1, nil},
+		{"dnsdojo", 1, nil},
+		{"doesntexist", 1, nil},
+		{"dontexist", 1, nil},
+		{"doomdns", 1, nil},
+		{"dreamhosters", 1, nil},
+		{"dyn-o-saur", 1, nil},
+		{"dynalias", 1, nil},
+		{"dyndns-at-home", 1, nil},
+		{"dyndns-at-work", 1, nil},
+		{"dyndns-blog", 1, nil},
+		{"dyndns-free", 1, nil},
+		{"dyndns-home", 1, nil},
+		{"dyndns-ip", 1, nil},
+		{"dyndns-mail", 1, nil},
+		{"dyndns-office", 1, nil},
+		{"dyndns-pics", 1, nil},
+		{"dyndns-remote", 1, nil},
+		{"dyndns-server", 1, nil},
+		{"dyndns-web", 1, nil},
+		{"dyndns-wiki", 1, nil},
+		{"dyndns-work", 1, nil},
+		{"est-a-la-maison", 1, nil},
+		{"est-a-la-masion", 1, nil},
+		{"est-le-patron", 1, nil},
+		{"est-mon-blogueur", 1, nil},
+		{"eu", 1, nil},
+		{"from-ak", 1, nil},
+		{"from-al", 1, nil},
+		{"from-ar", 1, nil},
+		{"from-ca", 1, nil},
+		{"from-ct", 1, nil},
+		{"from-dc", 1, nil},
+		{"from-de", 1, nil},
+		{"from-fl", 1, nil},
+		{"from-ga", 1, nil},
+		{"from-hi", 1, nil},
+		{"from-ia", 1, nil},
+		{"from-id", 1, nil},
+		{"from-il", 1, nil},
+		{"from-in", 1, nil},
+		{"from-ks", 1, nil},
+		{"from-ky", 1, nil},
+		{"from-ma", 1, nil},
+		{"from-md", 1, nil},
+		{"from-mi", 1, nil},
+		{"from-mn", 1, nil},
+		{"from-mo", 1, nil},
+		{"from-ms", 1, nil},
+		{"from-mt", 1, nil},
+		{"from-nc", 1, nil},
+		{"from-nd", 1, nil},
+		{"from-ne", 1, nil},
+		{"from-nh", 1, nil},
+		{"from-nj", 1, nil},
+		{"from-nm", 1, nil},
+		{"from-nv", 1, nil},
+		{"from-oh", 1, nil},
+		{"from-ok", 1, nil},
+		{"from-or", 1, nil},
+		{"from-pa", 1, nil},
+		{"from-pr", 1, nil},
+		{"from-ri", 1, nil},
+		{"from-sc", 1, nil},
+		{"from-sd", 1, nil},
+		{"from-tn", 1, nil},
+		{"from-tx", 1, nil},
+		{"from-ut", 1, nil},
+		{"from-va", 1, nil},
+		{"from-vt", 1, nil},
+		{"from-wa", 1, nil},
+		{"from-wi", 1, nil},
+		{"from-wv", 1, nil},
+		{"from-wy", 1, nil},
+		{"gb", 1, nil},
+		{"getmyip", 1, nil},
+		{"gotdns", 1, nil},
+		{"gr", 1, nil},
+		{"hobby-site", 1, nil},
+		{"homelinux", 1, nil},
+		{"homeunix", 1, nil},
+		{"hu", 1, nil},
+		{"iamallama", 1, nil},
+		{"is-a-anarchist", 1, nil},
+		{"is-a-blogger", 1, nil},
+		{"is-a-bookkeeper", 1, nil},
+		{"is-a-bulls-fan", 1, nil},
+		{"is-a-caterer", 1, nil},
+		{"is-a-chef", 1, nil},
+		{"is-a-conservative", 1, nil},
+		{"is-a-cpa", 1, nil},
+		{"is-a-cubicle-slave", 1, nil},
+		{"is-a-democrat", 1, nil},
+		{"is-a-designer", 1, nil},
+		{"is-a-doctor", 1, nil},
+		{"is-a-financialadvisor", 1, nil},
+		{"is-a-geek", 1, nil},
+		{"is-a-green", 1, nil},
+		{"is-a-guru", 1, nil},
+		{"is-a-hard-worker", 1, nil},
+		{"is-a-hunter", 1, nil},
+		{"is-a-landscaper", 1, nil},
+		{"is-a-lawyer", 1, nil},
+		{"is-a-liberal", 1, nil},
+		{"is-a-libertarian", 1, nil},
+		{"is-a-llama", 1, nil},
+		{"is-a-musician", 1, nil},
+		{"is-a-nascarfan", 1, nil},
+		{"is-a-nurse", 1, nil},
+		{"is-a-painter", 1, nil},
+		{"is-a-personaltrainer", 1, nil},
+		{"is-a-photographer", 1, nil},
+		{"is-a-player", 1, nil},
+		{"is-a-republican", 1, nil},
+		{"is-a-rockstar", 1, nil},
+		{"is-a-socialist", 1, nil},
+		{"is-a-student", 1, nil},
+		{"is-a-teacher", 1, nil},
+		{"is-a-techie", 1, nil},
+		{"is-a-therapist", 1, nil},
+		{"is-an-accountant", 1, nil},
+		{"is-an-actor", 1, nil},
+		{"is-an-actress", 1, nil},
+		{"is-an-anarchist", 1, nil},
+		{"is-an-artist", 1, nil},
+		{"is-an-engineer", 1, nil},
+		{"is-an-entertainer", 1, nil},
+		{"is-certified", 1, nil},
+		{"is-gone", 1, nil},
+		{"is-into-anime", 1, nil},
+		{"is-into-cars", 1, nil},
+		{"is-into-cartoons", 1, nil},
+		{"is-into-games", 1, nil},
+		{"is-leet", 1, nil},
+		{"is-not-certified", 1, nil},
+		{"is-slick", 1, nil},
+		{"is-uberleet", 1, nil},
+		{"is-with-theband", 1, nil},
+		{"isa-geek", 1, nil},
+		{"isa-hockeynut", 1, nil},
+		{"issmarterthanyou", 1, nil},
+		{"jpn", 1, nil},
+		{"kr", 1, nil},
+		{"likes-pie", 1, nil},
+		{"likescandy", 1, nil},
+		{"neat-url", 1, nil},
+		{"no", 1, nil},
+		{"operaunite", 1, nil},
+		{"qc", 1, nil},
+		{"ru", 1, nil},
+		{"sa", 1, nil},
+		{"saves-the-whales", 1, nil},
+		{"se", 1, nil},
+		{"selfip", 1, nil},
+		{"sells-for-less", 1, nil},
+		{"sells-for-u", 1, nil},
+		{"servebbs", 1, nil},
+		{"simple-url", 1, nil},
+		{"space-to-rent", 1, nil},
+		{"teaches-yoga", 1, nil},
+		{"uk", 1, nil},
+		{"us", 1, nil},
+		{"uy", 1, nil},
+		{"writesthisblog", 1, nil},
+		{"za", 1, nil},
+	},
+	},
+	{"coop", 1, nil},
+	{"cr", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"ed", 1, nil},
+		{"fi", 1, nil},
+		{"go", 1, nil},
+		{"or", 1, nil},
+		{"sa", 1, nil},
+	},
+	},
+	{"cu", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"inf", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"cv", 1, nil},
+	{"cx", 1, []Node{
+		{"ath", 1, nil},
+		{"gov", 1, nil},
+	},
+	},
+	{"cy", 3, nil},
+	{"cz", 1, nil},
+	{"de", 1, []Node{
+		{"com", 1, nil},
+		{"fuettertdasnetz", 1, nil},
+		{"isteingeek", 1, nil},
+		{"istmein", 1, nil},
+		{"lebtimnetz", 1, nil},
+		{"leitungsen", 1, nil},
+		{"traeumtgerade", 1, nil},
+	},
+	},
+	{"dj", 1, nil},
+	{"dk", 1, nil},
+	{"dm", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"do", 1, []Node{
+		{"art", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gob", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"sld", 1, nil},
+		{"web", 1, nil},
+	},
+	},
+	{"dz", 1, []Node{
+		{"art", 1, nil},
+		{"asso", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"pol", 1, nil},
+	},
+	},
+	{"ec", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"fin", 1, nil},
+		{"gob", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"k12", 1, nil},
+		{"med", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"pro", 1, nil},
+	},
+	},
+	{"edu", 1, nil},
+	{"ee", 1, []Node{
+		{"aip", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"fie", 1, nil},
+		{"gov", 1, nil},
+		{"lib", 1, nil},
+		{"med", 1, nil},
+		{"org", 1, nil},
+		{"pri", 1, nil},
+		{"riik", 1, nil},
+	},
+	},
+	{"eg", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"eun", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"sci", 1, nil},
+	},
+	},
+	{"er", 3, nil},
+	{"es", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gob", 1, nil},
+		{"nom", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"et", 3, nil},
+	{"eu", 1, nil},
+	{"fi", 1, []Node{
+		{"aland", 1, nil},
+		{"iki", 1, nil},
+	},
+	},
+	{"fj", 3, nil},
+	{"fk", 3, nil},
+	{"fm", 1, nil},
+	{"fo", 1, nil},
+	{"fr", 1, []Node{
+		{"aeroport", 1, nil},
+		{"assedic", 1, nil},
+		{"asso", 1, nil},
+		{"avocat", 1, nil},
+		{"avoues", 1, nil},
+		{"cci", 1, nil},
+		{"chambagri", 1, nil},
+		{"chirurgiens-dentistes", 1, nil},
+		{"com", 1, nil},
+		{"experts-comptables", 1, nil},
+		{"geometre-expert", 1, nil},
+		{"gouv", 1, nil},
+		{"greta", 1, nil},
+		{"huissier-justice", 1, nil},
+		{"medecin", 1, nil},
+		{"nom", 1, nil},
+		{"notaires", 1, nil},
+		{"pharmacien", 1, nil},
+		{"port", 1, nil},
+		{"prd", 1, nil},
+		{"presse", 1, nil},
+		{"tm", 1, nil},
+		{"veterinaire", 1, nil},
+	},
+	},
+	{"ga", 1, nil},
+	{"gd", 1, nil},
+	{"ge", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"pvt", 1, nil},
+	},
+	},
+	{"gf", 1, nil},
+	{"gg", 1, []Node{
+		{"co", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"sch", 1, nil},
+	},
+	},
+	{"gh", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"gi", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"ltd", 1, nil},
+		{"mod", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"gl", 1, nil},
+	{"gm", 1, nil},
+	{"gn", 0, []Node{
+		{"ac", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"gov", 1, nil},
+	{"gp", 1, []Node{
+		{"asso", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"mobi", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"gq", 1, nil},
+	{"gr", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"gs", 1, nil},
+	{"gt", 3, []Node{
+		{"www", 2, nil},
+	},
+	},
+	{"gu", 3, nil},
+	{"gw", 1, nil},
+	{"gy", 1, []Node{
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"net", 1, nil},
+	},
+	},
+	{"hk", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"idv", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"个人", 1, nil},
+		{"個人", 1, nil},
+		{"公司", 1, nil},
+		{"政府", 1, nil},
+		{"敎育", 1, nil},
+		{"教育", 1, nil},
+		{"箇人", 1, nil},
+		{"組織", 1, nil},
+		{"組织", 1, nil},
+		{"網絡", 1, nil},
+		{"網络", 1, nil},
+		{"组織", 1, nil},
+		{"组织", 1, nil},
+		{"网絡", 1, nil},
+		{"网络", 1, nil},
+	},
+	},
+	{"hm", 1, nil},
+	{"hn", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gob", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"hr", 1, []Node{
+		{"com", 1, nil},
+		{"from", 1, nil},
+		{"iz", 1, nil},
+		{"name", 1, nil},
+	},
+	},
+	{"ht", 1, []Node{
+		{"adult", 1, nil},
+		{"art", 1, nil},
+		{"asso", 1, nil},
+		{"com", 1, nil},
+		{"coop", 1, nil},
+		{"edu", 1, nil},
+		{"firm", 1, nil},
+		{"gouv", 1, nil},
+		{"info", 1, nil},
+		{"med", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"perso", 1, nil},
+		{"pol", 1, nil},
+		{"pro", 1, nil},
+		{"rel", 1, nil},
+		{"shop", 1, nil},
+	},
+	},
+	{"hu", 1, []Node{
+		{"2000", 1, nil},
+		{"agrar", 1, nil},
+		{"bolt", 1, nil},
+		{"casino", 1, nil},
+		{"city", 1, nil},
+		{"co", 1, nil},
+		{"erotica", 1, nil},
+		{"erotika", 1, nil},
+		{"film", 1, nil},
+		{"forum", 1, nil},
+		{"games", 1, nil},
+		{"hotel", 1, nil},
+		{"info", 1, nil},
+		{"ingatlan", 1, nil},
+		{"jogasz", 1, nil},
+		{"konyvelo", 1, nil},
+		{"lakas", 1, nil},
+		{"media", 1, nil},
+		{"news", 1, nil},
+		{"org", 1, nil},
+		{"priv", 1, nil},
+		{"reklam", 1, nil},
+		{"sex", 1, nil},
+		{"shop", 1, nil},
+		{"sport", 1, nil},
+		{"suli", 1, nil},
+		{"szex", 1, nil},
+		{"tm", 1, nil},
+		{"tozsde", 1, nil},
+		{"utazas", 1, nil},
+		{"video", 1, nil},
+	},
+	},
+	{"id", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"go", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"or", 1, nil},
+		{"sch", 1, nil},
+		{"web", 1, nil},
+	},
+	},
+	{"ie", 1, []Node{
+		{"gov", 1, nil},
+	},
+	},
+	{"il", 3, nil},
+	{"im", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, []Node{
+			{"ltd", 1, nil},
+			{"plc", 1, nil},
+		},
+		},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"nic", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"in", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"edu", 1, nil},
+		{"firm", 1, nil},
+		{"gen", 1, nil},
+		{"gov", 1, nil},
+		{"ind", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"nic", 1, nil},
+		{"org", 1, nil},
+		{"res", 1, nil},
+	},
+	},
+	{"info", 1, []Node{
+		{"barrel-of-knowledge", 1, nil},
+		{"barrell-of-knowledge", 1, nil},
+		{"dyndns", 1, nil},
+		{"for-our", 1, nil},
+		{"groks-the", 1, nil},
+		{"groks-this", 1, nil},
+		{"here-for-more", 1, nil},
+		{"knowsitall", 1, nil},
+		{"selfip", 1, nil},
+		{"webhop", 1, nil},
+	},
+	},
+	{"int", 1, []Node{
+		{"eu", 1, nil},
+	},
+	},
+	{"io", 1, []Node{
+		{"com", 1, nil},
+	},
+	},
+	{"iq", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ir", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"gov", 1, nil},
+		{"id", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"sch", 1, nil},
+		{"ايران", 1, nil},
+		{"ایران", 1, nil},
+	},
+	},
+	{"is", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"int", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"it", 1, []Node{
+		{"ag", 1, nil},
+		{"agrigento", 1, nil},
+		{"al", 1, nil},
+		{"alessandria", 1, nil},
+		{"alto-adige", 1, nil},
+		{"altoadige", 1, nil},
+		{"an", 1, nil},
+		{"ancona", 1, nil},
+		{"andria-barletta-trani", 1, nil},
+		{"andria-trani-barletta", 1, nil},
+		{"andriabarlettatrani", 1, nil},
+		{"andriatranibarletta", 1, nil},
+		{"ao", 1, nil},
+		{"aosta", 1, nil},
+		{"aoste", 1, nil},
+		{"ap", 1, nil},
+		{"aq", 1, nil},
+		{"aquila", 1, nil},
+		{"ar", 1, nil},
+		{"arezzo", 1, nil},
+		{"ascoli-piceno", 1, nil},
+		{"ascolipiceno", 1, nil},
+		{"asti", 1, nil},
+		{"at", 1, nil},
+		{"av", 1, nil},
+		{"avellino", 1, nil},
+		{"ba", 1, nil},
+		{"balsan", 1, nil},
+		{"bari", 1, nil},
+		{"barletta-trani-andria", 1, nil},
+		{"barlettatraniandria", 1, nil},
+		{"belluno", 1, nil},
+		{"benevento", 1, nil},
+		{"bergamo", 1, nil},
+		{"bg", 1, nil},
+		{"bi", 1, nil},
+		{"biella", 1, nil},
+		{"bl", 1, nil},
+		{"bn", 1, nil},
+		{"bo", 1, nil},
+		{"bologna", 1, nil},
+		{"bolzano", 1, nil},
+		{"bozen", 1, nil},
+		{"br", 1, nil},
+		{"brescia", 1, nil},
+		{"brindisi", 1, nil},
+		{"bs", 1, nil},
+		{"bt", 1, nil},
+		{"bz", 1, nil},
+		{"ca", 1, nil},
+		{"cagliari", 1, nil},
+		{"caltanissetta", 1, nil},
+		{"campidano-medio", 1, nil},
+		{"campidanomedio", 1, nil},
+		{"campobasso", 1, nil},
+		{"carbonia-iglesias", 1, nil},
+		{"carboniaiglesias", 1, nil},
+		{"carrara-massa", 1, nil},
+		{"carraramassa", 1, nil},
+		{"caserta", 1, nil},
+		{"catania", 1, nil},
+		{"catanzaro", 1, nil},
+		{"cb", 1, nil},
+		{"ce", 1, nil},
+		{"cesena-forli", 1, nil},
+		{"cesenaforli", 1, nil},
+		{"ch", 1, nil},
+		{"chieti", 1, nil},
+		{"ci", 1, nil},
+		{"cl", 1, nil},
+		{"cn", 1, nil},
+		{"co", 1, nil},
+		{"como", 1, nil},
+		{"cosenza", 1, nil},
+		{"cr", 1, nil},
+		{"cremona", 1, nil},
+		{"crotone", 1, nil},
+		{"cs", 1, nil},
+		{"ct", 1, nil},
+		{"cuneo", 1, nil},
+		{"cz", 1, nil},
+		{"dell-ogliastra", 1, nil},
+		{"dellogliastra", 1, nil},
+		{"edu", 1, nil},
+		{"en", 1, nil},
+		{"enna", 1, nil},
+		{"fc", 1, nil},
+		{"fe", 1, nil},
+		{"fermo", 1, nil},
+		{"ferrara", 1, nil},
+		{"fg", 1, nil},
+		{"fi", 1, nil},
+		{"firenze", 1, nil},
+		{"florence", 1, nil},
+		{"fm", 1, nil},
+		{"foggia", 1, nil},
+		{"forli-cesena", 1, nil},
+		{"forlicesena", 1, nil},
+		{"fr", 1, nil},
+		{"frosinone", 1, nil},
+		{"ge", 1, nil},
+		{"genoa", 1, nil},
+		{"genova", 1, nil},
+		{"go", 1, nil},
+		{"gorizia", 1, nil},
+		{"gov", 1, nil},
+		{"gr", 1, nil},
+		{"grosseto", 1, nil},
+		{"iglesias-carbonia", 1, nil},
+		{"iglesiascarbonia", 1, nil},
+		{"im", 1, nil},
+		{"imperia", 1, nil},
+		{"is", 1, nil},
+		{"isernia", 1, nil},
+		{"kr", 1, nil},
+		{"la-spezia", 1, nil},
+		{"laquila", 1, nil},
+		{"laspezia", 1, nil},
+		{"latina", 1, nil},
+		{"lc", 1, nil},
+		{"le", 1, nil},
+		{"lecce", 1, nil},
+		{"lecco", 1, nil},
+		{"li", 1, nil},
+		{"livorno", 1, nil},
+		{"lo", 1, nil},
+		{"lodi", 1, nil},
+		{"lt", 1, nil},
+		{"lu", 1, nil},
+		{"lucca", 1, nil},
+		{"macerata", 1, nil},
+		{"mantova", 1, nil},
+		{"massa-carrara", 1, nil},
+		{"massacarrara", 1, nil},
+		{"matera", 1, nil},
+		{"mb", 1, nil},
+		{"mc", 1, nil},
+		{"me", 1, nil},
+		{"medio-campidano", 1, nil},
+		{"mediocampidano", 1, nil},
+		{"messina", 1, nil},
+		{"mi", 1, nil},
+		{"milan", 1, nil},
+		{"milano", 1, nil},
+		{"mn", 1, nil},
+		{"mo", 1, nil},
+		{"modena", 1, nil},
+		{"monza", 1, nil},
+		{"monza-brianza", 1, nil},
+		{"monza-e-della-brianza", 1, nil},
+		{"monzabrianza", 1, nil},
+		{"monzaebrianza", 1, nil},
+		{"monzaedellabrianza", 1, nil},
+		{"ms", 1, nil},
+		{"mt", 1, nil},
+		{"na", 1, nil},
+		{"naples", 1, nil},
+		{"napoli", 1, nil},
+		{"no", 1, nil},
+		{"novara", 1, nil},
+		{"nu", 1, nil},
+		{"nuoro", 1, nil},
+		{"og", 1, nil},
+		{"ogliastra", 1, nil},
+		{"olbia-tempio", 1, nil},
+		{"olbiatempio", 1, nil},
+		{"or", 1, nil},
+		{"oristano", 1, nil},
+		{"ot", 1, nil},
+		{"pa", 1, nil},
+		{"padova", 1, nil},
+		{"padua", 1, nil},
+		{"palermo", 1, nil},
+		{"parma", 1, nil},
+		{"pavia", 1, nil},
+		{"pc", 1, nil},
+		{"pd", 1, nil},
+		{"pe", 1, nil},
+		{"perugia", 1, nil},
+		{"pesaro-urbino", 1, nil},
+		{"pesarourbino", 1, nil},
+		{"pescara", 1, nil},
+		{"pg", 1, nil},
+		{"pi", 1, nil},
+		{"piacenza", 1, nil},
+		{"pisa", 1, nil},
+		{"pistoia", 1, nil},
+		{"pn", 1, nil},
+		{"po", 1, nil},
+		{"pordenone", 1, nil},
+		{"potenza", 1, nil},
+		{"pr", 1, nil},
+		{"prato", 1, nil},
+		{"pt", 1, nil},
+		{"pu", 1, nil},
+		{"pv", 1, nil},
+		{"pz", 1, nil},
+		{"ra", 1, nil},
+		{"ragusa", 1, nil},
+		{"ravenna", 1, nil},
+		{"rc", 1, nil},
+		{"re", 1, nil},
+		{"reggio-calabria", 1, nil},
+		{"reggio-emilia", 1, nil},
+		{"reggiocalabria", 1, nil},
+		{"reggioemilia", 1, nil},
+		{"rg", 1, nil},
+		{"ri", 1, nil},
+		{"rieti", 1, nil},
+		{"rimini", 1, nil},
+		{"rm", 1, nil},
+		{"rn", 1, nil},
+		{"ro", 1, nil},
+		{"roma", 1, nil},
+		{"rome", 1, nil},
+		{"rovigo", 1, nil},
+		{"sa", 1, nil},
+		{"salerno", 1, nil},
+		{"sassari", 1, nil},
+		{"savona", 1, nil},
+		{"si", 1, nil},
+		{"siena", 1, nil},
+		{"siracusa", 1, nil},
+		{"so", 1, nil},
+		{"sondrio", 1, nil},
+		{"sp", 1, nil},
+		{"sr", 1, nil},
+		{"ss", 1, nil},
+		{"suedtirol", 1, nil},
+		{"sv", 1, nil},
+		{"ta", 1, nil},
+		{"taranto", 1, nil},
+		{"te", 1, nil},
+		{"tempio-olbia", 1, nil},
+		{"tempioolbia", 1, nil},
+		{"teramo", 1, nil},
+		{"terni", 1, nil},
+		{"tn", 1, nil},
+		{"to", 1, nil},
+		{"torino", 1, nil},
+		{"tp", 1, nil},
+		{"tr", 1, nil},
+		{"trani-andria-barletta", 1, nil},
+		{"trani-barletta-andria", 1, nil},
+		{"traniandriabarletta", 1, nil},
+		{"tranibarlettaandria", 1, nil},
+		{"trapani", 1, nil},
+		{"trentino", 1, nil},
+		{"trento", 1, nil},
+		{"treviso", 1, nil},
+		{"trieste", 1, nil},
+		{"ts", 1, nil},
+		{"turin", 1, nil},
+		{"tv", 1, nil},
+		{"ud", 1, nil},
+		{"udine", 1, nil},
+		{"urbino-pesaro", 1, nil},
+		{"urbinopesaro", 1, nil},
+		{"va", 1, nil},
+		{"varese", 1, nil},
+		{"vb", 1, nil},
+		{"vc", 1, nil},
+		{"ve", 1, nil},
+		{"venezia", 1, nil},
+		{"venice", 1, nil},
+		{"verbania", 1, nil},
+		{"vercelli", 1, nil},
+		{"verona", 1, nil},
+		{"vi", 1, nil},
+		{"vibo-valentia", 1, nil},
+		{"vibovalentia", 1, nil},
+		{"vicenza", 1, nil},
+		{"viterbo", 1, nil},
+		{"vr", 1, nil},
+		{"vs", 1, nil},
+		{"vt", 1, nil},
+		{"vv", 1, nil},
+	},
+	},
+	{"je", 1, []Node{
+		{"co", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"sch", 1, nil},
+	},
+	},
+	{"jm", 3, nil},
+	{"jo", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"sch", 1, nil},
+	},
+	},
+	{"jobs", 1, nil},
+	{"jp", 1, []Node{
+		{"ac", 1, nil},
+		{"ad", 1, nil},
+		{"aichi", 1, []Node{
+			{"aisai", 1, nil},
+			{"ama", 1, nil},
+			{"anjo", 1, nil},
+			{"asuke", 1, nil},
+			{"chiryu", 1, nil},
+			{"chita", 1, nil},
+			{"fuso", 1, nil},
+			{"gamagori", 1, nil},
+			{"handa", 1, nil},
+			{"hazu", 1, nil},
+			{"hekinan", 1, nil},
+			{"higashiura", 1, nil},
+			{"ichinomiya", 1, nil},
+			{"inazawa", 1, nil},
+			{"inuyama", 1, nil},
+			{"isshiki", 1, nil},
+			{"iwakura", 1, nil},
+			{"kanie", 1, nil},
+			{"kariya", 1, nil},
+			{"kasugai", 1, nil},
+			{"kira", 1, nil},
+			{"kiyosu", 1, nil},
+			{"komaki", 1, nil},
+			{"konan", 1, nil},
+			{"kota", 1, nil},
+			{"mihama", 1, nil},
+			{"miyoshi", 1, nil},
+			{"nagakute", 1, nil},
+			{"nishio", 1, nil},
+			{"nisshin", 1, nil},
+			{"obu", 1, nil},
+			{"oguchi", 1, nil},
+			{"oharu", 1, nil},
+			{"okazaki", 1, nil},
+			{"owariasahi", 1, nil},
+			{"seto", 1, nil},
+			{"shikatsu", 1, nil},
+			{"shinshiro", 1, nil},
+			{"shitara", 1, nil},
+			{"tahara", 1, nil},
+			{"takahama", 1, nil},
+			{"tobishima", 1, nil},
+			{"toei", 1, nil},
+			{"togo", 1, nil},
+			{"tokai", 1, nil},
+			{"tokoname", 1, nil},
+			{"toyoake", 1, nil},
+			{"toyohashi", 1, nil},
+			{"toyokawa", 1, nil},
+			{"toyone", 1, nil},
+			{"toyota", 1, nil},
+			{"tsushima", 1, nil},
+			{"yatomi", 1, nil},
+		},
+		},
+		{"akita", 1, []Node{
+			{"akita", 1, nil},
+			{"daisen", 1, nil},
+			{"fujisato", 1, nil},
+			{"gojome", 1, nil},
+			{"hachirogata", 1, nil},
+			{"happou", 1, nil},
+			{"higashinaruse", 1, nil},
+			{"honjo", 1, nil},
+			{"honjyo", 1, nil},
+			{"ikawa", 1, nil},
+			{"kamikoani", 1, nil},
+			{"kamioka", 1, nil},
+			{"katagami", 1, nil},
+			{"kazuno", 1, nil},
+			{"kitaakita", 1, nil},
+			{"kosaka", 1, nil},
+			{"kyowa", 1, nil},
+			{"misato", 1, nil},
+			{"mitane", 1, nil},
+			{"moriyoshi", 1, nil},
+			{"nikaho", 1, nil},
+			{"noshiro", 1, nil},
+			{"odate", 1, nil},
+			{"oga", 1, nil},
+			{"ogata", 1, nil},
+			{"semboku", 1, nil},
+			{"yokote", 1, nil},
+			{"yurihonjo", 1, nil},
+		},
+		},
+		{"aomori", 1, []Node{
+			{"aomori", 1, nil},
+			{"gonohe", 1, nil},
+			{"hachinohe", 1, nil},
+			{"hashikami", 1, nil},
+			{"hiranai", 1, nil},
+			{"hirosaki", 1, nil},
+			{"itayanagi", 1, nil},
+			{"kuroishi", 1, nil},
+			{"misawa", 1, nil},
+			{"mutsu", 1, nil},
+			{"nakadomari", 1, nil},
+			{"noheji", 1, nil},
+			{"oirase", 1, nil},
+			{"owani", 1, nil},
+			{"rokunohe", 1, nil},
+			{"sannohe", 1, nil},
+			{"shichinohe", 1, nil},
+			{"shingo", 1, nil},
+			{"takko", 1, nil},
+			{"towada", 1, nil},
+			{"tsugaru", 1, nil},
+			{"tsuruta", 1, nil},
+		},
+		},
+		{"chiba", 1, []Node{
+			{"abiko", 1, nil},
+			{"asahi", 1, nil},
+			{"chonan", 1, nil},
+			{"chosei", 1, nil},
+			{"choshi", 1, nil},
+			{"chuo", 1, nil},
+			{"funabashi", 1, nil},
+			{"futtsu", 1, nil},
+			{"hanamigawa", 1, nil},
+			{"ichihara", 1, nil},
+			{"ichikawa", 1, nil},
+			{"ichinomiya", 1, nil},
+			{"inzai", 1, nil},
+			{"isumi", 1, nil},
+			{"kamagaya", 1, nil},
+			{"kamogawa", 1, nil},
+			{"kashiwa", 1, nil},
+			{"katori", 1, nil},
+			{"katsuura", 1, nil},
+			{"kimitsu", 1, nil},
+			{"kisarazu", 1, nil},
+			{"kozaki", 1, nil},
+			{"kujukuri", 1, nil},
+			{"kyonan", 1, nil},
+			{"matsudo", 1, nil},
+			{"midori", 1, nil},
+			{"mihama", 1, nil},
+			{"minamiboso", 1, nil},
+			{"mobara", 1, nil},
+			{"mutsuzawa", 1, nil},
+			{"nagara", 1, nil},
+			{"nagareyama", 1, nil},
+			{"narashino", 1, nil},
+			{"narita", 1, nil},
+			{"noda", 1, nil},
+			{"oamishirasato", 1, nil},
+			{"omigawa", 1, nil},
+			{"onjuku", 1, nil},
+			{"otaki", 1, nil},
+			{"sakae", 1, nil},
+			{"sakura", 1, nil},
+			{"shimofusa", 1, nil},
+			{"shirako", 1, nil},
+			{"shiroi", 1, nil},
+			{"shisui", 1, nil},
+			{"sodegaura", 1, nil},
+			{"sosa", 1, nil},
+			{"tako", 1, nil},
+			{"tateyama", 1, nil},
+			{"togane", 1, nil},
+			{"tohnosho", 1, nil},
+			{"tomisato", 1, nil},
+			{"urayasu", 1, nil},
+			{"yachimata", 1, nil},
+			{"yachiyo", 1, nil},
+			{"yokaichiba", 1, nil},
+			{"yokoshibahikari", 1, nil},
+			{"yotsukaido", 1, nil},
+		},
+		},
+		{"co", 1, nil},
+		{"ed", 1, nil},
+		{"ehime", 1, []Node{
+			{"ainan", 1, nil},
+			{"honai", 1, nil},
+			{"ikata", 1, nil},
+			{"imabari", 1, nil},
+			{"iyo", 1, nil},
+			{"kamijima", 1, nil},
+			{"kihoku", 1, nil},
+			{"kumakogen", 1, nil},
+			{"masaki", 1, nil},
+			{"matsuno", 1, nil},
+			{"matsuyama", 1, nil},
+			{"namikata", 1, nil},
+			{"niihama", 1, nil},
+			{"ozu", 1, nil},
+			{"saijo", 1, nil},
+			{"seiyo", 1, nil},
+			{"shikokuchuo", 1, nil},
+			{"tobe", 1, nil},
+			{"toon", 1, nil},
+			{"uchiko", 1, nil},
+			{"uwajima", 1, nil},
+			{"yawatahama", 1, nil},
+		},
+		},
+		{"fukui", 1, []Node{
+			{"echizen", 1, nil},
+			{"eiheiji", 1, nil},
+			{"fukui", 1, nil},
+			{"ikeda", 1, nil},
+			{"katsuyama", 1, nil},
+			{"mihama", 1, nil},
+			{"minamiechizen", 1, nil},
+			{"obama", 1, nil},
+			{"ohi", 1, nil},
+			{"ono", 1, nil},
+			{"sabae", 1, nil},
+			{"sakai", 1, nil},
+			{"takahama", 1, nil},
+			{"tsuruga", 1, nil},
+			{"wakasa", 1, nil},
+		},
+		},
+		{"fukuoka", 1, []Node{
+			{"ashiya", 1, nil},
+			{"buzen", 1, nil},
+			{"chikugo", 1, nil},
+			{"chikuho", 1, nil},
+			{"chikujo", 1, nil},
+			{"chikushino", 1, nil},
+			{"chikuzen", 1, nil},
+			{"chuo", 1, nil},
+			{"dazaifu", 1, nil},
+			{"fukuchi", 1, nil},
+			{"hakata", 1, nil},
+			{"higashi", 1, nil},
+			{"hirokawa", 1, nil},
+			{"hisayama", 1, nil},
+			{"iizuka", 1, nil},
+			{"inatsuki", 1, nil},
+			{"kaho", 1, nil},
+			{"kasuga", 1, nil},
+			{"kasuya", 1, nil},
+			{"kawara", 1, nil},
+			{"keisen", 1, nil},
+			{"koga", 1, nil},
+			{"kurate", 1, nil},
+			{"kurogi", 1, nil},
+			{"kurume", 1, nil},
+			{"minami", 1, nil},
+			{"miyako", 1, nil},
+			{"miyama", 1, nil},
+			{"miyawaka", 1, nil},
+			{"mizumaki", 1, nil},
+			{"munakata", 1, nil},
+			{"nakagawa", 1, nil},
+			{"nakama", 1, nil},
+			{"nishi", 1, nil},
+			{"nogata", 1, nil},
+			{"ogori", 1, nil},
+			{"okagaki", 1, nil},
+			{"okawa", 1, nil},
+			{"oki", 1, nil},
+			{"omuta", 1, nil},
+			{"onga", 1, nil},
+			{"onojo", 1, nil},
+			{"oto", 1, nil},
+			{"saigawa", 1, nil},
+			{"sasaguri", 1, nil},
+			{"shingu", 1, nil},
+			{"shinyoshitomi", 1, nil},
+			{"shonai", 1, nil},
+			{"soeda", 1, nil},
+			{"sue", 1, nil},
+			{"tachiarai", 1, nil},
+			{"tagawa", 1, nil},
+			{"takata", 1, nil},
+			{"toho", 1, nil},
+			{"toyotsu", 1, nil},
+			{"tsuiki", 1, nil},
+			{"ukiha", 1, nil},
+			{"umi", 1, nil},
+			{"usui", 1, nil},
+			{"yamada", 1, nil},
+			{"yame", 1, nil},
+			{"yanagawa", 1, nil},
+			{"yukuhashi", 1, nil},
+		},
+		},
+		{"fukushima", 1, []Node{
+			{"aizubange", 1, nil},
+			{"aizumisato", 1, nil},
+			{"aizuwakamatsu", 1, nil},
+			{"asakawa", 1, nil},
+			{"bandai", 1, nil},
+			{"date", 1, nil},
+			{"fukushima", 1, nil},
+			{"furudono", 1, nil},
+			{"futaba", 1, nil},
+			{"hanawa", 1, nil},
+			{"higashi", 1, nil},
+			{"hirata", 1, nil},
+			{"hirono", 1, nil},
+			{"iitate", 1, nil},
+			{"inawashiro", 1, nil},
+			{"ishikawa", 1, nil},
+			{"iwaki", 1, nil},
+			{"izumizaki", 1, nil},
+			{"kagamiishi", 1, nil},
+			{"kaneyama", 1, nil},
+			{"kawamata", 1, nil},
+			{"kitakata", 1, nil},
+			{"kitashiobara", 1, nil},
+			{"koori", 1, nil},
+			{"koriyama", 1, nil},
+			{"kunimi", 1, nil},
+			{"miharu", 1, nil},
+			{"mishima", 1, nil},
+			{"namie", 1, nil},
+			{"nango", 1, nil},
+			{"nishiaizu", 1, nil},
+			{"nishigo", 1, nil},
+			{"okuma", 1, nil},
+			{"omotego", 1, nil},
+			{"ono", 1, nil},
+			{"otama", 1, nil},
+			{"samegawa", 1, nil},
+			{"shimogo", 1, nil},
+			{"shirakawa", 1, nil},
+			{"showa", 1, nil},
+			{"soma", 1, nil},
+			{"sukagawa", 1, nil},
+			{"taishin", 1, nil},
+			{"tamakawa", 1, nil},
+			{"tanagura", 1, nil},
+			{"tenei", 1, nil},
+			{"yabuki", 1, nil},
+			{"yamato", 1, nil},
+			{"yamatsuri", 1, nil},
+			{"yanaizu", 1, nil},
+			{"yugawa", 1, nil},
+		},
+		},
+		{"gifu", 1, []Node{
+			{"anpachi", 1, nil},
+			{"ena", 1, nil},
+			{"gifu", 1, nil},
+			{"ginan", 1, nil},
+			{"godo", 1, nil},
+			{"gujo", 1, nil},
+			{"hashima", 1, nil},
+			{"hichiso", 1, nil},
+			{"hida", 1, nil},
+			{"higashishirakawa", 1, nil},
+			{"ibigawa", 1, nil},
+			{"ikeda", 1, nil},
+			{"kakamigahara", 1, nil},
+			{"kani", 1, nil},
+			{"kasahara", 1, nil},
+			{"kasamatsu", 1, nil},
+			{"kawaue", 1, nil},
+			{"kitagata", 1, nil},
+			{"mino", 1, nil},
+			{"minokamo", 1, nil},
+			{"mitake", 1, nil},
+			{"mizunami", 1, nil},
+			{"motosu", 1, nil},
+			{"nakatsugawa", 1, nil},
+			{"ogaki", 1, nil},
+			{"sakahogi", 1, nil},
+			{"seki", 1, nil},
+			{"sekigahara", 1, nil},
+			{"shirakawa", 1, nil},
+			{"tajimi", 1, nil},
+			{"takayama", 1, nil},
+			{"tarui", 1, nil},
+			{"toki", 1, nil},
+			{"tomika", 1, nil},
+			{"wanouchi", 1, nil},
+			{"yamagata", 1, nil},
+			{"yaotsu", 1, nil},
+			{"yoro", 1, nil},
+		},
+		},
+		{"go", 1, nil},
+		{"gr", 1, nil},
+		{"gunma", 1, []Node{
+			{"annaka", 1, nil},
+			{"chiyoda", 1, nil},
+			{"fujioka", 1, nil},
+			{"higashiagatsuma", 1, nil},
+			{"isesaki", 1, nil},
+			{"itakura", 1, nil},
+			{"kanna", 1, nil},
+			{"kanra", 1, nil},
+			{"katashina", 1, nil},
+			{"kawaba", 1, nil},
+			{"kiryu", 1, nil},
+			{"kusatsu", 1, nil},
+			{"maebashi", 1, nil},
+			{"meiwa", 1, nil},
+			{"midori", 1, nil},
+			{"minakami", 1, nil},
+			{"naganohara", 1, nil},
+			{"nakanojo", 1, nil},
+			{"nanmoku", 1, nil},
+			{"numata", 1, nil},
+			{"oizumi", 1, nil},
+			{"ora", 1, nil},
+			{"ota", 1, nil},
+			{"shibukawa", 1, nil},
+			{"shimonita", 1, nil},
+			{"shinto", 1, nil},
+			{"showa", 1, nil},
+			{"takasaki", 1, nil},
+			{"takayama", 1, nil},
+			{"tamamura", 1, nil},
+			{"tatebayashi", 1, nil},
+			{"tomioka", 1, nil},
+			{"tsukiyono", 1, nil},
+			{"tsumagoi", 1, nil},
+			{"ueno", 1, nil},
+			{"yoshioka", 1, nil},
+		},
+		},
+		{"hiroshima", 1, []Node{
+			{"asaminami", 1, nil},
+			{"daiwa", 1, nil},
+			{"etajima", 1, nil},
+			{"fuchu", 1, nil},
+			{"fukuyama", 1, nil},
+			{"hatsukaichi", 1, nil},
+			{"higashihiroshima", 1, nil},
+			{"hongo", 1, nil},
+			{"jinsekikogen", 1, nil},
+			{"kaita", 1, nil},
+			{"kui", 1, nil},
+			{"kumano", 1, nil},
+			{"kure", 1, nil},
+			{"mihara", 1, nil},
+			{"miyoshi", 1, nil},
+			{"naka", 1, nil},
+			{"onomichi", 1, nil},
+			{"osakikamijima", 1, nil},
+			{"otake", 1, nil},
+			{"saka", 1, nil},
+			{"sera", 1, nil},
+			{"seranishi", 1, nil},
+			{"shinichi", 1, nil},
+			{"shobara", 1, nil},
+			{"takehara", 1, nil},
+		},
+		},
+		{"hokkaido", 1, []Node{
+			{"abashiri", 1, nil},
+			{"abira", 1, nil},
+			{"aibetsu", 1, nil},
+			{"akabira", 1, nil},
+			{"akkeshi", 1, nil},
+			{"asahikawa", 1, nil},
+			{"ashibetsu", 1, nil},
+			{"ashoro", 1, nil},
+			{"assabu", 1, nil},
+			{"atsuma", 1, nil},
+			{"bibai", 1, nil},
+			{"biei", 1, nil},
+			{"bifuka", 1, nil},
+			{"bihoro", 1, nil},
+			{"biratori", 1, nil},
+			{"chippubetsu", 1, nil},
+			{"chitose", 1, nil},
+			{"date", 1, nil},
+			{"ebetsu", 1, nil},
+			{"embetsu", 1, nil},
+			{"eniwa", 1, nil},
+			{"erimo", 1, nil},
+			{"esan", 1, nil},
+			{"esashi", 1, nil},
+			{"fukagawa", 1, nil},
+			{"fukushima", 1, nil},
+			{"furano", 1, nil},
+			{"furubira", 1, nil},
+			{"haboro", 1, nil},
+			{"hakodate", 1, nil},
+			{"hamatonbetsu", 1, nil},
+			{"hidaka", 1, nil},
+			{"higashikagura", 1, nil},
+			{"higashikawa", 1, nil},
+			{"hiroo", 1, nil},
+			{"hokuryu", 1, nil},
+			{"hokuto", 1, nil},
+			{"honbetsu", 1, nil},
+			{"horokanai", 1, nil},
+			{"horonobe", 1, nil},
+			{"ikeda", 1, nil},
+			{"imakane", 1, nil},
+			{"ishikari", 1, nil},
+			{"iwamizawa", 1, nil},
+			{"iwanai", 1, nil},
+			{"kamifurano", 1, nil},
+			{"kamikawa", 1, nil},
+			{"kamishihoro", 1, nil},
+			{"kamisunagawa", 1, nil},
+			{"kamoenai", 1, nil},
+			{"kayabe", 1, nil},
+			{"kembuchi", 1, nil},
+			{"kikonai", 1, nil},
+			{"kimobetsu", 1, nil},
+			{"kitahiroshima", 1, nil},
+			{"kitami", 1, nil},
+			{"kiyosato", 1, nil},
+			{"koshimizu", 1, nil},
+			{"kunneppu", 1, nil},
+			{"kuriyama", 1, nil},
+			{"kuromatsunai", 1, nil},
+			{"kushiro", 1, nil},
+			{"kutchan", 1, nil},
+			{"kyowa", 1, nil},
+			{"mashike", 1, nil},
+			{"matsumae", 1, nil},
+			{"mikasa", 1, nil},
+			{"minamifurano", 1, nil},
+			{"mombetsu", 1, nil},
+			{"moseushi", 1, nil},
+			{"mukawa", 1, nil},
+			{"muroran", 1, nil},
+			{"naie", 1, nil},
+			{"nakagawa", 1, nil},
+			{"nakasatsunai", 1, nil},
+			{"nakatombetsu", 1, nil},
+			{"nanae", 1, nil},
+			{"nanporo", 1, nil},
+			{"nayoro", 1, nil},
+			{"nemuro", 1, nil},
+			{"niikappu", 1, nil},
+			{"niki", 1, nil},
+			{"nishiokoppe", 1, nil},
+			{"noboribetsu", 1, nil},
+			{"numata", 1, nil},
+			{"obihiro", 1, nil},
+			{"obira", 1, nil},
+			{"oketo", 1, nil},
+			{"okoppe", 1, nil},
+			{"otaru", 1, nil},
+			{"otobe", 1, nil},
+			{"otofuke", 1, nil},
+			{"otoineppu", 1, nil},
+			{"oumu", 1, nil},
+			{"ozora", 1, nil},
+			{"pippu", 1, nil},
+			{"rankoshi", 1, nil},
+			{"rebun", 1, nil},
+			{"rikubetsu", 1, nil},
+			{"rishiri", 1, nil},
+			{"rishirifuji", 1, nil},
+			{"saroma", 1, nil},
+			{"sarufutsu", 1, nil},
+			{"shakotan", 1, nil},
+			{"shari", 1, nil},
+			{"shibecha", 1, nil},
+			{"shibetsu", 1, nil},
+			{"shikabe", 1, nil},
+			{"shikaoi", 1, nil},
+			{"shimamaki", 1, nil},
+			{"shimizu", 1, nil},
+			{"shimokawa", 1, nil},
+			{"shinshinotsu", 1, nil},
+			{"shintoku", 1, nil},
+			{"shiranuka", 1, nil},
+			{"shiraoi", 1, nil},
+			{"shiriuchi", 1, nil},
+			{"sobetsu", 1, nil},
+			{"sunagawa", 1, nil},
+			{"taiki", 1, nil},
+			{"takasu", 1, nil},
+			{"takikawa", 1, nil},
+			{"takinoue", 1, nil},
+			{"teshikaga", 1, nil},
+			{"tobetsu", 1, nil},
+			{"tohma", 1, nil},
+			{"tomakomai", 1, nil},
+			{"tomari", 1, nil},
+			{"toya", 1, nil},
+			{"toyako", 1, nil},
+			{"toyotomi", 1, nil},
+			{"toyoura", 1, nil},
+			{"tsubetsu", 1, nil},
+			{"tsukigata", 1, nil},
+			{"urakawa", 1, nil},
+			{"urausu", 1, nil},
+			{"uryu", 1, nil},
+			{"utashinai", 1, nil},
+			{"wakkanai", 1, nil},
+			{"wassamu", 1, nil},
+			{"yakumo", 1, nil},
+			{"yoichi", 1, nil},
+		},
+		},
+		{"hyogo", 1, []Node{
+			{"aioi", 1, nil},
+			{"akashi", 1, nil},
+			{"ako", 1, nil},
+			{"amagasaki", 1, nil},
+			{"aogaki", 1, nil},
+			{"asago", 1, nil},
+			{"ashiya", 1, nil},
+			{"awaji", 1, nil},
+			{"fukusaki", 1, nil},
+			{"goshiki", 1, nil},
+			{"harima", 1, nil},
+			{"himeji", 1, nil},
+			{"ichikawa", 1, nil},
+			{"inagawa", 1, nil},
+			{"itami", 1, nil},
+			{"kakogawa", 1, nil},
+			{"kamigori", 1, nil},
+			{"kamikawa", 1, nil},
+			{"kasai", 1, nil},
+			{"kasuga", 1, nil},
+			{"kawanishi", 1, nil},
+			{"miki", 1, nil},
+			{"minamiawaji", 1, nil},
+			{"nishinomiya", 1, nil},
+			{"nishiwaki", 1, nil},
+			{"ono", 1, nil},
+			{"sanda", 1, nil},
+			{"sannan", 1, nil},
+			{"sasayama", 1, nil},
+			{"sayo", 1, nil},
+			{"shingu", 1, nil},
+			{"shinonsen", 1, nil},
+			{"shiso", 1, nil},
+			{"sumoto", 1, nil},
+			{"taishi", 1, nil},
+			{"taka", 1, nil},
+			{"takarazuka", 1, nil},
+			{"takasago", 1, nil},
+			{"takino", 1, nil},
+			{"tamba", 1, nil},
+			{"tatsuno", 1, nil},
+			{"toyooka", 1, nil},
+			{"yabu", 1, nil},
+			{"yashiro", 1, nil},
+			{"yoka", 1, nil},
+			{"yokawa", 1, nil},
+		},
+		},
+		{"ibaraki", 1, []Node{
+			{"ami", 1, nil},
+			{"asahi", 1, nil},
+			{"bando", 1, nil},
+			{"chikusei", 1, nil},
+			{"daigo", 1, nil},
+			{"fujishiro", 1, nil},
+			{"hitachi", 1, nil},
+			{"hitachinaka", 1, nil},
+			{"hitachiomiya", 1, nil},
+			{"hitachiota", 1, nil},
+			{"ibaraki", 1, nil},
+			{"ina", 1, nil},
+			{"inashiki", 1, nil},
+			{"itako", 1, nil},
+			{"iwama", 1, nil},
+			{"joso", 1, nil},
+			{"kamisu", 1, nil},
+			{"kasama", 1, nil},
+			{"kashima", 1, nil},
+			{"kasumigaura", 1, nil},
+			{"koga", 1, nil},
+			{"miho", 1, nil},
+			{"mito", 1, nil},
+			{"moriya", 1, nil},
+			{"naka", 1, nil},
+			{"namegata", 1, nil},
+			{"oarai", 1, nil},
+			{"ogawa", 1, nil},
+			{"omitama", 1, nil},
+			{"ryugasaki", 1, nil},
+			{"sakai", 1, nil},
+			{"sakuragawa", 1, nil},
+			{"shimodate", 1, nil},
+			{"shimotsuma", 1, nil},
+			{"shirosato", 1, nil},
+			{"sowa", 1, nil},
+			{"suifu", 1, nil},
+			{"takahagi", 1, nil},
+			{"tamatsukuri", 1, nil},
+			{"tokai", 1, nil},
+			{"tomobe", 1, nil},
+			{"tone", 1, nil},
+			{"toride", 1, nil},
+			{"tsuchiura", 1, nil},
+			{"tsukuba", 1, nil},
+			{"uchihara", 1, nil},
+			{"ushiku", 1, nil},
+			{"yachiyo", 1, nil},
+			{"yamagata", 1, nil},
+			{"yawara", 1, nil},
+			{"yuki", 1, nil},
+		},
+		},
+		{"ishikawa", 1, []Node{
+			{"anamizu", 1, nil},
+			{"hakui", 1, nil},
+			{"hakusan", 1, nil},
+			{"kaga", 1, nil},
+			{"kahoku", 1, nil},
+			{"kanazawa", 1, nil},
+			{"kawakita", 1, nil},
+			{"komatsu", 1, nil},
+			{"nakanoto", 1, nil},
+			{"nanao", 1, nil},
+			{"nomi", 1, nil},
+			{"nonoichi", 1, nil},
+			{"noto", 1, nil},
+			{"shika", 1, nil},
+			{"suzu", 1, nil},
+			{"tsubata", 1, nil},
+			{"tsurugi", 1, nil},
+			{"uchinada", 1, nil},
+			{"wajima", 1, nil},
+		},
+		},
+		{"iwate", 1, []Node{
+			{"fudai", 1, nil},
+			{"fujisawa", 1, nil},
+			{"hanamaki", 1, nil},
+			{"hiraizumi", 1, nil},
+			{"hirono", 1, nil},
+			{"ichinohe", 1, nil},
+			{"ichinoseki", 1, nil},
+			{"iwaizumi", 1, nil},
+			{"iwate", 1, nil},
+			{"joboji", 1, nil},
+			{"kamaishi", 1, nil},
+			{"kanegasaki", 1, nil},
+			{"karumai", 1, nil},
+			{"kawai", 1, nil},
+			{"kitakami", 1, nil},
+			{"kuji", 1, nil},
+			{"kunohe", 1, nil},
+			{"kuzumaki", 1, nil},
+			{"miyako", 1, nil},
+			{"mizusawa", 1, nil},
+			{"morioka", 1, nil},
+			{"ninohe", 1, nil},
+			{"noda", 1, nil},
+			{"ofunato", 1, nil},
+			{"oshu", 1, nil},
+			{"otsuchi", 1, nil},
+			{"rikuzentakata", 1, nil},
+			{"shiwa", 1, nil},
+			{"shizukuishi", 1, nil},
+			{"sumita", 1, nil},
+			{"takizawa", 1, nil},
+			{"tanohata", 1, nil},
+			{"tono", 1, nil},
+			{"yahaba", 1, nil},
+			{"yamada", 1, nil},
+		},
+		},
+		{"kagawa", 1, []Node{
+			{"ayagawa", 1, nil},
+			{"higashikagawa", 1, nil},
+			{"kanonji", 1, nil},
+			{"kotohira", 1, nil},
+			{"manno", 1, nil},
+			{"marugame", 1, nil},
+			{"mitoyo", 1, nil},
+			{"naoshima", 1, nil},
+			{"sanuki", 1, nil},
+			{"tadotsu", 1, nil},
+			{"takamatsu", 1, nil},
+			{"tonosho", 1, nil},
+			{"uchinomi", 1, nil},
+			{"utazu", 1, nil},
+			{"zentsuji", 1, nil},
+		},
+		},
+		{"kagoshima", 1, []Node{
+			{"akune", 1, nil},
+			{"amami", 1, nil},
+			{"hioki", 1, nil},
+			{"isa", 1, nil},
+			{"isen", 1, nil},
+			{"izumi", 1, nil},
+			{"kagoshima", 1, nil},
+			{"kanoya", 1, nil},
+			{"kawanabe", 1, nil},
+			{"kinko", 1, nil},
+			{"kouyama", 1, nil},
+			{"makurazaki", 1, nil},
+			{"matsumoto", 1, nil},
+			{"minamitane", 1, nil},
+			{"nakatane", 1, nil},
+			{"nishinoomote", 1, nil},
+			{"satsumasendai", 1, nil},
+			{"soo", 1, nil},
+			{"tarumizu", 1, nil},
+			{"yusui", 1, nil},
+		},
+		},
+		{"kanagawa", 1, []Node{
+			{"aikawa", 1, nil},
+			{"atsugi", 1, nil},
+			{"ayase", 1, nil},
+			{"chigasaki", 1, nil},
+			{"ebina", 1, nil},
+			{"fujisawa", 1, nil},
+			{"hadano", 1, nil},
+			{"hakone", 1, nil},
+			{"hiratsuka", 1, nil},
+			{"isehara", 1, nil},
+			{"kaisei", 1, nil},
+			{"kamakura", 1, nil},
+			{"kiyokawa", 1, nil},
+			{"matsuda", 1, nil},
+			{"minamiashigara", 1, nil},
+			{"miura", 1, nil},
+			{"nakai", 1, nil},
+			{"ninomiya", 1, nil},
+			{"odawara", 1, nil},
+			{"oi", 1, nil},
+			{"oiso", 1, nil},
+			{"sagamihara", 1, nil},
+			{"samukawa", 1, nil},
+			{"tsukui", 1, nil},
+			{"yamakita", 1, nil},
+			{"yamato", 1, nil},
+			{"yokosuka", 1, nil},
+			{"yugawara", 1, nil},
+			{"zama", 1, nil},
+			{"zushi", 1, nil},
+		},
+		},
+		{"kawasaki", 3, []Node{
+			{"city", 2, nil},
+		},
+		},
+		{"kitakyushu", 3, []Node{
+			{"city", 2, nil},
+		},
+		},
+		{"kobe", 3, []Node{
+			{"city", 2, nil},
+		},
+		},
+		{"kochi", 1, []Node{
+			{"aki", 1, nil},
+			{"geisei", 1, nil},
+			{"hidaka", 1, nil},
+			{"higashitsuno", 1, nil},
+			{"ino", 1, nil},
+			{"kagami", 1, nil},
+			{"kami", 1, nil},
+			{"kitagawa", 1, nil},
+			{"kochi", 1, nil},
+			{"mihara", 1, nil},
+			{"motoyama", 1, nil},
+			{"muroto", 1, nil},
+			{"nahari", 1, nil},
+			{"nakamura", 1, nil},
+			{"nankoku", 1, nil},
+			{"nishitosa", 1, nil},
+			{"niyodogawa", 1, nil},
+			{"ochi", 1, nil},
+			{"okawa", 1, nil},
+			{"otoyo", 1, nil},
+			{"otsuki", 1, nil},
+			{"sakawa", 1, nil},
+			{"sukumo", 1, nil},
+			{"susaki", 1, nil},
+			{"tosa", 1, nil},
+			{"tosashimizu", 1, nil},
+			{"toyo", 1, nil},
+			{"tsuno", 1, nil},
+			{"umaji", 1, nil},
+			{"yasuda", 1, nil},
+			{"yusuhara", 1, nil},
+		},
+		},
+		{"kumamoto", 1, []Node{
+			{"amakusa", 1, nil},
+			{"arao", 1, nil},
+			{"aso", 1, nil},
+			{"choyo", 1, nil},
+			{"gyokuto", 1, nil},
+			{"hitoyoshi", 1, nil},
+			{"kamiamakusa", 1, nil},
+			{"kashima", 1, nil},
+			{"kikuchi", 1, nil},
+			{"kosa", 1, nil},
+			{"kumamoto", 1, nil},
+			{"mashiki", 1, nil},
+			{"mifune", 1, nil},
+			{"minamata", 1, nil},
+			{"minamioguni", 1, nil},
+			{"nagasu", 1, nil},
+			{"nishihara", 1, nil},
+			{"oguni", 1, nil},
+			{"ozu", 1, nil},
+			{"sumoto", 1, nil},
+			{"takamori", 1, nil},
+			{"uki", 1, nil},
+			{"uto", 1, nil},
+			{"yamaga", 1, nil},
+			{"yamato", 1, nil},
+			{"yatsushiro", 1, nil},
+		},
+		},
+		{"kyoto", 1, []Node{
+			{"ayabe", 1, nil},
+			{"fukuchiyama", 1, nil},
+			{"higashiyama", 1, nil},
+			{"ide", 1, nil},
+			{"ine", 1, nil},
+			{"joyo", 1, nil},
+			{"kameoka", 1, nil},
+			{"kamo", 1, nil},
+			{"kita", 1, nil},
+			{"kizu", 1, nil},
+			{"kumiyama", 1, nil},
+			{"kyotamba", 1, nil},
+			{"kyotanabe", 1, nil},
+			{"kyotango", 1, nil},
+			{"maizuru", 1, nil},
+			{"minami", 1, nil},
+			{"minamiyamashiro", 1, nil},
+			{"miyazu", 1, nil},
+			{"muko", 1, nil},
+			{"nagaokakyo", 1, nil},
+			{"nakagyo", 1, nil},
+			{"nantan", 1, nil},
+			{"oyamazaki", 1, nil},
+			{"sakyo", 1, nil},
+			{"seika", 1, nil},
+			{"tanabe", 1, nil},
+			{"uji", 1, nil},
+			{"ujitawara", 1, nil},
+			{"wazuka", 1, nil},
+			{"yamashina", 1, nil},
+			{"yawata", 1, nil},
+		},
+		},
+		{"lg", 1, nil},
+		{"mie", 1, []Node{
+			{"asahi", 1, nil},
+			{"inabe", 1, nil},
+			{"ise", 1, nil},
+			{"kameyama", 1, nil},
+			{"kawagoe", 1, nil},
+			{"kiho", 1, nil},
+			{"kisosaki", 1, nil},
+			{"kiwa", 1, nil},
+			{"komono", 1, nil},
+			{"kumano", 1, nil},
+			{"kuwana", 1, nil},
+			{"matsusaka", 1, nil},
+			{"meiwa", 1, nil},
+			{"mihama", 1, nil},
+			{"minamiise", 1, nil},
+			{"misugi", 1, nil},
+			{"miyama", 1, nil},
+			{"nabari", 1, nil},
+			{"shima", 1, nil},
+			{"suzuka", 1, nil},
+			{"tado", 1, nil},
+			{"taiki", 1, nil},
+			{"taki", 1, nil},
+			{"tamaki", 1, nil},
+			{"toba", 1, nil},
+			{"tsu", 1, nil},
+			{"udono", 1, nil},
+			{"ureshino", 1, nil},
+			{"watarai", 1, nil},
+			{"yokkaichi", 1, nil},
+		},
+		},
+		{"miyagi", 1, []Node{
+			{"furukawa", 1, nil},
+			{"higashimatsushima", 1, nil},
+			{"ishinomaki", 1, nil},
+			{"iwanuma", 1, nil},
+			{"kakuda", 1, nil},
+			{"kami", 1, nil},
+			{"kawasaki", 1, nil},
+			{"kesennuma", 1, nil},
+			{"marumori", 1, nil},
+			{"matsushima", 1, nil},
+			{"minamisanriku", 1, nil},
+			{"misato", 1, nil},
+			{"murata", 1, nil},
+			{"natori", 1, nil},
+			{"ogawara", 1, nil},
+			{"ohira", 1, nil},
+			{"onagawa", 1, nil},
+			{"osaki", 1, nil},
+			{"rifu", 1, nil},
+			{"semine", 1, nil},
+			{"shibata", 1, nil},
+			{"shichikashuku", 1, nil},
+			{"shikama", 1, nil},
+			{"shiogama", 1, nil},
+			{"shiroishi", 1, nil},
+			{"tagajo", 1, nil},
+			{"taiwa", 1, nil},
+			{"tome", 1, nil},
+			{"tomiya", 1, nil},
+			{"wakuya", 1, nil},
+			{"watari", 1, nil},
+			{"yamamoto", 1, nil},
+			{"zao", 1, nil},
+		},
+		},
+		{"miyazaki", 1, []Node{
+			{"aya", 1, nil},
+			{"ebino", 1, nil},
+			{"gokase", 1, nil},
+			{"hyuga", 1, nil},
+			{"kadogawa", 1, nil},
+			{"kawaminami", 1, nil},
+			{"kijo", 1, nil},
+			{"kitagawa", 1, nil},
+			{"kitakata", 1, nil},
+			{"kitaura", 1, nil},
+			{"kobayashi", 1, nil},
+			{"kunitomi", 1, nil},
+			{"kushima", 1, nil},
+			{"mimata", 1, nil},
+			{"miyakonojo", 1, nil},
+			{"miyazaki", 1, nil},
+			{"morotsuka", 1, nil},
+			{"nichinan", 1, nil},
+			{"nishimera", 1, nil},
+			{"nobeoka", 1, nil},
+			{"saito", 1, nil},
+			{"shiiba", 1, nil},
+			{"shintomi", 1, nil},
+			{"takaharu", 1, nil},
+			{"takanabe", 1, nil},
+			{"takazaki", 1, nil},
+			{"tsuno", 1, nil},
+		},
+		},
+		{"nagano", 1, []Node{
+			{"achi", 1, nil},
+			{"agematsu", 1, nil},
+			{"anan", 1, nil},
+			{"aoki", 1, nil},
+			{"asahi", 1, nil},
+			{"azumino", 1, nil},
+			{"chikuhoku", 1, nil},
+			{"chikuma", 1, nil},
+			{"chino", 1, nil},
+			{"fujimi", 1, nil},
+			{"hakuba", 1, nil},
+			{"hara", 1, nil},
+			{"hiraya", 1, nil},
+			{"iida", 1, nil},
+			{"iijima", 1, nil},
+			{"iiyama", 1, nil},
+			{"iizuna", 1, nil},
+			{"ikeda", 1, nil},
+			{"ikusaka", 1, nil},
+			{"ina", 1, nil},
+			{"karuizawa", 1, nil},
+			{"kawakami", 1, nil},
+			{"kiso", 1, nil},
+			{"kisofukushima", 1, nil},
+			{"kitaaiki", 1, nil},
+			{"komagane", 1, nil},
+			{"komoro", 1, nil},
+			{"matsukawa", 1, nil},
+			{"matsumoto", 1, nil},
+			{"miasa", 1, nil},
+			{"minamiaiki", 1, nil},
+			{"minamimaki", 1, nil},
+			{"minamiminowa", 1, nil},
+			{"minowa", 1, nil},
+			{"miyada", 1, nil},
+			{"miyota", 1, nil},
+			{"mochizuki", 1, nil},
+			{"nagano", 1, nil},
+			{"nagawa", 1, nil},
+			{"nagiso", 1, nil},
+			{"nakagawa", 1, nil},
+			{"nakano", 1, nil},
+			{"nozawaonsen", 1, nil},
+			{"obuse", 1, nil},
+			{"ogawa", 1, nil},
+			{"okaya", 1, nil},
+			{"omachi", 1, nil},
+			{"omi", 1, nil},
+			{"ookuwa", 1, nil},
+			{"ooshika", 1, nil},
+			{"otaki", 1, nil},
+			{"otari", 1, nil},
+			{"sakae", 1, nil},
+			{"sakaki", 1, nil},
+			{"saku", 1, nil},
+			{"sakuho", 1, nil},
+			{"shimosuwa", 1, nil},
+			{"shinanomachi", 1, nil},
+			{"shiojiri", 1, nil},
+			{"suwa", 1, nil},
+			{"suzaka", 1, nil},
+			{"takagi", 1, nil},
+			{"takamori", 1, nil},
+			{"takayama", 1, nil},
+			{"tateshina", 1, nil},
+			{"tatsuno", 1, nil},
+			{"togakushi", 1, nil},
+			{"togura", 1, nil},
+			{"tomi", 1, nil},
+			{"ueda", 1, nil},
+			{"wada", 1, nil},
+			{"yamagata", 1, nil},
+			{"yamanouchi", 1, nil},
+			{"yasaka", 1, nil},
+			{"yasuoka", 1, nil},
+		},
+		},
+		{"nagasaki", 1, []Node{
+			{"chijiwa", 1, nil},
+			{"futsu", 1, nil},
+			{"goto", 1, nil},
+			{"hasami", 1, nil},
+			{"hirado", 1, nil},
+			{"iki", 1, nil},
+			{"isahaya", 1, nil},
+			{"kawatana", 1, nil},
+			{"kuchinotsu", 1, nil},
+			{"matsuura", 1, nil},
+			{"nagasaki", 1, nil},
+			{"obama", 1, nil},
+			{"omura", 1, nil},
+			{"oseto", 1, nil},
+			{"saikai", 1, nil},
+			{"sasebo", 1, nil},
+			{"seihi", 1, nil},
+			{"shimabara", 1, nil},
+			{"shinkamigoto", 1, nil},
+			{"togitsu", 1, nil},
+			{"tsushima", 1, nil},
+			{"unzen", 1, nil},
+		},
+		},
+		{"nagoya", 3, []Node{
+			{"city", 2, nil},
+		},
+		},
+		{"nara", 1, []Node{
+			{"ando", 1, nil},
+			{"gose", 1, nil},
+			{"heguri", 1, nil},
+			{"higashiyoshino", 1, nil},
+			{"ikaruga", 1, nil},
+			{"ikoma", 1, nil},
+			{"kamikitayama", 1, nil},
+			{"kanmaki", 1, nil},
+			{"kashiba", 1, nil},
+			{"kashihara", 1, nil},
+			{"katsuragi", 1, nil},
+			{"kawai", 1, nil},
+			{"kawakami", 1, nil},
+			{"kawanishi", 1, nil},
+			{"koryo", 1, nil},
+			{"kurotaki", 1, nil},
+			{"mitsue", 1, nil},
+			{"miyake", 1, nil},
+			{"nara", 1, nil},
+			{"nosegawa", 1, nil},
+			{"oji", 1, nil},
+			{"ouda", 1, nil},
+			{"oyodo", 1, nil},
+			{"sakurai", 1, nil},
+			{"sango", 1, nil},
+			{"shimoichi", 1, nil},
+			{"shimokitayama", 1, nil},
+			{"shinjo", 1, nil},
+			{"soni", 1, nil},
+			{"takatori", 1, nil},
+			{"tawaramoto", 1, nil},
+			{"tenkawa", 1, nil},
+			{"tenri", 1, nil},
+			{"uda", 1, nil},
+			{"yamatokoriyama", 1, nil},
+			{"yamatotakada", 1, nil},
+			{"yamazoe", 1, nil},
+			{"yoshino", 1, nil},
+		},
+		},
+		{"ne", 1, nil},
+		{"niigata", 1, []Node{
+			{"aga", 1, nil},
+			{"agano", 1, nil},
+			{"gosen", 1, nil},
+			{"itoigawa", 1, nil},
+			{"izumozaki", 1, nil},
+			{"joetsu", 1, nil},
+			{"kamo", 1, nil},
+			{"kariwa", 1, nil},
+			{"kashiwazaki", 1, nil},
+			{"minamiuonuma", 1, nil},
+			{"mitsuke", 1, nil},
+			{"muika", 1, nil},
+			{"murakami", 1, nil},
+			{"myoko", 1, nil},
+			{"nagaoka", 1, nil},
+			{"niigata", 1, nil},
+			{"ojiya", 1, nil},
+			{"omi", 1, nil},
+			{"sado", 1, nil},
+			{"sanjo", 1, nil},
+			{"seiro", 1, nil},
+			{"seirou", 1, nil},
+			{"sekikawa", 1, nil},
+			{"shibata", 1, nil},
+			{"tagami", 1, nil},
+			{"tainai", 1, nil},
+			{"tochio", 1, nil},
+			{"tokamachi", 1, nil},
+			{"tsubame", 1, nil},
+			{"tsunan", 1, nil},
+			{"uonuma", 1, nil},
+			{"yahiko", 1, nil},
+			{"yoita", 1, nil},
+			{"yuzawa", 1, nil},
+		},
+		},
+		{"oita", 1, []Node{
+			{"beppu", 1, nil},
+			{"bungoono", 1, nil},
+			{"bungotakada", 1, nil},
+			{"hasama", 1, nil},
+			{"hiji", 1, nil},
+			{"himeshima", 1, nil},
+			{"hita", 1, nil},
+			{"kamitsue", 1, nil},
+			{"kokonoe", 1, nil},
+			{"kuju", 1, nil},
+			{"kunisaki", 1, nil},
+			{"kusu", 1, nil},
+			{"oita", 1, nil},
+			{"saiki", 1, nil},
+			{"taketa", 1, nil},
+			{"tsukumi", 1, nil},
+			{"usa", 1, nil},
+			{"usuki", 1, nil},
+			{"yufu", 1, nil},
+		},
+		},
+		{"okayama", 1, []Node{
+			{"akaiwa", 1, nil},
+			{"asakuchi", 1, nil},
+			{"bizen", 1, nil},
+			{"hayashima", 1, nil},
+			{"ibara", 1, nil},
+			{"kagamino", 1, nil},
+			{"kasaoka", 1, nil},
+			{"kibichuo", 1, nil},
+			{"kumenan", 1, nil},
+			{"kurashiki", 1, nil},
+			{"maniwa", 1, nil},
+			{"misaki", 1, nil},
+			{"nagi", 1, nil},
+			{"niimi", 1, nil},
+			{"nishiawakura", 1, nil},
+			{"okayama", 1, nil},
+			{"satosho", 1, nil},
+			{"setouchi", 1, nil},
+			{"shinjo", 1, nil},
+			{"shoo", 1, nil},
+			{"soja", 1, nil},
+			{"takahashi", 1, nil},
+			{"tamano", 1, nil},
+			{"tsuyama", 1, nil},
+			{"wake", 1, nil},
+			{"yakage", 1, nil},
+		},
+		},
+		{"okinawa", 1, []Node{
+			{"aguni", 1, nil},
+			{"ginowan", 1, nil},
+			{"ginoza", 1, nil},
+			{"gushikami", 1, nil},
+			{"haebaru", 1, nil},
+			{"higashi", 1, nil},
+			{"hirara", 1, nil},
+			{"iheya", 1, nil},
+			{"ishigaki", 1, nil},
+			{"ishikawa", 1, nil},
+			{"itoman", 1, nil},
+			{"izena", 1, nil},
+			{"kadena", 1, nil},
+			{"kin", 1, nil},
+			{"kitadaito", 1, nil},
+			{"kitanakagusuku", 1, nil},
+			{"kumejima", 1, nil},
+			{"kunigami", 1, nil},
+			{"minamidaito", 1, nil},
+			{"motobu", 1, nil},
+			{"nago", 1, nil},
+			{"naha", 1, nil},
+			{"nakagusuku", 1, nil},
+			{"nakijin", 1, nil},
+			{"nanjo", 1, nil},
+			{"nishihara", 1, nil},
+			{"ogimi", 1, nil},
+			{"okinawa", 1, nil},
+			{"onna", 1, nil},
+			{"shimoji", 1, nil},
+			{"taketomi", 1, nil},
+			{"tarama", 1, nil},
+			{"tokashiki", 1, nil},
+			{"tomigusuku", 1, nil},
+			{"tonaki", 1, nil},
+			{"urasoe", 1, nil},
+			{"uruma", 1, nil},
+			{"yaese", 1, nil},
+			{"yomitan", 1, nil},
+			{"yonabaru", 1, nil},
+			{"yonaguni", 1, nil},
+			{"zamami", 1, nil},
+		},
+		},
+		{"or", 1, nil},
+		{"osaka", 1, []Node{
+			{"abeno", 1, nil},
+			{"chihayaakasaka", 1, nil},
+			{"chuo", 1, nil},
+			{"daito", 1, nil},
+			{"fujiidera", 1, nil},
+			{"habikino", 1, nil},
+			{"hannan", 1, nil},
+			{"higashiosaka", 1, nil},
+			{"higashisumiyoshi", 1, nil},
+			{"higashiyodogawa", 1, nil},
+			{"hirakata", 1, nil},
+			{"ibaraki", 1, nil},
+			{"ikeda", 1, nil},
+			{"izumi", 1, nil},
+			{"izumiotsu", 1, nil},
+			{"izumisano", 1, nil},
+			{"kadoma", 1, nil},
+			{"kaizuka", 1, nil},
+			{"kanan", 1, nil},
+			{"kashiwara", 1, nil},
+			{"katano", 1, nil},
+			{"kawachinagano", 1, nil},
+			{"kishiwada", 1, nil},
+			{"kita", 1, nil},
+			{"kumatori", 1, nil},
+			{"matsubara", 1, nil},
+			{"minato", 1, nil},
+			{"minoh", 1, nil},
+			{"misaki", 1, nil},
+			{"moriguchi", 1, nil},
+			{"neyagawa", 1, nil},
+			{"nishi", 1, nil},
+			{"nose", 1, nil},
+			{"osakasayama", 1, nil},
+			{"sakai", 1, nil},
+			{"sayama", 1, nil},
+			{"sennan", 1, nil},
+			{"settsu", 1, nil},
+			{"shijonawate", 1, nil},
+			{"shimamoto", 1, nil},
+			{"suita", 1, nil},
+			{"tadaoka", 1, nil},
+			{"taishi", 1, nil},
+			{"tajiri", 1, nil},
+			{"takaishi", 1, nil},
+			{"takatsuki", 1, nil},
+			{"tondabayashi", 1, nil},
+			{"toyonaka", 1, nil},
+			{"toyono", 1, nil},
+			{"yao", 1, nil},
+		},
+		},
+		{"saga", 1, []Node{
+			{"ariake", 1, nil},
+			{"arita", 1, nil},
+			{"fukudomi", 1, nil},
+			{"genkai", 1, nil},
+			{"hamatama", 1, nil},
+			{"hizen", 1, nil},
+			{"imari", 1, nil},
+			{"kamimine", 1, nil},
+			{"kanzaki", 1, nil},
+			{"karatsu", 1, nil},
+			{"kashima", 1, nil},
+			{"kitagata", 1, nil},
+			{"kitahata", 1, nil},
+			{"kiyama", 1, nil},
+			{"kouhoku", 1, nil},
+			{"kyuragi", 1, nil},
+			{"nishiarita", 1, nil},
+			{"ogi", 1, nil},
+			{"omachi", 1, nil},
+			{"ouchi", 1, nil},
+			{"saga", 1, nil},
+			{"shiroishi", 1, nil},
+			{"taku", 1, nil},
+			{"tara", 1, nil},
+			{"tosu", 1, nil},
+			{"yoshinogari", 1, nil},
+		},
+		},
+		{"saitama", 1, []Node{
+			{"arakawa", 1, nil},
+			{"asaka", 1, nil},
+			{"chichibu", 1, nil},
+			{"fujimi", 1, nil},
+			{"fujimino", 1, nil},
+			{"fukaya", 1, nil},
+			{"hanno", 1, nil},
+			{"hanyu", 1, nil},
+			{"hasuda", 1, nil},
+			{"hatogaya", 1, nil},
+			{"hatoyama", 1, nil},
+			{"hidaka", 1, nil},
+			{"higashichichibu", 1, nil},
+			{"higashimatsuyama", 1, nil},
+			{"honjo", 1, nil},
+			{"ina", 1, nil},
+			{"iruma", 1, nil},
+			{"iwatsuki", 1, nil},
+			{"kamiizumi", 1, nil},
+			{"kamikawa", 1, nil},
+			{"kamisato", 1, nil},
+			{"kasukabe", 1, nil},
+			{"kawagoe", 1, nil},
+			{"kawaguchi", 1, nil},
+			{"kawajima", 1, nil},
+			{"kazo", 1, nil},
+			{"kitamoto", 1, nil},
+			{"koshigaya", 1, nil},
+			{"kounosu", 1, nil},
+			{"kuki", 1, nil},
+			{"kumagaya", 1, nil},
+			{"matsubushi", 1, nil},
+			{"minano", 1, nil},
+			{"misato", 1, nil},
+			{"miyashiro", 1, nil},
+			{"miyoshi", 1, nil},
+			{"moroyama", 1, nil},
+			{"nagatoro", 1, nil},
+			{"namegawa", 1, nil},
+			{"niiza", 1, nil},
+			{"ogano", 1, nil},
+			{"ogawa", 1, nil},
+			{"ogose", 1, nil},
+			{"okegawa", 1, nil},
+			{"omiya", 1, nil},
+			{"otaki", 1, nil},
+			{"ranzan", 1, nil},
+			{"ryokami", 1, nil},
+			{"saitama", 1, nil},
+			{"sakado", 1, nil},
+			{"satte", 1, nil},
+			{"sayama", 1, nil},
+			{"shiki", 1, nil},
+			{"shiraoka", 1, nil},
+			{"soka", 1, nil},
+			{"sugito", 1, nil},
+			{"toda", 1, nil},
+			{"tokigawa", 1, nil},
+			{"tokorozawa", 1, nil},
+			{"tsurugashima", 1, nil},
+			{"urawa", 1, nil},
+			{"warabi", 1, nil},
+			{"yashio", 1, nil},
+			{"yokoze", 1, nil},
+			{"yono", 1, nil},
+			{"yorii", 1, nil},
+			{"yoshida", 1, nil},
+			{"yoshikawa", 1, nil},
+			{"yoshimi", 1, nil},
+		},
+		},
+		{"sapporo", 3, []Node{
+			{"city", 2, nil},
+		},
+		},
+		{"sendai", 3, []Node{
+			{"city", 2, nil},
+		},
+		},
+		{"shiga", 1, []Node{
+			{"aisho", 1, nil},
+			{"gamo", 1, nil},
+			{"higashiomi", 1, nil},
+			{"hikone", 1, nil},
+			{"koka", 1, nil},
+			{"konan", 1, nil},
+			{"kosei", 1, nil},
+			{"koto", 1, nil},
+			{"kusatsu", 1, nil},
+			{"maibara", 1, nil},
+			{"moriyama", 1, nil},
+			{"nagahama", 1, nil},
+			{"nishiazai", 1, nil},
+			{"notogawa", 1, nil},
+			{"omihachiman", 1, nil},
+			{"otsu", 1, nil},
+			{"ritto", 1, nil},
+			{"ryuoh", 1, nil},
+			{"takashima", 1, nil},
+			{"takatsuki", 1, nil},
+			{"torahime", 1, nil},
+			{"toyosato", 1, nil},
+			{"yasu", 1, nil},
+		},
+		},
+		{"shimane", 1, []Node{
+			{"akagi", 1, nil},
+			{"ama", 1, nil},
+			{"gotsu", 1, nil},
+			{"hamada", 1, nil},
+			{"higashiizumo", 1, nil},
+			{"hikawa", 1, nil},
+			{"hikimi", 1, nil},
+			{"izumo", 1, nil},
+			{"kakinoki", 1, nil},
+			{"masuda", 1, nil},
+			{"matsue", 1, nil},
+			{"misato", 1, nil},
+			{"nishinoshima", 1, nil},
+			{"ohda", 1, nil},
+			{"okinoshima", 1, nil},
+			{"okuizumo", 1, nil},
+			{"shimane", 1, nil},
+			{"tamayu", 1, nil},
+			{"tsuwano", 1, nil},
+			{"unnan", 1, nil},
+			{"yakumo", 1, nil},
+			{"yasugi", 1, nil},
+			{"yatsuka", 1, nil},
+		},
+		},
+		{"shizuoka", 1, []Node{
+			{"arai", 1, nil},
+			{"atami", 1, nil},
+			{"fuji", 1, nil},
+			{"fujieda", 1, nil},
+			{"fujikawa", 1, nil},
+			{"fujinomiya", 1, nil},
+			{"fukuroi", 1, nil},
+			{"gotemba", 1, nil},
+			{"haibara", 1, nil},
+			{"hamamatsu", 1, nil},
+			{"higashiizu", 1, nil},
+			{"ito", 1, nil},
+			{"iwata", 1, nil},
+			{"izu", 1, nil},
+			{"izunokuni", 1, nil},
+			{"kakegawa", 1, nil},
+			{"kannami", 1, nil},
+			{"kawanehon", 1, nil},
+			{"kawazu", 1, nil},
+			{"kikugawa", 1, nil},
+			{"kosai", 1, nil},
+			{"makinohara", 1, nil},
+			{"matsuzaki", 1, nil},
+			{"minamiizu", 1, nil},
+			{"mishima", 1, nil},
+			{"morimachi", 1, nil},
+			{"nishiizu", 1, nil},
+			{"numazu", 1, nil},
+			{"omaezaki", 1, nil},
+			{"shimada", 1, nil},
+			{"shimizu", 1, nil},
+			{"shimoda", 1, nil},
+			{"shizuoka", 1, nil},
+			{"susono", 1, nil},
+			{"yaizu", 1, nil},
+			{"yoshida", 1, nil},
+		},
+		},
+		{"tochigi", 1, []Node{
+			{"ashikaga", 1, nil},
+			{"bato", 1, nil},
+			{"haga", 1, nil},
+			{"ichikai", 1, nil},
+			{"iwafune", 1, nil},
+			{"kaminokawa", 1, nil},
+			{"kanuma", 1, nil},
+			{"karasuyama", 1, nil},
+			{"kuroiso", 1, nil},
+			{"mashiko", 1, nil},
+			{"mibu", 1, nil},
+			{"moka", 1, nil},
+			{"motegi", 1, nil},
+			{"nasu", 1, nil},
+			{"nasushiobara", 1, nil},
+			{"nikko", 1, nil},
+			{"nishikata", 1, nil},
+			{"nogi", 1, nil},
+			{"ohira", 1, nil},
+			{"ohtawara", 1, nil},
+			{"oyama", 1, nil},
+			{"sakura", 1, nil},
+			{"sano", 1, nil},
+			{"shimotsuke", 1, nil},
+			{"shioya", 1, nil},
+			{"takanezawa", 1, nil},
+			{"tochigi", 1, nil},
+			{"tsuga", 1, nil},
+			{"ujiie", 1, nil},
+			{"utsunomiya", 1, nil},
+			{"yaita", 1, nil},
+		},
+		},
+		{"tokushima", 1, []Node{
+			{"aizumi", 1, nil},
+			{"anan", 1, nil},
+			{"ichiba", 1, nil},
+			{"itano", 1, nil},
+			{"kainan", 1, nil},
+			{"komatsushima", 1, nil},
+			{"matsushige", 1, nil},
+			{"mima", 1, nil},
+			{"minami", 1, nil},
+			{"miyoshi", 1, nil},
+			{"mugi", 1, nil},
+			{"nakagawa", 1, nil},
+			{"naruto", 1, nil},
+			{"sanagochi", 1, nil},
+			{"shishikui", 1, nil},
+			{"tokushima", 1, nil},
+			{"wajiki", 1, nil},
+		},
+		},
+		{"tokyo", 1, []Node{
+			{"adachi", 1, nil},
+			{"akiruno", 1, nil},
+			{"akishima", 1, nil},
+			{"aogashima", 1, nil},
+			{"arakawa", 1, nil},
+			{"bunkyo", 1, nil},
+			{"chiyoda", 1, nil},
+			{"chofu", 1, nil},
+			{"chuo", 1, nil},
+			{"edogawa", 1, nil},
+			{"fuchu", 1, nil},
+			{"fussa", 1, nil},
+			{"hachijo", 1, nil},
+			{"hachioji", 1, nil},
+			{"hamura", 1, nil},
+			{"higashikurume", 1, nil},
+			{"higashimurayama", 1, nil},
+			{"higashiyamato", 1, nil},
+			{"hino", 1, nil},
+			{"hinode", 1, nil},
+			{"hinohara", 1, nil},
+			{"inagi", 1, nil},
+			{"itabashi", 1, nil},
+			{"katsushika", 1, nil},
+			{"kita", 1, nil},
+			{"kiyose", 1, nil},
+			{"kodaira", 1, nil},
+			{"koganei", 1, nil},
+			{"kokubunji", 1, nil},
+			{"komae", 1, nil},
+			{"koto", 1, nil},
+			{"kouzushima", 1, nil},
+			{"kunitachi", 1, nil},
+			{"machida", 1, nil},
+			{"meguro", 1, nil},
+			{"minato", 1, nil},
+			{"mitaka", 1, nil},
+			{"mizuho", 1, nil},
+			{"musashimurayama", 1, nil},
+			{"musashino", 1, nil},
+			{"nakano", 1, nil},
+			{"nerima", 1, nil},
+			{"ogasawara", 1, nil},
+			{"okutama", 1, nil},
+			{"ome", 1, nil},
+			{"oshima", 1, nil},
+			{"ota", 1, nil},
+			{"setagaya", 1, nil},
+			{"shibuya", 1, nil},
+			{"shinagawa", 1, nil},
+			{"shinjuku", 1, nil},
+			{"suginami", 1, nil},
+			{"sumida", 1, nil},
+			{"tachikawa", 1, nil},
+			{"taito", 1, nil},
+			{"tama", 1, nil},
+			{"toshima", 1, nil},
+		},
+		},
+		{"tottori", 1, []Node{
+			{"chizu", 1, nil},
+			{"hino", 1, nil},
+			{"kawahara", 1, nil},
+			{"koge", 1, nil},
+			{"kotoura", 1, nil},
+			{"misasa", 1, nil},
+			{"nanbu", 1, nil},
+			{"nichinan", 1, nil},
+			{"sakaiminato", 1, nil},
+			{"tottori", 1, nil},
+			{"wakasa", 1, nil},
+			{"yazu", 1, nil},
+			{"yonago", 1, nil},
+		},
+		},
+		{"toyama", 1, []Node{
+			{"asahi", 1, nil},
+			{"fuchu", 1, nil},
+			{"fukumitsu", 1, nil},
+			{"funahashi", 1, nil},
+			{"himi", 1, nil},
+			{"imizu", 1, nil},
+			{"inami", 1, nil},
+			{"johana", 1, nil},
+			{"kamiichi", 1, nil},
+			{"kurobe", 1, nil},
+			{"nakaniikawa", 1, nil},
+			{"namerikawa", 1, nil},
+			{"nanto", 1, nil},
+			{"nyuzen", 1, nil},
+			{"oyabe", 1, nil},
+			{"taira", 1, nil},
+			{"takaoka", 1, nil},
+			{"tateyama", 1, nil},
+			{"toga", 1, nil},
+			{"tonami", 1, nil},
+			{"toyama", 1, nil},
+			{"unazuki", 1, nil},
+			{"uozu", 1, nil},
+			{"yamada", 1, nil},
+		},
+		},
+		{"wakayama", 1, []Node{
+			{"arida", 1, nil},
+			{"aridagawa", 1, nil},
+			{"gobo", 1, nil},
+			{"hashimoto", 1, nil},
+			{"hidaka", 1, nil},
+			{"hirogawa", 1, nil},
+			{"inami", 1, nil},
+			{"iwade", 1, nil},
+			{"kainan", 1, nil},
+			{"kamitonda", 1, nil},
+			{"katsuragi", 1, nil},
+			{"kimino", 1, nil},
+			{"kinokawa", 1, nil},
+			{"kitayama", 1, nil},
+			{"koya", 1, nil},
+			{"koza", 1, nil},
+			{"kozagawa", 1, nil},
+			{"kudoyama", 1, nil},
+			{"kushimoto", 1, nil},
+			{"mihama", 1, nil},
+			{"misato", 1, nil},
+			{"nachikatsuura", 1, nil},
+			{"shingu", 1, nil},
+			{"shirahama", 1, nil},
+			{"taiji", 1, nil},
+			{"tanabe", 1, nil},
+			{"wakayama", 1, nil},
+			{"yuasa", 1, nil},
+			{"yura", 1, nil},
+		},
+		},
+		{"yamagata", 1, []Node{
+			{"asahi", 1, nil},
+			{"funagata", 1, nil},
+			{"higashine", 1, nil},
+			{"iide", 1, nil},
+			{"kahoku", 1, nil},
+			{"kaminoyama", 1, nil},
+			{"kaneyama", 1, nil},
+			{"kawanishi", 1, nil},
+			{"mamurogawa", 1, nil},
+			{"mikawa", 1, nil},
+			{"murayama", 1, nil},
+			{"nagai", 1, nil},
+			{"nakayama", 1, nil},
+			{"nanyo", 1, nil},
+			{"nishikawa", 1, nil},
+			{"obanazawa", 1, nil},
+			{"oe", 1, nil},
+			{"oguni", 1, nil},
+			{"ohkura", 1, nil},
+			{"oishida", 1, nil},
+			{"sagae", 1, nil},
+			{"sakata", 1, nil},
+			{"sakegawa", 1, nil},
+			{"shinjo", 1, nil},
+			{"shirataka", 1, nil},
+			{"shonai", 1, nil},
+			{"takahata", 1, nil},
+			{"tendo", 1, nil},
+			{"tozawa", 1, nil},
+			{"tsuruoka", 1, nil},
+			{"yamagata", 1, nil},
+			{"yamanobe", 1, nil},
+			{"yonezawa", 1, nil},
+			{"yuza", 1, nil},
+		},
+		},
+		{"yamaguchi", 1, []Node{
+			{"abu", 1, nil},
+			{"hagi", 1, nil},
+			{"hikari", 1, nil},
+			{"hofu", 1, nil},
+			{"iwakuni", 1, nil},
+			{"kudamatsu", 1, nil},
+			{"mitou", 1, nil},
+			{"nagato", 1, nil},
+			{"oshima", 1, nil},
+			{"shimonoseki", 1, nil},
+			{"shunan", 1, nil},
+			{"tabuse", 1, nil},
+			{"tokuyama", 1, nil},
+			{"toyota", 1, nil},
+			{"ube", 1, nil},
+			{"yuu", 1, nil},
+		},
+		},
+		{"yamanashi", 1, []Node{
+			{"chuo", 1, nil},
+			{"doshi", 1, nil},
+			{"fuefuki", 1, nil},
+			{"fujikawa", 1, nil},
+			{"fujikawaguchiko", 1, nil},
+			{"fujiyoshida", 1, nil},
+			{"hayakawa", 1, nil},
+			{"hokuto", 1, nil},
+			{"ichikawamisato", 1, nil},
+			{"kai", 1, nil},
+			{"kofu", 1, nil},
+			{"koshu", 1, nil},
+			{"kosuge", 1, nil},
+			{"minami-alps", 1, nil},
+			{"minobu", 1, nil},
+			{"nakamichi", 1, nil},
+			{"nanbu", 1, nil},
+			{"narusawa", 1, nil},
+			{"nirasaki", 1, nil},
+			{"nishikatsura", 1, nil},
+			{"oshino", 1, nil},
+			{"otsuki", 1, nil},
+			{"showa", 1, nil},
+			{"tabayama", 1, nil},
+			{"tsuru", 1, nil},
+			{"uenohara", 1, nil},
+			{"yamanakako", 1, nil},
+			{"yamanashi", 1, nil},
+		},
+		},
+		{"yokohama", 3, []Node{
+			{"city", 2, nil},
+		},
+		},
+	},
+	},
+	{"ke", 3, nil},
+	{"kg", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"kh", 3, nil},
+	{"ki", 1, []Node{
+		{"biz", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"km", 1, []Node{
+		{"ass", 1, nil},
+		{"asso", 1, nil},
+		{"com", 1, nil},
+		{"coop", 1, nil},
+		{"edu", 1, nil},
+		{"gouv", 1, nil},
+		{"gov", 1, nil},
+		{"medecin", 1, nil},
+		{"mil", 1, nil},
+		{"nom", 1, nil},
+		{"notaires", 1, nil},
+		{"org", 1, nil},
+		{"pharmaciens", 1, nil},
+		{"prd", 1, nil},
+		{"presse", 1, nil},
+		{"tm", 1, nil},
+		{"veterinaire", 1, nil},
+	},
+	},
+	{"kn", 1, []Node{
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"kp", 0, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"org", 1, nil},
+		{"rep", 1, nil},
+		{"tra", 1, nil},
+	},
+	},
+	{"kr", 1, []Node{
+		{"ac", 1, nil},
+		{"busan", 1, nil},
+		{"chungbuk", 1, nil},
+		{"chungnam", 1, nil},
+		{"co", 1, nil},
+		{"daegu", 1, nil},
+		{"daejeon", 1, nil},
+		{"es", 1, nil},
+		{"gangwon", 1, nil},
+		{"go", 1, nil},
+		{"gwangju", 1, nil},
+		{"gyeongbuk", 1, nil},
+		{"gyeonggi", 1, nil},
+		{"gyeongnam", 1, nil},
+		{"hs", 1, nil},
+		{"incheon", 1, nil},
+		{"jeju", 1, nil},
+		{"jeonbuk", 1, nil},
+		{"jeonnam", 1, nil},
+		{"kg", 1, nil},
+		{"mil", 1, nil},
+		{"ms", 1, nil},
+		{"ne", 1, nil},
+		{"or", 1, nil},
+		{"pe", 1, nil},
+		{"re", 1, nil},
+		{"sc", 1, nil},
+		{"seoul", 1, nil},
+		{"ulsan", 1, nil},
+	},
+	},
+	{"kw", 3, nil},
+	{"ky", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"kz", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"la", 1, []Node{
+		{"c", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"int", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"per", 1, nil},
+	},
+	},
+	{"lb", 0, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"lc", 1, []Node{
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"li", 1, nil},
+	{"lk", 1, []Node{
+		{"assn", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"grp", 1, nil},
+		{"hotel", 1, nil},
+		{"int", 1, nil},
+		{"ltd", 1, nil},
+		{"net", 1, nil},
+		{"ngo", 1, nil},
+		{"org", 1, nil},
+		{"sch", 1, nil},
+		{"soc", 1, nil},
+		{"web", 1, nil},
+	},
+	},
+	{"lr", 0, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ls", 1, []Node{
+		{"co", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"lt", 1, []Node{
+		{"gov", 1, nil},
+	},
+	},
+	{"lu", 1, nil},
+	{"lv", 1, []Node{
+		{"asn", 1, nil},
+		{"com", 1, nil},
+		{"conf", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"id", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ly", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"id", 1, nil},
+		{"med", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"plc", 1, nil},
+		{"sch", 1, nil},
+	},
+	},
+	{"ma", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"press", 1, nil},
+	},
+	},
+	{"mc", 1, []Node{
+		{"asso", 1, nil},
+		{"tm", 1, nil},
+	},
+	},
+	{"md", 1, nil},
+	{"me", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"its", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"priv", 1, nil},
+	},
+	},
+	{"mg", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"nom", 1, nil},
+		{"org", 1, nil},
+		{"prd", 1, nil},
+		{"tm", 1, nil},
+	},
+	},
+	{"mh", 1, nil},
+	{"mil", 1, nil},
+	{"mk", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"inf", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ml", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gouv", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"presse", 1, nil},
+	},
+	},
+	{"mm", 3, nil},
+	{"mn", 1, []Node{
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"mo", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"mobi", 1, nil},
+	{"mp", 1, nil},
+	{"mq", 1, nil},
+	{"mr", 1, []Node{
+		{"gov", 1, nil},
+	},
+	},
+	{"ms", 1, nil},
+	{"mt", 3, nil},
+	{"mu", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"or", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"museum", 1, []Node{
+		{"academy", 1, nil},
+		{"agriculture", 1, nil},
+		{"air", 1, nil},
+		{"airguard", 1, nil},
+		{"alabama", 1, nil},
+		{"alaska", 1, nil},
+		{"amber", 1, nil},
+		{"ambulance", 1, nil},
+		{"american", 1, nil},
+		{"americana", 1, nil},
+		{"americanantiques", 1, nil},
+		{"americanart", 1, nil},
+		{"amsterdam", 1, nil},
+		{"and", 1, nil},
+		{"annefrank", 1, nil},
+		{"anthro", 1, nil},
+		{"anthropology", 1, nil},
+		{"antiques", 1, nil},
+		{"aquarium", 1, nil},
+		{"arboretum", 1, nil},
+		{"archaeological", 1, nil},
+		{"archaeology", 1, nil},
+		{"architecture", 1, nil},
+		{"art", 1, nil},
+		{"artanddesign", 1, nil},
+		{"artcenter", 1, nil},
+		{"artdeco", 1, nil},
+		{"arteducation", 1, nil},
+		{"artgallery", 1, nil},
+		{"arts", 1, nil},
+		{"artsandcrafts", 1, nil},
+		{"asmatart", 1, nil},
+		{"assassination", 1, nil},
+		{"assisi", 1, nil},
+		{"association", 1, nil},
+		{"astronomy", 1, nil},
+		{"atlanta", 1, nil},
+		{"austin", 1, nil},
+		{"australia", 1, nil},
+		{"automotive", 1, nil},
+		{"aviation", 1, nil},
+		{"axis", 1, nil},
+		{"badajoz", 1, nil},
+		{"baghdad", 1, nil},
+		{"bahn", 1, nil},
+		{"bale", 1, nil},
+		{"baltimore", 1, nil},
+		{"barcelona", 1, nil},
+		{"baseball", 1, nil},
+		{"basel", 1, nil},
+		{"baths", 1, nil},
+		{"bauern", 1, nil},
+		{"beauxarts", 1, nil},
+		{"beeldengeluid", 1, nil},
+		{"bellevue", 1, nil},
+		{"bergbau", 1, nil},
+		{"berkeley", 1, nil},
+		{"berlin", 1, nil},
+		{"bern", 1, nil},
+		{"bible", 1, nil},
+		{"bilbao", 1, nil},
+		{"bill", 1, nil},
+		{"birdart", 1, nil},
+		{"birthplace", 1, nil},
+		{"bonn", 1, nil},
+		{"boston", 1, nil},
+		{"botanical", 1, nil},
+		{"botanicalgarden", 1, nil},
+		{"botanicgarden", 1, nil},
+		{"botany", 1, nil},
+		{"brandywinevalley", 1, nil},
+		{"brasil", 1, nil},
+		{"bristol", 1, nil},
+		{"british", 1, nil},
+		{"britishcolumbia", 1, nil},
+		{"broadcast", 1, nil},
+		{"brunel", 1, nil},
+		{"brussel", 1, nil},
+		{"brussels", 1, nil},
+		{"bruxelles", 1, nil},
+		{"building", 1, nil},
+		{"burghof", 1, nil},
+		{"bus", 1, nil},
+		{"bushey", 1, nil},
+		{"cadaques", 1, nil},
+		{"california", 1, nil},
+		{"cambridge", 1, nil},
+		{"can", 1, nil},
+		{"canada", 1, nil},
+		{"capebreton", 1, nil},
+		{"carrier", 1, nil},
+		{"cartoonart", 1, nil},
+		{"casadelamoneda", 1, nil},
+		{"castle", 1, nil},
+		{"castres", 1, nil},
+		{"celtic", 1, nil},
+		{"center", 1, nil},
+		{"chattanooga", 1, nil},
+		{"cheltenham", 1, nil},
+		{"chesapeakebay", 1, nil},
+		{"chicago", 1, nil},
+		{"children", 1, nil},
+		{"childrens", 1, nil},
+		{"childrensgarden", 1, nil},
+		{"chiropractic", 1, nil},
+		{"chocolate", 1, nil},
+		{"christiansburg", 1, nil},
+		{"cincinnati", 1, nil},
+		{"cinema", 1, nil},
+		{"circus", 1, nil},
+		{"civilisation", 1, nil},
+		{"civilization", 1, nil},
+		{"civilwar", 1, nil},
+		{"clinton", 1, nil},
+		{"clock", 1, nil},
+		{"coal", 1, nil},
+		{"coastaldefence", 1, nil},
+		{"cody", 1, nil},
+		{"coldwar", 1, nil},
+		{"collection", 1, nil},
+		{"colonialwilliamsburg", 1, nil},
+		{"coloradoplateau", 1, nil},
+		{"columbia", 1, nil},
+		{"columbus", 1, nil},
+		{"communication", 1, nil},
+		{"communications", 1, nil},
+		{"community", 1, nil},
+		{"computer", 1, nil},
+		{"computerhistory", 1, nil},
+		{"comunicações", 1, nil},
+		{"contemporary", 1, nil},
+		{"contemporaryart", 1, nil},
+		{"convent", 1, nil},
+		{"copenhagen", 1, nil},
+		{"corporation", 1, nil},
+		{"correios-e-telecomunicações", 1, nil},
+		{"corvette", 1, nil},
+		{"costume", 1, nil},
+		{"countryestate", 1, nil},
+		{"county", 1, nil},
+		{"crafts", 1, nil},
+		{"cranbrook", 1, nil},
+		{"creation", 1, nil},
+		{"cultural", 1, nil},
+		{"culturalcenter", 1, nil},
+		{"culture", 1, nil},
+		{"cyber", 1, nil},
+		{"cymru", 1, nil},
+		{"dali", 1, nil},
+		{"dallas", 1, nil},
+		{"database", 1, nil},
+		{"ddr", 1, nil},
+		{"decorativearts", 1, nil},
+		{"delaware", 1, nil},
+		{"delmenhorst", 1, nil},
+		{"denmark", 1, nil},
+		{"depot", 1, nil},
+		{"design", 1, nil},
+		{"detroit", 1, nil},
+		{"dinosaur", 1, nil},
+		{"discovery", 1, nil},
+		{"dolls", 1, nil},
+		{"donostia", 1, nil},
+		{"durham", 1, nil},
+		{"eastafrica", 1, nil},
+		{"eastcoast", 1, nil},
+		{"education", 1, nil},
+		{"educational", 1, nil},
+		{"egyptian", 1, nil},
+		{"eisenbahn", 1, nil},
+		{"elburg", 1, nil},
+		{"elvendrell", 1, nil},
+		{"embroidery", 1, nil},
+		{"encyclopedic", 1, nil},
+		{"england", 1, nil},
+		{"entomology", 1, nil},
+		{"environment", 1, nil},
+		{"environmentalconservation", 1, nil},
+		{"epilepsy", 1, nil},
+		{"essex", 1, nil},
+		{"estate", 1, nil},
+		{"ethnology", 1, nil},
+		{"exeter", 1, nil},
+		{"exhibition", 1, nil},
+		{"family", 1, nil},
+		{"farm", 1, nil},
+		{"farmequipment", 1, nil},
+		{"farmers", 1, nil},
+		{"farmstead", 1, nil},
+		{"field", 1, nil},
+		{"figueres", 1, nil},
+		{"filatelia", 1, nil},
+		{"film", 1, nil},
+		{"fineart", 1, nil},
+		{"finearts", 1, nil},
+		{"finland", 1, nil},
+		{"flanders", 1, nil},
+		{"florida", 1, nil},
+		{"force", 1, nil},
+		{"fortmissoula", 1, nil},
+		{"fortworth", 1, nil},
+		{"foundation", 1, nil},
+		{"francaise", 1, nil},
+		{"frankfurt", 1, nil},
+		{"franziskaner", 1, nil},
+		{"freemasonry", 1, nil},
+		{"freiburg", 1, nil},
+		{"fribourg", 1, nil},
+		{"frog", 1, nil},
+		{"fundacio", 1, nil},
+		{"furniture", 1, nil},
+		{"gallery", 1, nil},
+		{"garden", 1, nil},
+		{"gateway", 1, nil},
+		{"geelvinck", 1, nil},
+		{"gemological", 1, nil},
+		{"geology", 1, nil},
+		{"georgia", 1, nil},
+		{"giessen", 1, nil},
+		{"glas", 1, nil},
+		{"glass", 1, nil},
+		{"gorge", 1, nil},
+		{"grandrapids", 1, nil},
+		{"graz", 1, nil},
+		{"guernsey", 1, nil},
+		{"halloffame", 1, nil},
+		{"hamburg", 1, nil},
+		{"handson", 1, nil},
+		{"harvestcelebration", 1, nil},
+		{"hawaii", 1, nil},
+		{"health", 1, nil},
+		{"heimatunduhren", 1, nil},
+		{"hellas", 1, nil},
+		{"helsinki", 1, nil},
+		{"hembygdsforbund", 1, nil},
+		{"heritage", 1, nil},
+		{"histoire", 1, nil},
+		{"historical", 1, nil},
+		{"historicalsociety", 1, nil},
+		{"historichouses", 1, nil},
+		{"historisch", 1, nil},
+		{"historisches", 1, nil},
+		{"history", 1, nil},
+		{"historyofscience", 1, nil},
+		{"horology", 1, nil},
+		{"house", 1, nil},
+		{"humanities", 1, nil},
+		{"illustration", 1, nil},
+		{"imageandsound", 1, nil},
+		{"indian", 1, nil},
+		{"indiana", 1, nil},
+		{"indianapolis", 1, nil},
+		{"indianmarket", 1, nil},
+		{"intelligence", 1, nil},
+		{"interactive", 1, nil},
+		{"iraq", 1, nil},
+		{"iron", 1, nil},
+		{"isleofman", 1, nil},
+		{"jamison", 1, nil},
+		{"jefferson", 1, nil},
+		{"jerusalem", 1, nil},
+		{"jewelry", 1, nil},
+		{"jewish", 1, nil},
+		{"jewishart", 1, nil},
+		{"jfk", 1, nil},
+		{"journalism", 1, nil},
+		{"judaica", 1, nil},
+		{"judygarland", 1, nil},
+		{"juedisches", 1, nil},
+		{"juif", 1, nil},
+		{"karate", 1, nil},
+		{"karikatur", 1, nil},
+		{"kids", 1, nil},
+		{"koebenhavn", 1, nil},
+		{"koeln", 1, nil},
+		{"kunst", 1, nil},
+		{"kunstsammlung", 1, nil},
+		{"kunstunddesign", 1, nil},
+		{"labor", 1, nil},
+		{"labour", 1, nil},
+		{"lajolla", 1, nil},
+		{"lancashire", 1, nil},
+		{"landes", 1, nil},
+		{"lans", 1, nil},
+		{"larsson", 1, nil},
+		{"lewismiller", 1, nil},
+		{"lincoln", 1, nil},
+		{"linz", 1, nil},
+		{"living", 1, nil},
+		{"livinghistory", 1, nil},
+		{"localhistory", 1, nil},
+		{"london", 1, nil},
+		{"losangeles", 1, nil},
+		{"louvre", 1, nil},
+		{"loyalist", 1, nil},
+		{"lucerne", 1, nil},
+		{"luxembourg", 1, nil},
+		{"luzern", 1, nil},
+		{"läns", 1, nil},
+		{"mad", 1, nil},
+		{"madrid", 1, nil},
+		{"mallorca", 1, nil},
+		{"manchester", 1, nil},
+		{"mansion", 1, nil},
+		{"mansions", 1, nil},
+		{"manx", 1, nil},
+		{"marburg", 1, nil},
+		{"maritime", 1, nil},
+		{"maritimo", 1, nil},
+		{"maryland", 1, nil},
+		{"marylhurst", 1, nil},
+		{"media", 1, nil},
+		{"medical", 1, nil},
+		{"medizinhistorisches", 1, nil},
+		{"meeres", 1, nil},
+		{"memorial", 1, nil},
+		{"mesaverde", 1, nil},
+		{"michigan", 1, nil},
+		{"midatlantic", 1, nil},
+		{"military", 1, nil},
+		{"mill", 1, nil},
+		{"miners", 1, nil},
+		{"mining", 1, nil},
+		{"minnesota", 1, nil},
+		{"missile", 1, nil},
+		{"missoula", 1, nil},
+		{"modern", 1, nil},
+		{"moma", 1, nil},
+		{"money", 1, nil},
+		{"monmouth", 1, nil},
+		{"monticello", 1, nil},
+		{"montreal", 1, nil},
+		{"moscow", 1, nil},
+		{"motorcycle", 1, nil},
+		{"muenchen", 1, nil},
+		{"muenster", 1, nil},
+		{"mulhouse", 1, nil},
+		{"muncie", 1, nil},
+		{"museet", 1, nil},
+		{"museumcenter", 1, nil},
+		{"museumvereniging", 1, nil},
+		{"music", 1, nil},
+		{"national", 1, nil},
+		{"nationalfirearms", 1, nil},
+		{"nationalheritage", 1, nil},
+		{"nativeamerican", 1, nil},
+		{"naturalhistory", 1, nil},
+		{"naturalhistorymuseum", 1, nil},
+		{"naturalsciences", 1, nil},
+		{"nature", 1, nil},
+		{"naturhistorisches", 1, nil},
+		{"natuurwetenschappen", 1, nil},
+		{"naumburg", 1, nil},
+		{"naval", 1, nil},
+		{"nebraska", 1, nil},
+		{"neues", 1, nil},
+		{"newhampshire", 1, nil},
+		{"newjersey", 1, nil},
+		{"newmexico", 1, nil},
+		{"newport", 1, nil},
+		{"newspaper", 1, nil},
+		{"newyork", 1, nil},
+		{"niepce", 1, nil},
+		{"norfolk", 1, nil},
+		{"north", 1, nil},
+		{"nrw", 1, nil},
+		{"nuernberg", 1, nil},
+		{"nuremberg", 1, nil},
+		{"nyc", 1, nil},
+		{"nyny", 1, nil},
+		{"oceanographic", 1, nil},
+		{"oceanographique", 1, nil},
+		{"omaha", 1, nil},
+		{"online", 1, nil},
+		{"ontario", 1, nil},
+		{"openair", 1, nil},
+		{"oregon", 1, nil},
+		{"oregontrail", 1, nil},
+		{"otago", 1, nil},
+		{"oxford", 1, nil},
+		{"pacific", 1, nil},
+		{"paderborn", 1, nil},
+		{"palace", 1, nil},
+		{"paleo", 1, nil},
+		{"palmsprings", 1, nil},
+		{"panama", 1, nil},
+		{"paris", 1, nil},
+		{"pasadena", 1, nil},
+		{"pharmacy", 1, nil},
+		{"philadelphia", 1, nil},
+		{"philadelphiaarea", 1, nil},
+		{"philately", 1, nil},
+		{"phoenix", 1, nil},
+		{"photography", 1, nil},
+		{"pilots", 1, nil},
+		{"pittsburgh", 1, nil},
+		{"planetarium", 1, nil},
+		{"plantation", 1, nil},
+		{"plants", 1, nil},
+		{"plaza", 1, nil},
+		{"portal", 1, nil},
+		{"portland", 1, nil},
+		{"portlligat", 1, nil},
+		{"posts-and-telecommunications", 1, nil},
+		{"preservation", 1, nil},
+		{"presidio", 1, nil},
+		{"press", 1, nil},
+		{"project", 1, nil},
+		{"public", 1, nil},
+		{"pubol", 1, nil},
+		{"quebec", 1, nil},
+		{"railroad", 1, nil},
+		{"railway", 1, nil},
+		{"research", 1, nil},
+		{"resistance", 1, nil},
+		{"riodejaneiro", 1, nil},
+		{"rochester", 1, nil},
+		{"rockart", 1, nil},
+		{"roma", 1, nil},
+		{"russia", 1, nil},
+		{"saintlouis", 1, nil},
+		{"salem", 1, nil},
+		{"salvadordali", 1, nil},
+		{"salzburg", 1, nil},
+		{"sandiego", 1, nil},
+		{"sanfrancisco", 1, nil},
+		{"santabarbara", 1, nil},
+		{"santacruz", 1, nil},
+		{"santafe", 1, nil},
+		{"saskatchewan", 1, nil},
+		{"satx", 1, nil},
+		{"savannahga", 1, nil},
+		{"schlesisches", 1, nil},
+		{"schoenbrunn", 1, nil},
+		{"schokoladen", 1, nil},
+		{"school", 1, nil},
+		{"schweiz", 1, nil},
+		{"science", 1, nil},
+		{"science-fiction", 1, nil},
+		{"scienceandhistory", 1, nil},
+		{"scienceandindustry", 1, nil},
+		{"sciencecenter", 1, nil},
+		{"sciencecenters", 1, nil},
+		{"sciencehistory", 1, nil},
+		{"sciences", 1, nil},
+		{"sciencesnaturelles", 1, nil},
+		{"scotland", 1, nil},
+		{"seaport", 1, nil},
+		{"settlement", 1, nil},
+		{"settlers", 1, nil},
+		{"shell", 1, nil},
+		{"sherbrooke", 1, nil},
+		{"sibenik", 1, nil},
+		{"silk", 1, nil},
+		{"ski", 1, nil},
+		{"skole", 1, nil},
+		{"society", 1, nil},
+		{"sologne", 1, nil},
+		{"soundandvision", 1, nil},
+		{"southcarolina", 1, nil},
+		{"southwest", 1, nil},
+		{"space", 1, nil},
+		{"spy", 1, nil},
+		{"square", 1, nil},
+		{"stadt", 1, nil},
+		{"stalbans", 1, nil},
+		{"starnberg", 1, nil},
+		{"state", 1, nil},
+		{"stateofdelaware", 1, nil},
+		{"station", 1, nil},
+		{"steam", 1, nil},
+		{"steiermark", 1, nil},
+		{"stjohn", 1, nil},
+		{"stockholm", 1, nil},
+		{"stpetersburg", 1, nil},
+		{"stuttgart", 1, nil},
+		{"suisse", 1, nil},
+		{"surgeonshall", 1, nil},
+		{"surrey", 1, nil},
+		{"svizzera", 1, nil},
+		{"sweden", 1, nil},
+		{"sydney", 1, nil},
+		{"tank", 1, nil},
+		{"tcm", 1, nil},
+		{"technology", 1, nil},
+		{"telekommunikation", 1, nil},
+		{"television", 1, nil},
+		{"texas", 1, nil},
+		{"textile", 1, nil},
+		{"theater", 1, nil},
+		{"time", 1, nil},
+		{"timekeeping", 1, nil},
+		{"topology", 1, nil},
+		{"torino", 1, nil},
+		{"touch", 1, nil},
+		{"town", 1, nil},
+		{"transport", 1, nil},
+		{"tree", 1, nil},
+		{"trolley", 1, nil},
+		{"trust", 1, nil},
+		{"trustee", 1, nil},
+		{"uhren", 1, nil},
+		{"ulm", 1, nil},
+		{"undersea", 1, nil},
+		{"university", 1, nil},
+		{"usa", 1, nil},
+		{"usantiques", 1, nil},
+		{"usarts", 1, nil},
+		{"uscountryestate", 1, nil},
+		{"usculture", 1, nil},
+		{"usdecorativearts", 1, nil},
+		{"usgarden", 1, nil},
+		{"ushistory", 1, nil},
+		{"ushuaia", 1, nil},
+		{"uslivinghistory", 1, nil},
+		{"utah", 1, nil},
+		{"uvic", 1, nil},
+		{"valley", 1, nil},
+		{"vantaa", 1, nil},
+		{"versailles", 1, nil},
+		{"viking", 1, nil},
+		{"village", 1, nil},
+		{"virginia", 1, nil},
+		{"virtual", 1, nil},
+		{"virtuel", 1, nil},
+		{"vlaanderen", 1, nil},
+		{"volkenkunde", 1, nil},
+		{"wales", 1, nil},
+		{"wallonie", 1, nil},
+		{"war", 1, nil},
+		{"washingtondc", 1, nil},
+		{"watch-and-clock", 1, nil},
+		{"watchandclock", 1, nil},
+		{"western", 1, nil},
+		{"westfalen", 1, nil},
+		{"whaling", 1, nil},
+		{"wildlife", 1, nil},
+		{"williamsburg", 1, nil},
+		{"windmill", 1, nil},
+		{"workshop", 1, nil},
+		{"york", 1, nil},
+		{"yorkshire", 1, nil},
+		{"yosemite", 1, nil},
+		{"youth", 1, nil},
+		{"zoological", 1, nil},
+		{"zoology", 1, nil},
+		{"иком", 1, nil},
+		{"ירושלים", 1, nil},
+	},
+	},
+	{"mv", 1, []Node{
+		{"aero", 1, nil},
+		{"biz", 1, nil},
+		{"com", 1, nil},
+		{"coop", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"int", 1, nil},
+		{"mil", 1, nil},
+		{"museum", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"pro", 1, nil},
+	},
+	},
+	{"mw", 1, []Node{
+		{"ac", 1, nil},
+		{"biz", 1, nil},
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"coop", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"int", 1, nil},
+		{"museum", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"mx", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gob", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"my", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"mz", 3, nil},
+	{"na", 1, []Node{
+		{"ca", 1, nil},
+		{"cc", 1, nil},
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"dr", 1, nil},
+		{"in", 1, nil},
+		{"info", 1, nil},
+		{"mobi", 1, nil},
+		{"mx", 1, nil},
+		{"name", 1, nil},
+		{"or", 1, nil},
+		{"org", 1, nil},
+		{"pro", 1, nil},
+		{"school", 1, nil},
+		{"tv", 1, nil},
+		{"us", 1, nil},
+		{"ws", 1, nil},
+	},
+	},
+	{"name", 1, []Node{
+		{"her", 0, []Node{
+			{"forgot", 1, nil},
+		},
+		},
+		{"his", 0, []Node{
+			{"forgot", 1, nil},
+		},
+		},
+	},
+	},
+	{"nc", 1, []Node{
+		{"asso", 1, nil},
+	},
+	},
+	{"ne", 1, nil},
+	{"net", 1, []Node{
+		{"at-band-camp", 1, nil},
+		{"blogdns", 1, nil},
+		{"broke-it", 1, nil},
+		{"buyshouses", 1, nil},
+		{"dnsalias", 1, nil},
+		{"dnsdojo", 1, nil},
+		{"does-it", 1, nil},
+		{"dontexist", 1, nil},
+		{"dynalias", 1, nil},
+		{"dynathome", 1, nil},
+		{"endofinternet", 1, nil},
+		{"from-az", 1, nil},
+		{"from-co", 1, nil},
+		{"from-la", 1, nil},
+		{"from-ny", 1, nil},
+		{"gb", 1, nil},
+		{"gets-it", 1, nil},
+		{"ham-radio-op", 1, nil},
+		{"homeftp", 1, nil},
+		{"homeip", 1, nil},
+		{"homelinux", 1, nil},
+		{"homeunix", 1, nil},
+		{"hu", 1, nil},
+		{"in-the-band", 1, nil},
+		{"is-a-chef", 1, nil},
+		{"is-a-geek", 1, nil},
+		{"isa-geek", 1, nil},
+		{"jp", 1, nil},
+		{"kicks-ass", 1, nil},
+		{"office-on-the", 1, nil},
+		{"podzone", 1, nil},
+		{"scrapper-site", 1, nil},
+		{"se", 1, nil},
+		{"selfip", 1, nil},
+		{"sells-it", 1, nil},
+		{"servebbs", 1, nil},
+		{"serveftp", 1, nil},
+		{"thruhere", 1, nil},
+		{"uk", 1, nil},
+		{"webhop", 1, nil},
+		{"za", 1, nil},
+	},
+	},
+	{"nf", 1, []Node{
+		{"arts", 1, nil},
+		{"com", 1, nil},
+		{"firm", 1, nil},
+		{"info", 1, nil},
+		{"net", 1, nil},
+		{"other", 1, nil},
+		{"per", 1, nil},
+		{"rec", 1, nil},
+		{"store", 1, nil},
+		{"web", 1, nil},
+	},
+	},
+	{"ng", 0, []Node{
+		{"ac", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ni", 3, nil},
+	{"nl", 1, []Node{
+		{"bv", 1, nil},
+		{"co", 1, nil},
+	},
+	},
+	{"no", 1, []Node{
+		{"aa", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"aarborte", 1, nil},
+		{"aejrie", 1, nil},
+		{"afjord", 1, nil},
+		{"agdenes", 1, nil},
+		{"ah", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"akershus", 0, []Node{
+			{"nes", 1, nil},
+		},
+		},
+		{"aknoluokta", 1, nil},
+		{"akrehamn", 1, nil},
+		{"al", 1, nil},
+		{"alaheadju", 1, nil},
+		{"alesund", 1, nil},
+		{"algard", 1, nil},
+		{"alstahaug", 1, nil},
+		{"alta", 1, nil},
+		{"alvdal", 1, nil},
+		{"amli", 1, nil},
+		{"amot", 1, nil},
+		{"andasuolo", 1, nil},
+		{"andebu", 1, nil},
+		{"andoy", 1, nil},
+		{"andøy", 1, nil},
+		{"ardal", 1, nil},
+		{"aremark", 1, nil},
+		{"arendal", 1, nil},
+		{"arna", 1, nil},
+		{"aseral", 1, nil},
+		{"asker", 1, nil},
+		{"askim", 1, nil},
+		{"askoy", 1, nil},
+		{"askvoll", 1, nil},
+		{"askøy", 1, nil},
+		{"asnes", 1, nil},
+		{"audnedaln", 1, nil},
+		{"aukra", 1, nil},
+		{"aure", 1, nil},
+		{"aurland", 1, nil},
+		{"aurskog-holand", 1, nil},
+		{"aurskog-høland", 1, nil},
+		{"austevoll", 1, nil},
+		{"austrheim", 1, nil},
+		{"averoy", 1, nil},
+		{"averøy", 1, nil},
+		{"badaddja", 1, nil},
+		{"bahcavuotna", 1, nil},
+		{"bahccavuotna", 1, nil},
+		{"baidar", 1, nil},
+		{"bajddar", 1, nil},
+		{"balat", 1, nil},
+		{"balestrand", 1, nil},
+		{"ballangen", 1, nil},
+		{"balsfjord", 1, nil},
+		{"bamble", 1, nil},
+		{"bardu", 1, nil},
+		{"barum", 1, nil},
+		{"batsfjord", 1, nil},
+		{"bearalvahki", 1, nil},
+		{"bearalváhki", 1, nil},
+		{"beardu", 1, nil},
+		{"beiarn", 1, nil},
+		{"berg", 1, nil},
+		{"bergen", 1, nil},
+		{"berlevag", 1, nil},
+		{"berlevåg", 1, nil},
+		{"bievat", 1, nil},
+		{"bievát", 1, nil},
+		{"bindal", 1, nil},
+		{"birkenes", 1, nil},
+		{"bjarkoy", 1, nil},
+		{"bjarkøy", 1, nil},
+		{"bjerkreim", 1, nil},
+		{"bjugn", 1, nil},
+		{"bodo", 1, nil},
+		{"bodø", 1, nil},
+		{"bokn", 1, nil},
+		{"bomlo", 1, nil},
+		{"bremanger", 1, nil},
+		{"bronnoy", 1, nil},
+		{"bronnoysund", 1, nil},
+		{"brumunddal", 1, nil},
+		{"bryne", 1, nil},
+		{"brønnøy", 1, nil},
+		{"brønnøysund", 1, nil},
+		{"bu", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"budejju", 1, nil},
+		{"buskerud", 0, []Node{
+			{"nes", 1, nil},
+		},
+		},
+		{"bygland", 1, nil},
+		{"bykle", 1, nil},
+		{"báhcavuotna", 1, nil},
+		{"báhccavuotna", 1, nil},
+		{"báidár", 1, nil},
+		{"bájddar", 1, nil},
+		{"bálát", 1, nil},
+		{"bådåddjå", 1, nil},
+		{"båtsfjord", 1, nil},
+		{"bærum", 1, nil},
+		{"bømlo", 1, nil},
+		{"cahcesuolo", 1, nil},
+		{"co", 1, nil},
+		{"davvenjarga", 1, nil},
+		{"davvenjárga", 1, nil},
+		{"davvesiida", 1, nil},
+		{"deatnu", 1, nil},
+		{"dep", 1, nil},
+		{"dielddanuorri", 1, nil},
+		{"divtasvuodna", 1, nil},
+		{"divttasvuotna", 1, nil},
+		{"donna", 1, nil},
+		{"dovre", 1, nil},
+		{"drammen", 1, nil},
+		{"drangedal", 1, nil},
+		{"drobak", 1, nil},
+		{"drøbak", 1, nil},
+		{"dyroy", 1, nil},
+		{"dyrøy", 1, nil},
+		{"dønna", 1, nil},
+		{"egersund", 1, nil},
+		{"eid", 1, nil},
+		{"eidfjord", 1, nil},
+		{"eidsberg", 1, nil},
+		{"eidskog", 1, nil},
+		{"eidsvoll", 1, nil},
+		{"eigersund", 1, nil},
+		{"elverum", 1, nil},
+		{"enebakk", 1, nil},
+		{"engerdal", 1, nil},
+		{"etne", 1, nil},
+		{"etnedal", 1, nil},
+		{"evenassi", 1, nil},
+		{"evenes", 1, nil},
+		{"evenášši", 1, nil},
+		{"evje-og-hornnes", 1, nil},
+		{"farsund", 1, nil},
+		{"fauske", 1, nil},
+		{"fedje", 1, nil},
+		{"fet", 1, nil},
+		{"fetsund", 1, nil},
+		{"fhs", 1, nil},
+		{"finnoy", 1, nil},
+		{"finnøy", 1, nil},
+		{"fitjar", 1, nil},
+		{"fjaler", 1, nil},
+		{"fjell", 1, nil},
+		{"fla", 1, nil},
+		{"flakstad", 1, nil},
+		{"flatanger", 1, nil},
+		{"flekkefjord", 1, nil},
+		{"flesberg", 1, nil},
+		{"flora", 1, nil},
+		{"floro", 1, nil},
+		{"florø", 1, nil},
+		{"flå", 1, nil},
+		{"fm", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"folkebibl", 1, nil},
+		{"folldal", 1, nil},
+		{"forde", 1, nil},
+		{"forsand", 1, nil},
+		{"fosnes", 1, nil},
+		{"frana", 1, nil},
+		{"fredrikstad", 1, nil},
+		{"frei", 1, nil},
+		{"frogn", 1, nil},
+		{"froland", 1, nil},
+		{"frosta", 1, nil},
+		{"froya", 1, nil},
+		{"fræna", 1, nil},
+		{"frøya", 1, nil},
+		{"fuoisku", 1, nil},
+		{"fuossko", 1, nil},
+		{"fusa", 1, nil},
+		{"fylkesbibl", 1, nil},
+		{"fyresdal", 1, nil},
+		{"førde", 1, nil},
+		{"gaivuotna", 1, nil},
+		{"galsa", 1, nil},
+		{"gamvik", 1, nil},
+		{"gangaviika", 1, nil},
+		{"gaular", 1, nil},
+		{"gausdal", 1, nil},
+		{"giehtavuoatna", 1, nil},
+		{"gildeskal", 1, nil},
+		{"gildeskål", 1, nil},
+		{"giske", 1, nil},
+		{"gjemnes", 1, nil},
+		{"gjerdrum", 1, nil},
+		{"gjerstad", 1, nil},
+		{"gjesdal", 1, nil},
+		{"gjovik", 1, nil},
+		{"gjøvik", 1, nil},
+		{"gloppen", 1, nil},
+		{"gol", 1, nil},
+		{"gran", 1, nil},
+		{"grane", 1, nil},
+		{"granvin", 1, nil},
+		{"gratangen", 1, nil},
+		{"grimstad", 1, nil},
+		{"grong", 1, nil},
+		{"grue", 1, nil},
+		{"gulen", 1, nil},
+		{"guovdageaidnu", 1, nil},
+		{"gáivuotna", 1, nil},
+		{"gálsá", 1, nil},
+		{"gáŋgaviika", 1, nil},
+		{"ha", 1, nil},
+		{"habmer", 1, nil},
+		{"hadsel", 1, nil},
+		{"hagebostad", 1, nil},
+		{"halden", 1, nil},
+		{"halsa", 1, nil},
+		{"hamar", 1, nil},
+		{"hamaroy", 1, nil},
+		{"hammarfeasta", 1, nil},
+		{"hammerfest", 1, nil},
+		{"hapmir", 1, nil},
+		{"haram", 1, nil},
+		{"hareid", 1, nil},
+		{"harstad", 1, nil},
+		{"hasvik", 1, nil},
+		{"hattfjelldal", 1, nil},
+		{"haugesund", 1, nil},
+		{"hedmark", 0, []Node{
+			{"os", 1, nil},
+			{"valer", 1, nil},
+			{"våler", 1, nil},
+		},
+		},
+		{"hemne", 1, nil},
+		{"hemnes", 1, nil},
+		{"hemsedal", 1, nil},
+		{"herad", 1, nil},
+		{"hitra", 1, nil},
+		{"hjartdal", 1, nil},
+		{"hjelmeland", 1, nil},
+		{"hl", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"hm", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"hobol", 1, nil},
+		{"hobøl", 1, nil},
+		{"hof", 1, nil},
+		{"hokksund", 1, nil},
+		{"hol", 1, nil},
+		{"hole", 1, nil},
+		{"holmestrand", 1, nil},
+		{"holtalen", 1, nil},
+		{"holtålen", 1, nil},
+		{"honefoss", 1, nil},
+		{"hordaland", 0, []Node{
+			{"os", 1, nil},
+		},
+		},
+		{"hornindal", 1, nil},
+		{"horten", 1, nil},
+		{"hoyanger", 1, nil},
+		{"hoylandet", 1, nil},
+		{"hurdal", 1, nil},
+		{"hurum", 1, nil},
+		{"hvaler", 1, nil},
+		{"hyllestad", 1, nil},
+		{"hábmer", 1, nil},
+		{"hámmárfeasta", 1, nil},
+		{"hápmir", 1, nil},
+		{"hå", 1, nil},
+		{"hægebostad", 1, nil},
+		{"hønefoss", 1, nil},
+		{"høyanger", 1, nil},
+		{"høylandet", 1, nil},
+		{"ibestad", 1, nil},
+		{"idrett", 1, nil},
+		{"inderoy", 1, nil},
+		{"inderøy", 1, nil},
+		{"iveland", 1, nil},
+		{"ivgu", 1, nil},
+		{"jan-mayen", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"jessheim", 1, nil},
+		{"jevnaker", 1, nil},
+		{"jolster", 1, nil},
+		{"jondal", 1, nil},
+		{"jorpeland", 1, nil},
+		{"jølster", 1, nil},
+		{"jørpeland", 1, nil},
+		{"kafjord", 1, nil},
+		{"karasjohka", 1, nil},
+		{"karasjok", 1, nil},
+		{"karlsoy", 1, nil},
+		{"karmoy", 1, nil},
+		{"karmøy", 1, nil},
+		{"kautokeino", 1, nil},
+		{"kirkenes", 1, nil},
+		{"klabu", 1, nil},
+		{"klepp", 1, nil},
+		{"klæbu", 1, nil},
+		{"kommune", 1, nil},
+		{"kongsberg", 1, nil},
+		{"kongsvinger", 1, nil},
+		{"kopervik", 1, nil},
+		{"kraanghke", 1, nil},
+		{"kragero", 1, nil},
+		{"kragerø", 1, nil},
+		{"kristiansand", 1, nil},
+		{"kristiansund", 1, nil},
+		{"krodsherad", 1, nil},
+		{"krokstadelva", 1, nil},
+		{"kråanghke", 1, nil},
+		{"krødsherad", 1, nil},
+		{"kvafjord", 1, nil},
+		{"kvalsund", 1, nil},
+		{"kvam", 1, nil},
+		{"kvanangen", 1, nil},
+		{"kvinesdal", 1, nil},
+		{"kvinnherad", 1, nil},
+		{"kviteseid", 1, nil},
+		{"kvitsoy", 1, nil},
+		{"kvitsøy", 1, nil},
+		{"kvæfjord", 1, nil},
+		{"kvænangen", 1, nil},
+		{"kárášjohka", 1, nil},
+		{"kåfjord", 1, nil},
+		{"laakesvuemie", 1, nil},
+		{"lahppi", 1, nil},
+		{"langevag", 1, nil},
+		{"langevåg", 1, nil},
+		{"lardal", 1, nil},
+		{"larvik", 1, nil},
+		{"lavagis", 1, nil},
+		{"lavangen", 1, nil},
+		{"leangaviika", 1, nil},
+		{"leaŋgaviika", 1, nil},
+		{"lebesby", 1, nil},
+		{"leikanger", 1, nil},
+		{"leirfjord", 1, nil},
+		{"leirvik", 1, nil},
+		{"leka", 1, nil},
+		{"leksvik", 1, nil},
+		{"lenvik", 1, nil},
+		{"lerdal", 1, nil},
+		{"lesja", 1, nil},
+		{"levanger", 1, nil},
+		{"lier", 1, nil},
+		{"lierne", 1, nil},
+		{"lillehammer", 1, nil},
+		{"lillesand", 1, nil},
+		{"lindas", 1, nil},
+		{"lindesnes", 1, nil},
+		{"lindås", 1, nil},
+		{"loabat", 1, nil},
+		{"loabát", 1, nil},
+		{"lodingen", 1, nil},
+		{"lom", 1, nil},
+		{"loppa", 1, nil},
+		{"lorenskog", 1, nil},
+		{"loten", 1, nil},
+		{"lund", 1, nil},
+		{"lunner", 1, nil},
+		{"luroy", 1, nil},
+		{"lurøy", 1, nil},
+		{"luster", 1, nil},
+		{"lyngdal", 1, nil},
+		{"lyngen", 1, nil},
+		{"láhppi", 1, nil},
+		{"lærdal", 1, nil},
+		{"lødingen", 1, nil},
+		{"lørenskog", 1, nil},
+		{"løten", 1, nil},
+		{"malatvuopmi", 1, nil},
+		{"malselv", 1, nil},
+		{"malvik", 1, nil},
+		{"mandal", 1, nil},
+		{"marker", 1, nil},
+		{"marnardal", 1, nil},
+		{"masfjorden", 1, nil},
+		{"masoy", 1, nil},
+		{"matta-varjjat", 1, nil},
+		{"meland", 1, nil},
+		{"meldal", 1, nil},
+		{"melhus", 1, nil},
+		{"meloy", 1, nil},
+		{"meløy", 1, nil},
+		{"meraker", 1, nil},
+		{"meråker", 1, nil},
+		{"midsund", 1, nil},
+		{"midtre-gauldal", 1, nil},
+		{"mil", 1, nil},
+		{"mjondalen", 1, nil},
+		{"mjøndalen", 1, nil},
+		{"mo-i-rana", 1, nil},
+		{"moareke", 1, nil},
+		{"modalen", 1, nil},
+		{"modum", 1, nil},
+		{"molde", 1, nil},
+		{"more-og-romsdal", 0, []Node{
+			{"heroy", 1, nil},
+			{"sande", 1, nil},
+		},
+		},
+		{"mosjoen", 1, nil},
+		{"mosjøen", 1, nil},
+		{"moskenes", 1, nil},
+		{"moss", 1, nil},
+		{"mosvik", 1, nil},
+		{"moåreke", 1, nil},
+		{"mr", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"muosat", 1, nil},
+		{"muosát", 1, nil},
+		{"museum", 1, nil},
+		{"málatvuopmi", 1, nil},
+		{"mátta-várjjat", 1, nil},
+		{"målselv", 1, nil},
+		{"måsøy", 1, nil},
+		{"møre-og-romsdal", 0, []Node{
+			{"herøy", 1, nil},
+			{"sande", 1, nil},
+		},
+		},
+		{"naamesjevuemie", 1, nil},
+		{"namdalseid", 1, nil},
+		{"namsos", 1, nil},
+		{"namsskogan", 1, nil},
+		{"nannestad", 1, nil},
+		{"naroy", 1, nil},
+		{"narviika", 1, nil},
+		{"narvik", 1, nil},
+		{"naustdal", 1, nil},
+		{"navuotna", 1, nil},
+		{"nedre-eiker", 1, nil},
+		{"nesna", 1, nil},
+		{"nesodden", 1, nil},
+		{"nesoddtangen", 1, nil},
+		{"nesseby", 1, nil},
+		{"nesset", 1, nil},
+		{"nissedal", 1, nil},
+		{"nittedal", 1, nil},
+		{"nl", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"nord-aurdal", 1, nil},
+		{"nord-fron", 1, nil},
+		{"nord-odal", 1, nil},
+		{"norddal", 1, nil},
+		{"nordkapp", 1, nil},
+		{"nordland", 0, []Node{
+			{"bo", 1, nil},
+			{"bø", 1, nil},
+			{"heroy", 1, nil},
+			{"herøy", 1, nil},
+		},
+		},
+		{"nordre-land", 1, nil},
+		{"nordreisa", 1, nil},
+		{"nore-og-uvdal", 1, nil},
+		{"notodden", 1, nil},
+		{"notteroy", 1, nil},
+		{"nt", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"návuotna", 1, nil},
+		{"nååmesjevuemie", 1, nil},
+		{"nærøy", 1, nil},
+		{"nøtterøy", 1, nil},
+		{"odda", 1, nil},
+		{"of", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"oksnes", 1, nil},
+		{"ol", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"omasvuotna", 1, nil},
+		{"oppdal", 1, nil},
+		{"oppegard", 1, nil},
+		{"oppegård", 1, nil},
+		{"orkanger", 1, nil},
+		{"orkdal", 1, nil},
+		{"orland", 1, nil},
+		{"orskog", 1, nil},
+		{"orsta", 1, nil},
+		{"osen", 1, nil},
+		{"oslo", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"osoyro", 1, nil},
+		{"osteroy", 1, nil},
+		{"osterøy", 1, nil},
+		{"ostfold", 0, []Node{
+			{"valer", 1, nil},
+		},
+		},
+		{"ostre-toten", 1, nil},
+		{"osøyro", 1, nil},
+		{"overhalla", 1, nil},
+		{"ovre-eiker", 1, nil},
+		{"oyer", 1, nil},
+		{"oygarden", 1, nil},
+		{"oystre-slidre", 1, nil},
+		{"porsanger", 1, nil},
+		{"porsangu", 1, nil},
+		{"porsgrunn", 1, nil},
+		{"porsáŋgu", 1, nil},
+		{"priv", 1, nil},
+		{"rade", 1, nil},
+		{"radoy", 1, nil},
+		{"radøy", 1, nil},
+		{"rahkkeravju", 1, nil},
+		{"raholt", 1, nil},
+		{"raisa", 1, nil},
+		{"rakkestad", 1, nil},
+		{"ralingen", 1, nil},
+		{"rana", 1, nil},
+		{"randaberg", 1, nil},
+		{"rauma", 1, nil},
+		{"rendalen", 1, nil},
+		{"rennebu", 1, nil},
+		{"rennesoy", 1, nil},
+		{"rennesøy", 1, nil},
+		{"rindal", 1, nil},
+		{"ringebu", 1, nil},
+		{"ringerike", 1, nil},
+		{"ringsaker", 1, nil},
+		{"risor", 1, nil},
+		{"rissa", 1, nil},
+		{"risør", 1, nil},
+		{"rl", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"roan", 1, nil},
+		{"rodoy", 1, nil},
+		{"rollag", 1, nil},
+		{"romsa", 1, nil},
+		{"romskog", 1, nil},
+		{"roros", 1, nil},
+		{"rost", 1, nil},
+		{"royken", 1, nil},
+		{"royrvik", 1, nil},
+		{"ruovat", 1, nil},
+		{"rygge", 1, nil},
+		{"ráhkkerávju", 1, nil},
+		{"ráisa", 1, nil},
+		{"råde", 1, nil},
+		{"råholt", 1, nil},
+		{"rælingen", 1, nil},
+		{"rødøy", 1, nil},
+		{"rømskog", 1, nil},
+		{"røros", 1, nil},
+		{"røst", 1, nil},
+		{"røyken", 1, nil},
+		{"røyrvik", 1, nil},
+		{"salangen", 1, nil},
+		{"salat", 1, nil},
+		{"saltdal", 1, nil},
+		{"samnanger", 1, nil},
+		{"sandefjord", 1, nil},
+		{"sandnes", 1, nil},
+		{"sandnessjoen", 1, nil},
+		{"sandnessjøen", 1, nil},
+		{"sandoy", 1, nil},
+		{"sandøy", 1, nil},
+		{"sarpsborg", 1, nil},
+		{"sauda", 1, nil},
+		{"sauherad", 1, nil},
+		{"sel", 1, nil},
+		{"selbu", 1, nil},
+		{"selje", 1, nil},
+		{"seljord", 1, nil},
+		{"sf", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"siellak", 1, nil},
+		{"sigdal", 1, nil},
+		{"siljan", 1, nil},
+		{"sirdal", 1, nil},
+		{"skanit", 1, nil},
+		{"skanland", 1, nil},
+		{"skaun", 1, nil},
+		{"skedsmo", 1, nil},
+		{"skedsmokorset", 1, nil},
+		{"ski", 1, nil},
+		{"skien", 1, nil},
+		{"skierva", 1, nil},
+		{"skiervá", 1, nil},
+		{"skiptvet", 1, nil},
+		{"skjak", 1, nil},
+		{"skjervoy", 1, nil},
+		{"skjervøy", 1, nil},
+		{"skjåk", 1, nil},
+		{"skodje", 1, nil},
+		{"skánit", 1, nil},
+		{"skånland", 1, nil},
+		{"slattum", 1, nil},
+		{"smola", 1, nil},
+		{"smøla", 1, nil},
+		{"snaase", 1, nil},
+		{"snasa", 1, nil},
+		{"snillfjord", 1, nil},
+		{"snoasa", 1, nil},
+		{"snåase", 1, nil},
+		{"snåsa", 1, nil},
+		{"sogndal", 1, nil},
+		{"sogne", 1, nil},
+		{"sokndal", 1, nil},
+		{"sola", 1, nil},
+		{"solund", 1, nil},
+		{"somna", 1, nil},
+		{"sondre-land", 1, nil},
+		{"songdalen", 1, nil},
+		{"sor-aurdal", 1, nil},
+		{"sor-fron", 1, nil},
+		{"sor-odal", 1, nil},
+		{"sor-varanger", 1, nil},
+		{"sorfold", 1, nil},
+		{"sorreisa", 1, nil},
+		{"sortland", 1, nil},
+		{"sorum", 1, nil},
+		{"spjelkavik", 1, nil},
+		{"spydeberg", 1, nil},
+		{"st", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"stange", 1, nil},
+		{"stat", 1, nil},
+		{"stathelle", 1, nil},
+		{"stavanger", 1, nil},
+		{"stavern", 1, nil},
+		{"steigen", 1, nil},
+		{"steinkjer", 1, nil},
+		{"stjordal", 1, nil},
+		{"stjordalshalsen", 1, nil},
+		{"stjørdal", 1, nil},
+		{"stjørdalshalsen", 1, nil},
+		{"stokke", 1, nil},
+		{"stor-elvdal", 1, nil},
+		{"stord", 1, nil},
+		{"stordal", 1, nil},
+		{"storfjord", 1, nil},
+		{"strand", 1, nil},
+		{"stranda", 1, nil},
+		{"stryn", 1, nil},
+		{"sula", 1, nil},
+		{"suldal", 1, nil},
+		{"sund", 1, nil},
+		{"sunndal", 1, nil},
+		{"surnadal", 1, nil},
+		{"svalbard", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"sveio", 1, nil},
+		{"svelvik", 1, nil},
+		{"sykkylven", 1, nil},
+		{"sálat", 1, nil},
+		{"sálát", 1, nil},
+		{"søgne", 1, nil},
+		{"sømna", 1, nil},
+		{"søndre-land", 1, nil},
+		{"sør-aurdal", 1, nil},
+		{"sør-fron", 1, nil},
+		{"sør-odal", 1, nil},
+		{"sør-varanger", 1, nil},
+		{"sørfold", 1, nil},
+		{"sørreisa", 1, nil},
+		{"sørum", 1, nil},
+		{"tana", 1, nil},
+		{"tananger", 1, nil},
+		{"telemark", 0, []Node{
+			{"bo", 1, nil},
+			{"bø", 1, nil},
+		},
+		},
+		{"time", 1, nil},
+		{"tingvoll", 1, nil},
+		{"tinn", 1, nil},
+		{"tjeldsund", 1, nil},
+		{"tjome", 1, nil},
+		{"tjøme", 1, nil},
+		{"tm", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"tokke", 1, nil},
+		{"tolga", 1, nil},
+		{"tonsberg", 1, nil},
+		{"torsken", 1, nil},
+		{"tr", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"trana", 1, nil},
+		{"tranby", 1, nil},
+		{"tranoy", 1, nil},
+		{"tranøy", 1, nil},
+		{"troandin", 1, nil},
+		{"trogstad", 1, nil},
+		{"tromsa", 1, nil},
+		{"tromso", 1, nil},
+		{"tromsø", 1, nil},
+		{"trondheim", 1, nil},
+		{"trysil", 1, nil},
+		{"træna", 1, nil},
+		{"trøgstad", 1, nil},
+		{"tvedestrand", 1, nil},
+		{"tydal", 1, nil},
+		{"tynset", 1, nil},
+		{"tysfjord", 1, nil},
+		{"tysnes", 1, nil},
+		{"tysvar", 1, nil},
+		{"tysvær", 1, nil},
+		{"tønsberg", 1, nil},
+		{"ullensaker", 1, nil},
+		{"ullensvang", 1, nil},
+		{"ulvik", 1, nil},
+		{"unjarga", 1, nil},
+		{"unjárga", 1, nil},
+		{"utsira", 1, nil},
+		{"va", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"vaapste", 1, nil},
+		{"vadso", 1, nil},
+		{"vadsø", 1, nil},
+		{"vaga", 1, nil},
+		{"vagan", 1, nil},
+		{"vagsoy", 1, nil},
+		{"vaksdal", 1, nil},
+		{"valle", 1, nil},
+		{"vang", 1, nil},
+		{"vanylven", 1, nil},
+		{"vardo", 1, nil},
+		{"vardø", 1, nil},
+		{"varggat", 1, nil},
+		{"varoy", 1, nil},
+		{"vefsn", 1, nil},
+		{"vega", 1, nil},
+		{"vegarshei", 1, nil},
+		{"vegårshei", 1, nil},
+		{"vennesla", 1, nil},
+		{"verdal", 1, nil},
+		{"verran", 1, nil},
+		{"vestby", 1, nil},
+		{"vestfold", 0, []Node{
+			{"sande", 1, nil},
+		},
+		},
+		{"vestnes", 1, nil},
+		{"vestre-slidre", 1, nil},
+		{"vestre-toten", 1, nil},
+		{"vestvagoy", 1, nil},
+		{"vestvågøy", 1, nil},
+		{"vevelstad", 1, nil},
+		{"vf", 1, []Node{
+			{"gs", 1, nil},
+		},
+		},
+		{"vgs", 1, nil},
+		{"vik", 1, nil},
+		{"vikna", 1, nil},
+		{"vindafjord", 1, nil},
+		{"voagat", 1, nil},
+		{"volda", 1, nil},
+		{"voss", 1, nil},
+		{"vossevangen", 1, nil},
+		{"várggát", 1, nil},
+		{"vågan", 1, nil},
+		{"vågsøy", 1, nil},
+		{"vågå", 1, nil},
+		{"værøy", 1, nil},
+		{"ákŋoluokta", 1, nil},
+		{"álaheadju", 1, nil},
+		{"áltá", 1, nil},
+		{"åfjord", 1, nil},
+		{"åkrehamn", 1, nil},
+		{"ål", 1, nil},
+		{"ålesund", 1, nil},
+		{"ålgård", 1, nil},
+		{"åmli", 1, nil},
+		{"åmot", 1, nil},
+		{"årdal", 1, nil},
+		{"ås", 1, nil},
+		{"åseral", 1, nil},
+		{"åsnes", 1, nil},
+		{"øksnes", 1, nil},
+		{"ørland", 1, nil},
+		{"ørskog", 1, nil},
+		{"ørsta", 1, nil},
+		{"østfold", 0, []Node{
+			{"våler", 1, nil},
+		},
+		},
+		{"østre-toten", 1, nil},
+		{"øvre-eiker", 1, nil},
+		{"øyer", 1, nil},
+		{"øygarden", 1, nil},
+		{"øystre-slidre", 1, nil},
+		{"čáhcesuolo", 1, nil},
+	},
+	},
+	{"np", 3, nil},
+	{"nr", 1, []Node{
+		{"biz", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"nu", 1, []Node{
+		{"merseine", 1, nil},
+		{"mine", 1, nil},
+		{"shacknet", 1, nil},
+	},
+	},
+	{"nz", 3, nil},
+	{"om", 3, []Node{
+		{"mediaphone", 2, nil},
+		{"nawras", 2, nil},
+		{"nawrastelecom", 2, nil},
+		{"omanmobile", 2, nil},
+		{"omanpost", 2, nil},
+		{"omantel", 2, nil},
+		{"rakpetroleum", 2, nil},
+		{"siemens", 2, nil},
+		{"songfest", 2, nil},
+		{"statecouncil", 2, nil},
+	},
+	},
+	{"org", 1, []Node{
+		{"ae", 1, nil},
+		{"blogdns", 1, nil},
+		{"blogsite", 1, nil},
+		{"boldlygoingnowhere", 1, nil},
+		{"dnsalias", 1, nil},
+		{"dnsdojo", 1, nil},
+		{"doesntexist", 1, nil},
+		{"dontexist", 1, nil},
+		{"doomdns", 1, nil},
+		{"dvrdns", 1, nil},
+		{"dynalias", 1, nil},
+		{"dyndns", 1, []Node{
+			{"go", 1, nil},
+			{"home", 1, nil},
+		},
+		},
+		{"endofinternet", 1, nil},
+		{"endoftheinternet", 1, nil},
+		{"from-me", 1, nil},
+		{"game-host", 1, nil},
+		{"gotdns", 1, nil},
+		{"hobby-site", 1, nil},
+		{"homedns", 1, nil},
+		{"homeftp", 1, nil},
+		{"homelinux", 1, nil},
+		{"homeunix", 1, nil},
+		{"is-a-bruinsfan", 1, nil},
+		{"is-a-candidate", 1, nil},
+		{"is-a-celticsfan", 1, nil},
+		{"is-a-chef", 1, nil},
+		{"is-a-geek", 1, nil},
+		{"is-a-knight", 1, nil},
+		{"is-a-linux-user", 1, nil},
+		{"is-a-patsfan", 1, nil},
+		{"is-a-soxfan", 1, nil},
+		{"is-found", 1, nil},
+		{"is-lost", 1, nil},
+		{"is-saved", 1, nil},
+		{"is-very-bad", 1, nil},
+		{"is-very-evil", 1, nil},
+		{"is-very-good", 1, nil},
+		{"is-very-nice", 1, nil},
+		{"is-very-sweet", 1, nil},
+		{"isa-geek", 1, nil},
+		{"kicks-ass", 1, nil},
+		{"misconfused", 1, nil},
+		{"podzone", 1, nil},
+		{"readmyblog", 1, nil},
+		{"selfip", 1, nil},
+		{"sellsyourhome", 1, nil},
+		{"servebbs", 1, nil},
+		{"serveftp", 1, nil},
+		{"servegame", 1, nil},
+		{"stuff-4-sale", 1, nil},
+		{"us", 1, nil},
+		{"webhop", 1, nil},
+		{"za", 1, nil},
+	},
+	},
+	{"pa", 1, []Node{
+		{"abo", 1, nil},
+		{"ac", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gob", 1, nil},
+		{"ing", 1, nil},
+		{"med", 1, nil},
+		{"net", 1, nil},
+		{"nom", 1, nil},
+		{"org", 1, nil},
+		{"sld", 1, nil},
+	},
+	},
+	{"pe", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gob", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"nom", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"pf", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"pg", 3, nil},
+	{"ph", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"i", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"ngo", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"pk", 1, []Node{
+		{"biz", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"fam", 1, nil},
+		{"gob", 1, nil},
+		{"gok", 1, nil},
+		{"gon", 1, nil},
+		{"gop", 1, nil},
+		{"gos", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"web", 1, nil},
+	},
+	},
+	{"pl", 1, []Node{
+		{"6bone", 1, nil},
+		{"agro", 1, nil},
+		{"aid", 1, nil},
+		{"art", 1, nil},
+		{"atm", 1, nil},
+		{"augustow", 1, nil},
+		{"auto", 1, nil},
+		{"babia-gora", 1, nil},
+		{"bedzin", 1, nil},
+		{"beskidy", 1, nil},
+		{"bialowieza", 1, nil},
+		{"bialystok", 1, nil},
+		{"bielawa", 1, nil},
+		{"bieszczady", 1, nil},
+		{"biz", 1, nil},
+		{"boleslawiec", 1, nil},
+		{"bydgoszcz", 1, nil},
+		{"bytom", 1, nil},
+		{"cieszyn", 1, nil},
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"czeladz", 1, nil},
+		{"czest", 1, nil},
+		{"dlugoleka", 1, nil},
+		{"edu", 1, nil},
+		{"elblag", 1, nil},
+		{"elk", 1, nil},
+		{"gda", 1, nil},
+		{"gdansk", 1, nil},
+		{"gdynia", 1, nil},
+		{"gliwice", 1, nil},
+		{"glogow", 1, nil},
+		{"gmina", 1, nil},
+		{"gniezno", 1, nil},
+		{"gorlice", 1, nil},
+		{"gov", 1, []Node{
+			{"pa", 1, nil},
+			{"po", 1, nil},
+			{"so", 1, nil},
+			{"sr", 1, nil},
+			{"starostwo", 1, nil},
+			{"ug", 1, nil},
+			{"um", 1, nil},
+			{"upow", 1, nil},
+			{"uw", 1, nil},
+		},
+		},
+		{"grajewo", 1, nil},
+		{"gsm", 1, nil},
+		{"ilawa", 1, nil},
+		{"info", 1, nil},
+		{"irc", 1, nil},
+		{"jaworzno", 1, nil},
+		{"jelenia-gora", 1, nil},
+		{"jgora", 1, nil},
+		{"kalisz", 1, nil},
+		{"karpacz", 1, nil},
+		{"kartuzy", 1, nil},
+		{"kaszuby", 1, nil},
+		{"katowice", 1, nil},
+		{"kazimierz-dolny", 1, nil},
+		{"kepno", 1, nil},
+		{"ketrzyn", 1, nil},
+		{"klodzko", 1, nil},
+		{"kobierzyce", 1, nil},
+		{"kolobrzeg", 1, nil},
+		{"konin", 1, nil},
+		{"konskowola", 1, nil},
+		{"krakow", 1, nil},
+		{"kutno", 1, nil},
+		{"lapy", 1, nil},
+		{"lebork", 1, nil},
+		{"legnica", 1, nil},
+		{"lezajsk", 1, nil},
+		{"limanowa", 1, nil},
+		{"lomza", 1, nil},
+		{"lowicz", 1, nil},
+		{"lubin", 1, nil},
+		{"lukow", 1, nil},
+		{"mail", 1, nil},
+		{"malbork", 1, nil},
+		{"malopolska", 1, nil},
+		{"mazowsze", 1, nil},
+		{"mazury", 1, nil},
+		{"mbone", 1, nil},
+		{"med", 1, nil},
+		{"media", 1, nil},
+		{"miasta", 1, nil},
+		{"mielec", 1, nil},
+		{"mielno", 1, nil},
+		{"mil", 1, nil},
+		{"mragowo", 1, nil},
+		{"naklo", 1, nil},
+		{"net", 1, nil},
+		{"ngo", 1, nil},
+		{"nieruchomosci", 1, nil},
+		{"nom", 1, nil},
+		{"nowaruda", 1, nil},
+		{"nysa", 1, nil},
+		{"olawa", 1, nil},
+		{"olecko", 1, nil},
+		{"olkusz", 1, nil},
+		{"olsztyn", 1, nil},
+		{"opoczno", 1, nil},
+		{"opole", 1, nil},
+		{"org", 1, nil},
+		{"ostroda", 1, nil},
+		{"ostroleka", 1, nil},
+		{"ostrowiec", 1, nil},
+		{"ostrowwlkp", 1, nil},
+		{"pc", 1, nil},
+		{"pila", 1, nil},
+		{"pisz", 1, nil},
+		{"podhale", 1, nil},
+		{"podlasie", 1, nil},
+		{"polkowice", 1, nil},
+		{"pomorskie", 1, nil},
+		{"pomorze", 1, nil},
+		{"powiat", 1, nil},
+		{"poznan", 1, nil},
+		{"priv", 1, nil},
+		{"prochowice", 1, nil},
+		{"pruszkow", 1, nil},
+		{"przeworsk", 1, nil},
+		{"pulawy", 1, nil},
+		{"radom", 1, nil},
+		{"rawa-maz", 1, nil},
+		{"realestate", 1, nil},
+		{"rel", 1, nil},
+		{"rybnik", 1, nil},
+		{"rzeszow", 1, nil},
+		{"sanok", 1, nil},
+		{"sejny", 1, nil},
+		{"sex", 1, nil},
+		{"shop", 1, nil},
+		{"siedlce", 1, nil},
+		{"sklep", 1, nil},
+		{"skoczow", 1, nil},
+		{"slask", 1, nil},
+		{"slupsk", 1, nil},
+		{"sopot", 1, nil},
+		{"sos", 1, nil},
+		{"sosnowiec", 1, nil},
+		{"stalowa-wola", 1, nil},
+		{"starachowice", 1, nil},
+		{"stargard", 1, nil},
+		{"suwalki", 1, nil},
+		{"swidnica", 1, nil},
+		{"swiebodzin", 1, nil},
+		{"swinoujscie", 1, nil},
+		{"szczecin", 1, nil},
+		{"szczytno", 1, nil},
+		{"szkola", 1, nil},
+		{"targi", 1, nil},
+		{"tarnobrzeg", 1, nil},
+		{"tgory", 1, nil},
+		{"tm", 1, nil},
+		{"tourism", 1, nil},
+		{"travel", 1, nil},
+		{"turek", 1, nil},
+		{"turystyka", 1, nil},
+		{"tychy", 1, nil},
+		{"usenet", 1, nil},
+		{"ustka", 1, nil},
+		{"walbrzych", 1, nil},
+		{"warmia", 1, nil},
+		{"warszawa", 1, nil},
+		{"waw", 1, nil},
+		{"wegrow", 1, nil},
+		{"wielun", 1, nil},
+		{"wlocl", 1, nil},
+		{"wloclawek", 1, nil},
+		{"wodzislaw", 1, nil},
+		{"wolomin", 1, nil},
+		{"wroc", 1, nil},
+		{"wroclaw", 1, nil},
+		{"zachpomor", 1, nil},
+		{"zagan", 1, nil},
+		{"zakopane", 1, nil},
+		{"zarow", 1, nil},
+		{"zgora", 1, nil},
+		{"zgorzelec", 1, nil},
+	},
+	},
+	{"pm", 1, nil},
+	{"pn", 1, []Node{
+		{"co", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"pr", 1, []Node{
+		{"ac", 1, nil},
+		{"biz", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"est", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"isla", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"pro", 1, nil},
+		{"prof", 1, nil},
+	},
+	},
+	{"pro", 1, []Node{
+		{"aca", 1, nil},
+		{"bar", 1, nil},
+		{"cpa", 1, nil},
+		{"eng", 1, nil},
+		{"jur", 1, nil},
+		{"law", 1, nil},
+		{"med", 1, nil},
+	},
+	},
+	{"ps", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"plo", 1, nil},
+		{"sec", 1, nil},
+	},
+	},
+	{"pt", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"int", 1, nil},
+		{"net", 1, nil},
+		{"nome", 1, nil},
+		{"org", 1, nil},
+		{"publ", 1, nil},
+	},
+	},
+	{"pw", 1, []Node{
+		{"belau", 1, nil},
+		{"co", 1, nil},
+		{"ed", 1, nil},
+		{"go", 1, nil},
+		{"ne", 1, nil},
+		{"or", 1, nil},
+	},
+	},
+	{"py", 3, nil},
+	{"qa", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"sch", 1, nil},
+	},
+	},
+	{"re", 1, []Node{
+		{"asso", 1, nil},
+		{"com", 1, nil},
+		{"nom", 1, nil},
+	},
+	},
+	{"ro", 1, []Node{
+		{"arts", 1, nil},
+		{"com", 1, nil},
+		{"firm", 1, nil},
+		{"info", 1, nil},
+		{"nom", 1, nil},
+		{"nt", 1, nil},
+		{"org", 1, nil},
+		{"rec", 1, nil},
+		{"store", 1, nil},
+		{"tm", 1, nil},
+		{"www", 1, nil},
+	},
+	},
+	{"rs", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"in", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ru", 1, []Node{
+		{"ac", 1, nil},
+		{"adygeya", 1, nil},
+		{"altai", 1, nil},
+		{"amur", 1, nil},
+		{"amursk", 1, nil},
+		{"arkhangelsk", 1, nil},
+		{"astrakhan", 1, nil},
+		{"baikal", 1, nil},
+		{"bashkiria", 1, nil},
+		{"belgorod", 1, nil},
+		{"bir", 1, nil},
+		{"bryansk", 1, nil},
+		{"buryatia", 1, nil},
+		{"cbg", 1, nil},
+		{"chel", 1, nil},
+		{"chelyabinsk", 1, nil},
+		{"chita", 1, nil},
+		{"chukotka", 1, nil},
+		{"chuvashia", 1, nil},
+		{"cmw", 1, nil},
+		{"com", 1, nil},
+		{"dagestan", 1, nil},
+		{"dudinka", 1, nil},
+		{"e-burg", 1, nil},
+		{"edu", 1, nil},
+		{"fareast", 1, nil},
+		{"gov", 1, nil},
+		{"grozny", 1, nil},
+		{"int", 1, nil},
+		{"irkutsk", 1, nil},
+		{"ivanovo", 1, nil},
+		{"izhevsk", 1, nil},
+		{"jamal", 1, nil},
+		{"jar", 1, nil},
+		{"joshkar-ola", 1, nil},
+		{"k-uralsk", 1, nil},
+		{"kalmykia", 1, nil},
+		{"kaluga", 1, nil},
+		{"kamchatka", 1, nil},
+		{"karelia", 1, nil},
+		{"kazan", 1, nil},
+		{"kchr", 1, nil},
+		{"kemerovo", 1, nil},
+		{"khabarovsk", 1, nil},
+		{"khakassia", 1, nil},
+		{"khv", 1, nil},
+		{"kirov", 1, nil},
+		{"kms", 1, nil},
+		{"koenig", 1, nil},
+		{"komi", 1, nil},
+		{"kostroma", 1, nil},
+		{"krasnoyarsk", 1, nil},
+		{"kuban", 1, nil},
+		{"kurgan", 1, nil},
+		{"kursk", 1, nil},
+		{"kustanai", 1, nil},
+		{"kuzbass", 1, nil},
+		{"lipetsk", 1, nil},
+		{"magadan", 1, nil},
+		{"magnitka", 1, nil},
+		{"mari", 1, nil},
+		{"mari-el", 1, nil},
+		{"marine", 1, nil},
+		{"mil", 1, nil},
+		{"mordovia", 1, nil},
+		{"mosreg", 1, nil},
+		{"msk", 1, nil},
+		{"murmansk", 1, nil},
+		{"mytis", 1, nil},
+		{"nakhodka", 1, nil},
+		{"nalchik", 1, nil},
+		{"net", 1, nil},
+		{"nkz", 1, nil},
+		{"nnov", 1, nil},
+		{"norilsk", 1, nil},
+		{"nov", 1, nil},
+		{"novosibirsk", 1, nil},
+		{"nsk", 1, nil},
+		{"omsk", 1, nil},
+		{"orenburg", 1, nil},
+		{"org", 1, nil},
+		{"oryol", 1, nil},
+		{"oskol", 1, nil},
+		{"palana", 1, nil},
+		{"penza", 1, nil},
+		{"perm", 1, nil},
+		{"pp", 1, nil},
+		{"pskov", 1, nil},
+		{"ptz", 1, nil},
+		{"pyatigorsk", 1, nil},
+		{"rnd", 1, nil},
+		{"rubtsovsk", 1, nil},
+		{"ryazan", 1, nil},
+		{"sakhalin", 1, nil},
+		{"samara", 1, nil},
+		{"saratov", 1, nil},
+		{"simbirsk", 1, nil},
+		{"smolensk", 1, nil},
+		{"snz", 1, nil},
+		{"spb", 1, nil},
+		{"stavropol", 1, nil},
+		{"stv", 1, nil},
+		{"surgut", 1, nil},
+		{"syzran", 1, nil},
+		{"tambov", 1, nil},
+		{"tatarstan", 1, nil},
+		{"test", 1, nil},
+		{"tom", 1, nil},
+		{"tomsk", 1, nil},
+		{"tsaritsyn", 1, nil},
+		{"tsk", 1, nil},
+		{"tula", 1, nil},
+		{"tuva", 1, nil},
+		{"tver", 1, nil},
+		{"tyumen", 1, nil},
+		{"udm", 1, nil},
+		{"udmurtia", 1, nil},
+		{"ulan-ude", 1, nil},
+		{"vdonsk", 1, nil},
+		{"vladikavkaz", 1, nil},
+		{"vladimir", 1, nil},
+		{"vladivostok", 1, nil},
+		{"volgograd", 1, nil},
+		{"vologda", 1, nil},
+		{"voronezh", 1, nil},
+		{"vrn", 1, nil},
+		{"vyatka", 1, nil},
+		{"yakutia", 1, nil},
+		{"yamal", 1, nil},
+		{"yaroslavl", 1, nil},
+		{"yekaterinburg", 1, nil},
+		{"yuzhno-sakhalinsk", 1, nil},
+		{"zgrad", 1, nil},
+	},
+	},
+	{"rw", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gouv", 1, nil},
+		{"gov", 1, nil},
+		{"int", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+	},
+	},
+	{"sa", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"med", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"pub", 1, nil},
+		{"sch", 1, nil},
+	},
+	},
+	{"sb", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"sc", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"sd", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"med", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"tv", 1, nil},
+	},
+	},
+	{"se", 1, []Node{
+		{"a", 1, nil},
+		{"ac", 1, nil},
+		{"b", 1, nil},
+		{"bd", 1, nil},
+		{"brand", 1, nil},
+		{"c", 1, nil},
+		{"d", 1, nil},
+		{"e", 1, nil},
+		{"f", 1, nil},
+		{"fh", 1, nil},
+		{"fhsk", 1, nil},
+		{"fhv", 1, nil},
+		{"g", 1, nil},
+		{"h", 1, nil},
+		{"i", 1, nil},
+		{"k", 1, nil},
+		{"komforb", 1, nil},
+		{"kommunalforbund", 1, nil},
+		{"komvux", 1, nil},
+		{"l", 1, nil},
+		{"lanbib", 1, nil},
+		{"m", 1, nil},
+		{"n", 1, nil},
+		{"naturbruksgymn", 1, nil},
+		{"o", 1, nil},
+		{"org", 1, nil},
+		{"p", 1, nil},
+		{"parti", 1, nil},
+		{"pp", 1, nil},
+		{"press", 1, nil},
+		{"r", 1, nil},
+		{"s", 1, nil},
+		{"sshn", 1, nil},
+		{"t", 1, nil},
+		{"tm", 1, nil},
+		{"u", 1, nil},
+		{"w", 1, nil},
+		{"x", 1, nil},
+		{"y", 1, nil},
+		{"z", 1, nil},
+	},
+	},
+	{"sg", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"per", 1, nil},
+	},
+	},
+	{"sh", 1, []Node{
+		{"com", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"si", 1, nil},
+	{"sk", 1, nil},
+	{"sl", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"sm", 1, nil},
+	{"sn", 1, []Node{
+		{"art", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gouv", 1, nil},
+		{"org", 1, nil},
+		{"perso", 1, nil},
+		{"univ", 1, nil},
+	},
+	},
+	{"so", 1, []Node{
+		{"com", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"sr", 1, nil},
+	{"st", 1, []Node{
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"consulado", 1, nil},
+		{"edu", 1, nil},
+		{"embaixada", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"principe", 1, nil},
+		{"saotome", 1, nil},
+		{"store", 1, nil},
+	},
+	},
+	{"su", 1, nil},
+	{"sv", 3, nil},
+	{"sx", 1, []Node{
+		{"gov", 1, nil},
+	},
+	},
+	{"sy", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"sz", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"tc", 1, nil},
+	{"td", 1, nil},
+	{"tel", 1, nil},
+	{"tf", 1, nil},
+	{"tg", 1, nil},
+	{"th", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"go", 1, nil},
+		{"in", 1, nil},
+		{"mi", 1, nil},
+		{"net", 1, nil},
+		{"or", 1, nil},
+	},
+	},
+	{"tj", 1, []Node{
+		{"ac", 1, nil},
+		{"biz", 1, nil},
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"go", 1, nil},
+		{"gov", 1, nil},
+		{"int", 1, nil},
+		{"mil", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"nic", 1, nil},
+		{"org", 1, nil},
+		{"test", 1, nil},
+		{"web", 1, nil},
+	},
+	},
+	{"tk", 1, nil},
+	{"tl", 1, []Node{
+		{"gov", 1, nil},
+	},
+	},
+	{"tm", 1, []Node{
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"nom", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"tn", 1, []Node{
+		{"agrinet", 1, nil},
+		{"com", 1, nil},
+		{"defense", 1, nil},
+		{"edunet", 1, nil},
+		{"ens", 1, nil},
+		{"fin", 1, nil},
+		{"gov", 1, nil},
+		{"ind", 1, nil},
+		{"info", 1, nil},
+		{"intl", 1, nil},
+		{"mincom", 1, nil},
+		{"nat", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"perso", 1, nil},
+		{"rnrt", 1, nil},
+		{"rns", 1, nil},
+		{"rnu", 1, nil},
+		{"tourism", 1, nil},
+		{"turen", 1, nil},
+	},
+	},
+	{"to", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"tr", 3, []Node{
+		{"nc", 0, []Node{
+			{"gov", 1, nil},
+		},
+		},
+		{"nic", 2, nil},
+	},
+	},
+	{"travel", 1, nil},
+	{"tt", 1, []Node{
+		{"aero", 1, nil},
+		{"biz", 1, nil},
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"coop", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"int", 1, nil},
+		{"jobs", 1, nil},
+		{"mobi", 1, nil},
+		{"museum", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"pro", 1, nil},
+		{"travel", 1, nil},
+	},
+	},
+	{"tv", 1, []Node{
+		{"better-than", 1, nil},
+		{"dyndns", 1, nil},
+		{"on-the-web", 1, nil},
+		{"worse-than", 1, nil},
+	},
+	},
+	{"tw", 1, []Node{
+		{"club", 1, nil},
+		{"com", 1, nil},
+		{"ebiz", 1, nil},
+		{"edu", 1, nil},
+		{"game", 1, nil},
+		{"gov", 1, nil},
+		{"idv", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"商業", 1, nil},
+		{"組織", 1, nil},
+		{"網路", 1, nil},
+	},
+	},
+	{"tz", 0, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"go", 1, nil},
+		{"mil", 1, nil},
+		{"ne", 1, nil},
+		{"or", 1, nil},
+		{"sc", 1, nil},
+	},
+	},
+	{"ua", 1, []Node{
+		{"cherkassy", 1, nil},
+		{"cherkasy", 1, nil},
+		{"chernigov", 1, nil},
+		{"chernihiv", 1, nil},
+		{"chernivtsi", 1, nil},
+		{"chernovtsy", 1, nil},
+		{"ck", 1, nil},
+		{"cn", 1, nil},
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"cr", 1, nil},
+		{"crimea", 1, nil},
+		{"cv", 1, nil},
+		{"dn", 1, nil},
+		{"dnepropetrovsk", 1, nil},
+		{"dnipropetrovsk", 1, nil},
+		{"dominic", 1, nil},
+		{"donetsk", 1, nil},
+		{"dp", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"if", 1, nil},
+		{"in", 1, nil},
+		{"ivano-frankivsk", 1, nil},
+		{"kh", 1, nil},
+		{"kharkiv", 1, nil},
+		{"kharkov", 1, nil},
+		{"kherson", 1, nil},
+		{"khmelnitskiy", 1, nil},
+		{"khmelnytskyi", 1, nil},
+		{"kiev", 1, nil},
+		{"kirovograd", 1, nil},
+		{"km", 1, nil},
+		{"kr", 1, nil},
+		{"krym", 1, nil},
+		{"ks", 1, nil},
+		{"kv", 1, nil},
+		{"kyiv", 1, nil},
+		{"lg", 1, nil},
+		{"lt", 1, nil},
+		{"lugansk", 1, nil},
+		{"lutsk", 1, nil},
+		{"lv", 1, nil},
+		{"lviv", 1, nil},
+		{"mk", 1, nil},
+		{"mykolaiv", 1, nil},
+		{"net", 1, nil},
+		{"nikolaev", 1, nil},
+		{"od", 1, nil},
+		{"odesa", 1, nil},
+		{"odessa", 1, nil},
+		{"org", 1, nil},
+		{"pl", 1, nil},
+		{"poltava", 1, nil},
+		{"pp", 1, nil},
+		{"rivne", 1, nil},
+		{"rovno", 1, nil},
+		{"rv", 1, nil},
+		{"sb", 1, nil},
+		{"sebastopol", 1, nil},
+		{"sevastopol", 1, nil},
+		{"sm", 1, nil},
+		{"sumy", 1, nil},
+		{"te", 1, nil},
+		{"ternopil", 1, nil},
+		{"uz", 1, nil},
+		{"uzhgorod", 1, nil},
+		{"vinnica", 1, nil},
+		{"vinnytsia", 1, nil},
+		{"vn", 1, nil},
+		{"volyn", 1, nil},
+		{"yalta", 1, nil},
+		{"zaporizhzhe", 1, nil},
+		{"zaporizhzhia", 1, nil},
+		{"zhitomir", 1, nil},
+		{"zhytomyr", 1, nil},
+		{"zp", 1, nil},
+		{"zt", 1, nil},
+	},
+	},
+	{"ug", 1, []Node{
+		{"ac", 1, nil},
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"go", 1, nil},
+		{"ne", 1, nil},
+		{"or", 1, nil},
+		{"org", 1, nil},
+		{"sc", 1, nil},
+	},
+	},
+	{"uk", 3, []Node{
+		{"bl", 2, nil},
+		{"british-library", 2, nil},
+		{"jet", 2, nil},
+		{"mod", 2, nil},
+		{"national-library-scotland", 2, nil},
+		{"nel", 2, nil},
+		{"nhs", 3, nil},
+		{"nic", 2, nil},
+		{"nls", 2, nil},
+		{"parliament", 2, nil},
+		{"police", 3, nil},
+		{"sch", 3, nil},
+	},
+	},
+	{"us", 1, []Node{
+		{"ak", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"al", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ar", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"as", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"az", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ca", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"co", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ct", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"dc", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"de", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"dni", 1, nil},
+		{"fed", 1, nil},
+		{"fl", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ga", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"gu", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"hi", 1, []Node{
+			{"cc", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ia", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"id", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"il", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"in", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"is-by", 1, nil},
+		{"isa", 1, nil},
+		{"kids", 1, nil},
+		{"ks", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ky", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"la", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"land-4-sale", 1, nil},
+		{"ma", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, []Node{
+				{"chtr", 1, nil},
+				{"paroch", 1, nil},
+				{"pvt", 1, nil},
+			},
+			},
+			{"lib", 1, nil},
+		},
+		},
+		{"md", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"me", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"mi", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"mn", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"mo", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ms", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"mt", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"nc", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"nd", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ne", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"nh", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"nj", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"nm", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"nsn", 1, nil},
+		{"nv", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ny", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"oh", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ok", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"or", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"pa", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"pr", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ri", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"sc", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"sd", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"stuff-4-sale", 1, nil},
+		{"tn", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"tx", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"ut", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"va", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"vi", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"vt", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"wa", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"wi", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"wv", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+		{"wy", 1, []Node{
+			{"cc", 1, nil},
+			{"k12", 1, nil},
+			{"lib", 1, nil},
+		},
+		},
+	},
+	},
+	{"uy", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gub", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"uz", 1, []Node{
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"va", 1, nil},
+	{"vc", 1, []Node{
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"ve", 1, []Node{
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"e12", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"info", 1, nil},
+		{"mil", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"web", 1, nil},
+	},
+	},
+	{"vg", 1, nil},
+	{"vi", 1, []Node{
+		{"co", 1, nil},
+		{"com", 1, nil},
+		{"k12", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"vn", 1, []Node{
+		{"ac", 1, nil},
+		{"biz", 1, nil},
+		{"com", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"health", 1, nil},
+		{"info", 1, nil},
+		{"int", 1, nil},
+		{"name", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+		{"pro", 1, nil},
+	},
+	},
+	{"vu", 1, nil},
+	{"wf", 1, nil},
+	{"ws", 1, []Node{
+		{"com", 1, nil},
+		{"dyndns", 1, nil},
+		{"edu", 1, nil},
+		{"gov", 1, nil},
+		{"mypets", 1, nil},
+		{"net", 1, nil},
+		{"org", 1, nil},
+	},
+	},
+	{"xxx", 1, nil},
+	{"ye", 3, nil},
+	{"yt", 1, nil},
+	{"za", 3, nil},
+	{"zm", 3, nil},
+	{"zw", 3, nil},
+	{"рф", 1, nil},
+	{"срб", 1, nil},
+	{"укр", 1, nil},
+	{"الاردن", 1, nil},
+	{"الجزائر", 1, nil},
+	{"السعودية", 1, nil},
+	{"السعوديه", 1, nil},
+	{"السعودیة", 1, nil},
+	{"السعودیۃ", 1, nil},
+	{"المغرب", 1, nil},
+	{"اليمن", 1, nil},
+	{"امارات", 1, nil},
+	{"ايران", 1, nil},
+	{"ایران", 1, nil},
+	{"بھارت", 1, nil},
+	{"تونس", 1, nil},
+	{"سوريا", 1, nil},
+	{"سورية", 1, nil},
+	{"عمان", 1, nil},
+	{"فلسطين", 1, nil},
+	{"قطر", 1, nil},
+	{"مصر", 1, nil},
+	{"भारत", 1, nil},
+	{"বাংলা", 1, nil},
+	{"ভারত", 1, nil},
+	{"ਭਾਰਤ", 1, nil},
+	{"ભારત", 1, nil},
+	{"இந்தியா", 1, nil},
+	{"இலங்கை", 1, nil},
+	{"சிங்கப்பூர்", 1, nil},
+	{"భారత్", 1, nil},
+	{"ලංකා", 1, nil},
+	{"ไทย", 1, nil},
+	{"გე", 1, nil},
+	{"中国", 1, nil},
+	{"中國", 1, nil},
+	{"台湾", 1, nil},
+	{"台灣", 1, nil},
+	{"新加坡", 1, nil},
+	{"臺灣", 1, nil},
+	{"香港", 1, nil},
+	{"한국", 1, nil},
+}}
+
+// the needed fibonacci numbers
+var fibonacci = []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89, 144, 233, 377, 610, 987}